@@ -0,0 +1,3281 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package nodev1beta1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_CrossChainRecordsRequest_3_list)(nil)
+
+type _CrossChainRecordsRequest_3_list struct {
+	list *[]string
+}
+
+func (x *_CrossChainRecordsRequest_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CrossChainRecordsRequest_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_CrossChainRecordsRequest_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CrossChainRecordsRequest_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CrossChainRecordsRequest_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message CrossChainRecordsRequest at list field RecordTypes as it is not of Message kind"))
+}
+
+func (x *_CrossChainRecordsRequest_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CrossChainRecordsRequest_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_CrossChainRecordsRequest_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CrossChainRecordsRequest                protoreflect.MessageDescriptor
+	fd_CrossChainRecordsRequest_from_index     protoreflect.FieldDescriptor
+	fd_CrossChainRecordsRequest_limit          protoreflect.FieldDescriptor
+	fd_CrossChainRecordsRequest_record_types   protoreflect.FieldDescriptor
+	fd_CrossChainRecordsRequest_min_height     protoreflect.FieldDescriptor
+	fd_CrossChainRecordsRequest_max_height     protoreflect.FieldDescriptor
+	fd_CrossChainRecordsRequest_channel_id     protoreflect.FieldDescriptor
+	fd_CrossChainRecordsRequest_filter_channel protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_node_v1beta1_crosschain_proto_init()
+	md_CrossChainRecordsRequest = File_cosmos_base_node_v1beta1_crosschain_proto.Messages().ByName("CrossChainRecordsRequest")
+	fd_CrossChainRecordsRequest_from_index = md_CrossChainRecordsRequest.Fields().ByName("from_index")
+	fd_CrossChainRecordsRequest_limit = md_CrossChainRecordsRequest.Fields().ByName("limit")
+	fd_CrossChainRecordsRequest_record_types = md_CrossChainRecordsRequest.Fields().ByName("record_types")
+	fd_CrossChainRecordsRequest_min_height = md_CrossChainRecordsRequest.Fields().ByName("min_height")
+	fd_CrossChainRecordsRequest_max_height = md_CrossChainRecordsRequest.Fields().ByName("max_height")
+	fd_CrossChainRecordsRequest_channel_id = md_CrossChainRecordsRequest.Fields().ByName("channel_id")
+	fd_CrossChainRecordsRequest_filter_channel = md_CrossChainRecordsRequest.Fields().ByName("filter_channel")
+}
+
+var _ protoreflect.Message = (*fastReflection_CrossChainRecordsRequest)(nil)
+
+type fastReflection_CrossChainRecordsRequest CrossChainRecordsRequest
+
+func (x *CrossChainRecordsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CrossChainRecordsRequest)(x)
+}
+
+func (x *CrossChainRecordsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CrossChainRecordsRequest_messageType fastReflection_CrossChainRecordsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_CrossChainRecordsRequest_messageType{}
+
+type fastReflection_CrossChainRecordsRequest_messageType struct{}
+
+func (x fastReflection_CrossChainRecordsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CrossChainRecordsRequest)(nil)
+}
+func (x fastReflection_CrossChainRecordsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_CrossChainRecordsRequest)
+}
+func (x fastReflection_CrossChainRecordsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CrossChainRecordsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CrossChainRecordsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_CrossChainRecordsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CrossChainRecordsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_CrossChainRecordsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CrossChainRecordsRequest) New() protoreflect.Message {
+	return new(fastReflection_CrossChainRecordsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CrossChainRecordsRequest) Interface() protoreflect.ProtoMessage {
+	return (*CrossChainRecordsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CrossChainRecordsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FromIndex)
+		if !f(fd_CrossChainRecordsRequest_from_index, value) {
+			return
+		}
+	}
+	if x.Limit != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Limit)
+		if !f(fd_CrossChainRecordsRequest_limit, value) {
+			return
+		}
+	}
+	if len(x.RecordTypes) != 0 {
+		value := protoreflect.ValueOfList(&_CrossChainRecordsRequest_3_list{list: &x.RecordTypes})
+		if !f(fd_CrossChainRecordsRequest_record_types, value) {
+			return
+		}
+	}
+	if x.MinHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinHeight)
+		if !f(fd_CrossChainRecordsRequest_min_height, value) {
+			return
+		}
+	}
+	if x.MaxHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxHeight)
+		if !f(fd_CrossChainRecordsRequest_max_height, value) {
+			return
+		}
+	}
+	if x.ChannelId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ChannelId)
+		if !f(fd_CrossChainRecordsRequest_channel_id, value) {
+			return
+		}
+	}
+	if x.FilterChannel != false {
+		value := protoreflect.ValueOfBool(x.FilterChannel)
+		if !f(fd_CrossChainRecordsRequest_filter_channel, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CrossChainRecordsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.from_index":
+		return x.FromIndex != uint64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.limit":
+		return x.Limit != uint32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.record_types":
+		return len(x.RecordTypes) != 0
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.min_height":
+		return x.MinHeight != int64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.max_height":
+		return x.MaxHeight != int64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.channel_id":
+		return x.ChannelId != uint32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.filter_channel":
+		return x.FilterChannel != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecordsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.from_index":
+		x.FromIndex = uint64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.limit":
+		x.Limit = uint32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.record_types":
+		x.RecordTypes = nil
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.min_height":
+		x.MinHeight = int64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.max_height":
+		x.MaxHeight = int64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.channel_id":
+		x.ChannelId = uint32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.filter_channel":
+		x.FilterChannel = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CrossChainRecordsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.from_index":
+		value := x.FromIndex
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.limit":
+		value := x.Limit
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.record_types":
+		if len(x.RecordTypes) == 0 {
+			return protoreflect.ValueOfList(&_CrossChainRecordsRequest_3_list{})
+		}
+		listValue := &_CrossChainRecordsRequest_3_list{list: &x.RecordTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.min_height":
+		value := x.MinHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.max_height":
+		value := x.MaxHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.filter_channel":
+		value := x.FilterChannel
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecordsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.from_index":
+		x.FromIndex = value.Uint()
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.limit":
+		x.Limit = uint32(value.Uint())
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.record_types":
+		lv := value.List()
+		clv := lv.(*_CrossChainRecordsRequest_3_list)
+		x.RecordTypes = *clv.list
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.min_height":
+		x.MinHeight = value.Int()
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.max_height":
+		x.MaxHeight = value.Int()
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.channel_id":
+		x.ChannelId = uint32(value.Uint())
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.filter_channel":
+		x.FilterChannel = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecordsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.record_types":
+		if x.RecordTypes == nil {
+			x.RecordTypes = []string{}
+		}
+		value := &_CrossChainRecordsRequest_3_list{list: &x.RecordTypes}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.from_index":
+		panic(fmt.Errorf("field from_index of message cosmos.base.node.v1beta1.CrossChainRecordsRequest is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.limit":
+		panic(fmt.Errorf("field limit of message cosmos.base.node.v1beta1.CrossChainRecordsRequest is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.min_height":
+		panic(fmt.Errorf("field min_height of message cosmos.base.node.v1beta1.CrossChainRecordsRequest is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.max_height":
+		panic(fmt.Errorf("field max_height of message cosmos.base.node.v1beta1.CrossChainRecordsRequest is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.channel_id":
+		panic(fmt.Errorf("field channel_id of message cosmos.base.node.v1beta1.CrossChainRecordsRequest is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.filter_channel":
+		panic(fmt.Errorf("field filter_channel of message cosmos.base.node.v1beta1.CrossChainRecordsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CrossChainRecordsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.from_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.limit":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.record_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_CrossChainRecordsRequest_3_list{list: &list})
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.min_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.max_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.channel_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecordsRequest.filter_channel":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CrossChainRecordsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.node.v1beta1.CrossChainRecordsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CrossChainRecordsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecordsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CrossChainRecordsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CrossChainRecordsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CrossChainRecordsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FromIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromIndex))
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if len(x.RecordTypes) > 0 {
+			for _, s := range x.RecordTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MinHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinHeight))
+		}
+		if x.MaxHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxHeight))
+		}
+		if x.ChannelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChannelId))
+		}
+		if x.FilterChannel {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CrossChainRecordsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FilterChannel {
+			i--
+			if x.FilterChannel {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ChannelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChannelId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MinHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.RecordTypes) > 0 {
+			for iNdEx := len(x.RecordTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RecordTypes[iNdEx])
+				copy(dAtA[i:], x.RecordTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.FromIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CrossChainRecordsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CrossChainRecordsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CrossChainRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromIndex", wireType)
+				}
+				x.FromIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordTypes = append(x.RecordTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+				}
+				x.MinHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+				}
+				x.MaxHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				x.ChannelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChannelId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FilterChannel", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.FilterChannel = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CrossChainRecordsResponse_1_list)(nil)
+
+type _CrossChainRecordsResponse_1_list struct {
+	list *[]*CrossChainRecord
+}
+
+func (x *_CrossChainRecordsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CrossChainRecordsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CrossChainRecordsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CrossChainRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CrossChainRecordsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CrossChainRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CrossChainRecordsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(CrossChainRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CrossChainRecordsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CrossChainRecordsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(CrossChainRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CrossChainRecordsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CrossChainRecordsResponse            protoreflect.MessageDescriptor
+	fd_CrossChainRecordsResponse_records    protoreflect.FieldDescriptor
+	fd_CrossChainRecordsResponse_next_index protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_node_v1beta1_crosschain_proto_init()
+	md_CrossChainRecordsResponse = File_cosmos_base_node_v1beta1_crosschain_proto.Messages().ByName("CrossChainRecordsResponse")
+	fd_CrossChainRecordsResponse_records = md_CrossChainRecordsResponse.Fields().ByName("records")
+	fd_CrossChainRecordsResponse_next_index = md_CrossChainRecordsResponse.Fields().ByName("next_index")
+}
+
+var _ protoreflect.Message = (*fastReflection_CrossChainRecordsResponse)(nil)
+
+type fastReflection_CrossChainRecordsResponse CrossChainRecordsResponse
+
+func (x *CrossChainRecordsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CrossChainRecordsResponse)(x)
+}
+
+func (x *CrossChainRecordsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CrossChainRecordsResponse_messageType fastReflection_CrossChainRecordsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_CrossChainRecordsResponse_messageType{}
+
+type fastReflection_CrossChainRecordsResponse_messageType struct{}
+
+func (x fastReflection_CrossChainRecordsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CrossChainRecordsResponse)(nil)
+}
+func (x fastReflection_CrossChainRecordsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_CrossChainRecordsResponse)
+}
+func (x fastReflection_CrossChainRecordsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CrossChainRecordsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CrossChainRecordsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_CrossChainRecordsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CrossChainRecordsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_CrossChainRecordsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CrossChainRecordsResponse) New() protoreflect.Message {
+	return new(fastReflection_CrossChainRecordsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CrossChainRecordsResponse) Interface() protoreflect.ProtoMessage {
+	return (*CrossChainRecordsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CrossChainRecordsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Records) != 0 {
+		value := protoreflect.ValueOfList(&_CrossChainRecordsResponse_1_list{list: &x.Records})
+		if !f(fd_CrossChainRecordsResponse_records, value) {
+			return
+		}
+	}
+	if x.NextIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextIndex)
+		if !f(fd_CrossChainRecordsResponse_next_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CrossChainRecordsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.records":
+		return len(x.Records) != 0
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.next_index":
+		return x.NextIndex != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecordsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.records":
+		x.Records = nil
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.next_index":
+		x.NextIndex = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CrossChainRecordsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.records":
+		if len(x.Records) == 0 {
+			return protoreflect.ValueOfList(&_CrossChainRecordsResponse_1_list{})
+		}
+		listValue := &_CrossChainRecordsResponse_1_list{list: &x.Records}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.next_index":
+		value := x.NextIndex
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecordsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.records":
+		lv := value.List()
+		clv := lv.(*_CrossChainRecordsResponse_1_list)
+		x.Records = *clv.list
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.next_index":
+		x.NextIndex = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecordsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.records":
+		if x.Records == nil {
+			x.Records = []*CrossChainRecord{}
+		}
+		value := &_CrossChainRecordsResponse_1_list{list: &x.Records}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.next_index":
+		panic(fmt.Errorf("field next_index of message cosmos.base.node.v1beta1.CrossChainRecordsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CrossChainRecordsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.records":
+		list := []*CrossChainRecord{}
+		return protoreflect.ValueOfList(&_CrossChainRecordsResponse_1_list{list: &list})
+	case "cosmos.base.node.v1beta1.CrossChainRecordsResponse.next_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CrossChainRecordsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.node.v1beta1.CrossChainRecordsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CrossChainRecordsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecordsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CrossChainRecordsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CrossChainRecordsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CrossChainRecordsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Records) > 0 {
+			for _, e := range x.Records {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextIndex))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CrossChainRecordsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextIndex))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Records) > 0 {
+			for iNdEx := len(x.Records) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Records[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CrossChainRecordsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CrossChainRecordsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CrossChainRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Records = append(x.Records, &CrossChainRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Records[len(x.Records)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextIndex", wireType)
+				}
+				x.NextIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CrossChainRecord                   protoreflect.MessageDescriptor
+	fd_CrossChainRecord_index             protoreflect.FieldDescriptor
+	fd_CrossChainRecord_height            protoreflect.FieldDescriptor
+	fd_CrossChainRecord_tx_index          protoreflect.FieldDescriptor
+	fd_CrossChainRecord_record_type       protoreflect.FieldDescriptor
+	fd_CrossChainRecord_direction         protoreflect.FieldDescriptor
+	fd_CrossChainRecord_src_chain_id      protoreflect.FieldDescriptor
+	fd_CrossChainRecord_dest_chain_id     protoreflect.FieldDescriptor
+	fd_CrossChainRecord_channel_id        protoreflect.FieldDescriptor
+	fd_CrossChainRecord_sequence          protoreflect.FieldDescriptor
+	fd_CrossChainRecord_package_type      protoreflect.FieldDescriptor
+	fd_CrossChainRecord_timestamp         protoreflect.FieldDescriptor
+	fd_CrossChainRecord_payload           protoreflect.FieldDescriptor
+	fd_CrossChainRecord_relayer_fee       protoreflect.FieldDescriptor
+	fd_CrossChainRecord_ack_relayer_fee   protoreflect.FieldDescriptor
+	fd_CrossChainRecord_outcome           protoreflect.FieldDescriptor
+	fd_CrossChainRecord_error             protoreflect.FieldDescriptor
+	fd_CrossChainRecord_ack_package_type  protoreflect.FieldDescriptor
+	fd_CrossChainRecord_ack_sequence      protoreflect.FieldDescriptor
+	fd_CrossChainRecord_expected_sequence protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_node_v1beta1_crosschain_proto_init()
+	md_CrossChainRecord = File_cosmos_base_node_v1beta1_crosschain_proto.Messages().ByName("CrossChainRecord")
+	fd_CrossChainRecord_index = md_CrossChainRecord.Fields().ByName("index")
+	fd_CrossChainRecord_height = md_CrossChainRecord.Fields().ByName("height")
+	fd_CrossChainRecord_tx_index = md_CrossChainRecord.Fields().ByName("tx_index")
+	fd_CrossChainRecord_record_type = md_CrossChainRecord.Fields().ByName("record_type")
+	fd_CrossChainRecord_direction = md_CrossChainRecord.Fields().ByName("direction")
+	fd_CrossChainRecord_src_chain_id = md_CrossChainRecord.Fields().ByName("src_chain_id")
+	fd_CrossChainRecord_dest_chain_id = md_CrossChainRecord.Fields().ByName("dest_chain_id")
+	fd_CrossChainRecord_channel_id = md_CrossChainRecord.Fields().ByName("channel_id")
+	fd_CrossChainRecord_sequence = md_CrossChainRecord.Fields().ByName("sequence")
+	fd_CrossChainRecord_package_type = md_CrossChainRecord.Fields().ByName("package_type")
+	fd_CrossChainRecord_timestamp = md_CrossChainRecord.Fields().ByName("timestamp")
+	fd_CrossChainRecord_payload = md_CrossChainRecord.Fields().ByName("payload")
+	fd_CrossChainRecord_relayer_fee = md_CrossChainRecord.Fields().ByName("relayer_fee")
+	fd_CrossChainRecord_ack_relayer_fee = md_CrossChainRecord.Fields().ByName("ack_relayer_fee")
+	fd_CrossChainRecord_outcome = md_CrossChainRecord.Fields().ByName("outcome")
+	fd_CrossChainRecord_error = md_CrossChainRecord.Fields().ByName("error")
+	fd_CrossChainRecord_ack_package_type = md_CrossChainRecord.Fields().ByName("ack_package_type")
+	fd_CrossChainRecord_ack_sequence = md_CrossChainRecord.Fields().ByName("ack_sequence")
+	fd_CrossChainRecord_expected_sequence = md_CrossChainRecord.Fields().ByName("expected_sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_CrossChainRecord)(nil)
+
+type fastReflection_CrossChainRecord CrossChainRecord
+
+func (x *CrossChainRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CrossChainRecord)(x)
+}
+
+func (x *CrossChainRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CrossChainRecord_messageType fastReflection_CrossChainRecord_messageType
+var _ protoreflect.MessageType = fastReflection_CrossChainRecord_messageType{}
+
+type fastReflection_CrossChainRecord_messageType struct{}
+
+func (x fastReflection_CrossChainRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CrossChainRecord)(nil)
+}
+func (x fastReflection_CrossChainRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_CrossChainRecord)
+}
+func (x fastReflection_CrossChainRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CrossChainRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CrossChainRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_CrossChainRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CrossChainRecord) Type() protoreflect.MessageType {
+	return _fastReflection_CrossChainRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CrossChainRecord) New() protoreflect.Message {
+	return new(fastReflection_CrossChainRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CrossChainRecord) Interface() protoreflect.ProtoMessage {
+	return (*CrossChainRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CrossChainRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_CrossChainRecord_index, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_CrossChainRecord_height, value) {
+			return
+		}
+	}
+	if x.TxIndex != int32(0) {
+		value := protoreflect.ValueOfInt32(x.TxIndex)
+		if !f(fd_CrossChainRecord_tx_index, value) {
+			return
+		}
+	}
+	if x.RecordType != "" {
+		value := protoreflect.ValueOfString(x.RecordType)
+		if !f(fd_CrossChainRecord_record_type, value) {
+			return
+		}
+	}
+	if x.Direction != "" {
+		value := protoreflect.ValueOfString(x.Direction)
+		if !f(fd_CrossChainRecord_direction, value) {
+			return
+		}
+	}
+	if x.SrcChainId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SrcChainId)
+		if !f(fd_CrossChainRecord_src_chain_id, value) {
+			return
+		}
+	}
+	if x.DestChainId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DestChainId)
+		if !f(fd_CrossChainRecord_dest_chain_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ChannelId)
+		if !f(fd_CrossChainRecord_channel_id, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_CrossChainRecord_sequence, value) {
+			return
+		}
+	}
+	if x.PackageType != "" {
+		value := protoreflect.ValueOfString(x.PackageType)
+		if !f(fd_CrossChainRecord_package_type, value) {
+			return
+		}
+	}
+	if x.Timestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Timestamp)
+		if !f(fd_CrossChainRecord_timestamp, value) {
+			return
+		}
+	}
+	if x.Payload != "" {
+		value := protoreflect.ValueOfString(x.Payload)
+		if !f(fd_CrossChainRecord_payload, value) {
+			return
+		}
+	}
+	if x.RelayerFee != "" {
+		value := protoreflect.ValueOfString(x.RelayerFee)
+		if !f(fd_CrossChainRecord_relayer_fee, value) {
+			return
+		}
+	}
+	if x.AckRelayerFee != "" {
+		value := protoreflect.ValueOfString(x.AckRelayerFee)
+		if !f(fd_CrossChainRecord_ack_relayer_fee, value) {
+			return
+		}
+	}
+	if x.Outcome != "" {
+		value := protoreflect.ValueOfString(x.Outcome)
+		if !f(fd_CrossChainRecord_outcome, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_CrossChainRecord_error, value) {
+			return
+		}
+	}
+	if x.AckPackageType != "" {
+		value := protoreflect.ValueOfString(x.AckPackageType)
+		if !f(fd_CrossChainRecord_ack_package_type, value) {
+			return
+		}
+	}
+	if x.AckSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AckSequence)
+		if !f(fd_CrossChainRecord_ack_sequence, value) {
+			return
+		}
+	}
+	if x.ExpectedSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpectedSequence)
+		if !f(fd_CrossChainRecord_expected_sequence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CrossChainRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecord.index":
+		return x.Index != uint64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.height":
+		return x.Height != int64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.tx_index":
+		return x.TxIndex != int32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.record_type":
+		return x.RecordType != ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.direction":
+		return x.Direction != ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.src_chain_id":
+		return x.SrcChainId != uint32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.dest_chain_id":
+		return x.DestChainId != uint32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.channel_id":
+		return x.ChannelId != uint32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.sequence":
+		return x.Sequence != uint64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.package_type":
+		return x.PackageType != ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.timestamp":
+		return x.Timestamp != uint64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.payload":
+		return x.Payload != ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.relayer_fee":
+		return x.RelayerFee != ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_relayer_fee":
+		return x.AckRelayerFee != ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.outcome":
+		return x.Outcome != ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.error":
+		return x.Error != ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_package_type":
+		return x.AckPackageType != ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_sequence":
+		return x.AckSequence != uint64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.expected_sequence":
+		return x.ExpectedSequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecord.index":
+		x.Index = uint64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.height":
+		x.Height = int64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.tx_index":
+		x.TxIndex = int32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.record_type":
+		x.RecordType = ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.direction":
+		x.Direction = ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.src_chain_id":
+		x.SrcChainId = uint32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.dest_chain_id":
+		x.DestChainId = uint32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.channel_id":
+		x.ChannelId = uint32(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.sequence":
+		x.Sequence = uint64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.package_type":
+		x.PackageType = ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.timestamp":
+		x.Timestamp = uint64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.payload":
+		x.Payload = ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.relayer_fee":
+		x.RelayerFee = ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_relayer_fee":
+		x.AckRelayerFee = ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.outcome":
+		x.Outcome = ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.error":
+		x.Error = ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_package_type":
+		x.AckPackageType = ""
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_sequence":
+		x.AckSequence = uint64(0)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.expected_sequence":
+		x.ExpectedSequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CrossChainRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecord.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.tx_index":
+		value := x.TxIndex
+		return protoreflect.ValueOfInt32(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.record_type":
+		value := x.RecordType
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.direction":
+		value := x.Direction
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.src_chain_id":
+		value := x.SrcChainId
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.dest_chain_id":
+		value := x.DestChainId
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.package_type":
+		value := x.PackageType
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.payload":
+		value := x.Payload
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.relayer_fee":
+		value := x.RelayerFee
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_relayer_fee":
+		value := x.AckRelayerFee
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.outcome":
+		value := x.Outcome
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_package_type":
+		value := x.AckPackageType
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_sequence":
+		value := x.AckSequence
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.expected_sequence":
+		value := x.ExpectedSequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecord.index":
+		x.Index = value.Uint()
+	case "cosmos.base.node.v1beta1.CrossChainRecord.height":
+		x.Height = value.Int()
+	case "cosmos.base.node.v1beta1.CrossChainRecord.tx_index":
+		x.TxIndex = int32(value.Int())
+	case "cosmos.base.node.v1beta1.CrossChainRecord.record_type":
+		x.RecordType = value.Interface().(string)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.direction":
+		x.Direction = value.Interface().(string)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.src_chain_id":
+		x.SrcChainId = uint32(value.Uint())
+	case "cosmos.base.node.v1beta1.CrossChainRecord.dest_chain_id":
+		x.DestChainId = uint32(value.Uint())
+	case "cosmos.base.node.v1beta1.CrossChainRecord.channel_id":
+		x.ChannelId = uint32(value.Uint())
+	case "cosmos.base.node.v1beta1.CrossChainRecord.sequence":
+		x.Sequence = value.Uint()
+	case "cosmos.base.node.v1beta1.CrossChainRecord.package_type":
+		x.PackageType = value.Interface().(string)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.timestamp":
+		x.Timestamp = value.Uint()
+	case "cosmos.base.node.v1beta1.CrossChainRecord.payload":
+		x.Payload = value.Interface().(string)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.relayer_fee":
+		x.RelayerFee = value.Interface().(string)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_relayer_fee":
+		x.AckRelayerFee = value.Interface().(string)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.outcome":
+		x.Outcome = value.Interface().(string)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.error":
+		x.Error = value.Interface().(string)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_package_type":
+		x.AckPackageType = value.Interface().(string)
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_sequence":
+		x.AckSequence = value.Uint()
+	case "cosmos.base.node.v1beta1.CrossChainRecord.expected_sequence":
+		x.ExpectedSequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecord.index":
+		panic(fmt.Errorf("field index of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.height":
+		panic(fmt.Errorf("field height of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.tx_index":
+		panic(fmt.Errorf("field tx_index of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.record_type":
+		panic(fmt.Errorf("field record_type of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.direction":
+		panic(fmt.Errorf("field direction of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.src_chain_id":
+		panic(fmt.Errorf("field src_chain_id of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.dest_chain_id":
+		panic(fmt.Errorf("field dest_chain_id of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.channel_id":
+		panic(fmt.Errorf("field channel_id of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.sequence":
+		panic(fmt.Errorf("field sequence of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.package_type":
+		panic(fmt.Errorf("field package_type of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.timestamp":
+		panic(fmt.Errorf("field timestamp of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.payload":
+		panic(fmt.Errorf("field payload of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.relayer_fee":
+		panic(fmt.Errorf("field relayer_fee of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_relayer_fee":
+		panic(fmt.Errorf("field ack_relayer_fee of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.outcome":
+		panic(fmt.Errorf("field outcome of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.error":
+		panic(fmt.Errorf("field error of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_package_type":
+		panic(fmt.Errorf("field ack_package_type of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_sequence":
+		panic(fmt.Errorf("field ack_sequence of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.expected_sequence":
+		panic(fmt.Errorf("field expected_sequence of message cosmos.base.node.v1beta1.CrossChainRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CrossChainRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.CrossChainRecord.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.tx_index":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.record_type":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.node.v1beta1.CrossChainRecord.direction":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.node.v1beta1.CrossChainRecord.src_chain_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.dest_chain_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.channel_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.package_type":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.node.v1beta1.CrossChainRecord.timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.payload":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.node.v1beta1.CrossChainRecord.relayer_fee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_relayer_fee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.node.v1beta1.CrossChainRecord.outcome":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.node.v1beta1.CrossChainRecord.error":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_package_type":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.node.v1beta1.CrossChainRecord.ack_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.CrossChainRecord.expected_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.CrossChainRecord"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.CrossChainRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CrossChainRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.node.v1beta1.CrossChainRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CrossChainRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CrossChainRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CrossChainRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CrossChainRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CrossChainRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.TxIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxIndex))
+		}
+		l = len(x.RecordType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Direction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SrcChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SrcChainId))
+		}
+		if x.DestChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestChainId))
+		}
+		if x.ChannelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChannelId))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		l = len(x.PackageType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
+		l = len(x.Payload)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RelayerFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AckRelayerFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Outcome)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AckPackageType)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.AckSequence != 0 {
+			n += 2 + runtime.Sov(uint64(x.AckSequence))
+		}
+		if x.ExpectedSequence != 0 {
+			n += 2 + runtime.Sov(uint64(x.ExpectedSequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CrossChainRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpectedSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpectedSequence))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.AckSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AckSequence))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.AckPackageType) > 0 {
+			i -= len(x.AckPackageType)
+			copy(dAtA[i:], x.AckPackageType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AckPackageType)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.Outcome) > 0 {
+			i -= len(x.Outcome)
+			copy(dAtA[i:], x.Outcome)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Outcome)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.AckRelayerFee) > 0 {
+			i -= len(x.AckRelayerFee)
+			copy(dAtA[i:], x.AckRelayerFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AckRelayerFee)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.RelayerFee) > 0 {
+			i -= len(x.RelayerFee)
+			copy(dAtA[i:], x.RelayerFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RelayerFee)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.Payload) > 0 {
+			i -= len(x.Payload)
+			copy(dAtA[i:], x.Payload)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payload)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.PackageType) > 0 {
+			i -= len(x.PackageType)
+			copy(dAtA[i:], x.PackageType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PackageType)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ChannelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChannelId))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.DestChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestChainId))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.SrcChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SrcChainId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Direction) > 0 {
+			i -= len(x.Direction)
+			copy(dAtA[i:], x.Direction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Direction)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.RecordType) > 0 {
+			i -= len(x.RecordType)
+			copy(dAtA[i:], x.RecordType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordType)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.TxIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxIndex))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CrossChainRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CrossChainRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CrossChainRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				}
+				x.TxIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxIndex |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Direction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+				}
+				x.SrcChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SrcChainId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+				}
+				x.DestChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestChainId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				x.ChannelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChannelId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PackageType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PackageType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payload = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RelayerFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AckRelayerFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AckRelayerFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Outcome = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AckPackageType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AckPackageType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AckSequence", wireType)
+				}
+				x.AckSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AckSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedSequence", wireType)
+				}
+				x.ExpectedSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpectedSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/base/node/v1beta1/crosschain.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CrossChainRecordsRequest defines the request structure for the Records gRPC query.
+type CrossChainRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from_index is the index of the first record returned.
+	FromIndex uint64 `protobuf:"varint,1,opt,name=from_index,json=fromIndex,proto3" json:"from_index,omitempty"`
+	// limit is the maximum number of records returned, up to 1000, which is also the limit if it is 0.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// record_types are the types of the records returned, all of them if empty.
+	RecordTypes []string `protobuf:"bytes,3,rep,name=record_types,json=recordTypes,proto3" json:"record_types,omitempty"`
+	// min_height and max_height bound the heights of the records returned, 0 for no bound.
+	MinHeight int64 `protobuf:"varint,4,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight int64 `protobuf:"varint,5,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// channel_id is the channel of the records returned if filter_channel is set, any channel otherwise.
+	ChannelId     uint32 `protobuf:"varint,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	FilterChannel bool   `protobuf:"varint,7,opt,name=filter_channel,json=filterChannel,proto3" json:"filter_channel,omitempty"`
+}
+
+func (x *CrossChainRecordsRequest) Reset() {
+	*x = CrossChainRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossChainRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossChainRecordsRequest) ProtoMessage() {}
+
+// Deprecated: Use CrossChainRecordsRequest.ProtoReflect.Descriptor instead.
+func (*CrossChainRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_node_v1beta1_crosschain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CrossChainRecordsRequest) GetFromIndex() uint64 {
+	if x != nil {
+		return x.FromIndex
+	}
+	return 0
+}
+
+func (x *CrossChainRecordsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CrossChainRecordsRequest) GetRecordTypes() []string {
+	if x != nil {
+		return x.RecordTypes
+	}
+	return nil
+}
+
+func (x *CrossChainRecordsRequest) GetMinHeight() int64 {
+	if x != nil {
+		return x.MinHeight
+	}
+	return 0
+}
+
+func (x *CrossChainRecordsRequest) GetMaxHeight() int64 {
+	if x != nil {
+		return x.MaxHeight
+	}
+	return 0
+}
+
+func (x *CrossChainRecordsRequest) GetChannelId() uint32 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *CrossChainRecordsRequest) GetFilterChannel() bool {
+	if x != nil {
+		return x.FilterChannel
+	}
+	return false
+}
+
+// CrossChainRecordsResponse defines the response structure for the Records gRPC query.
+type CrossChainRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*CrossChainRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// next_index is the index to query the next records from.
+	NextIndex uint64 `protobuf:"varint,2,opt,name=next_index,json=nextIndex,proto3" json:"next_index,omitempty"`
+}
+
+func (x *CrossChainRecordsResponse) Reset() {
+	*x = CrossChainRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossChainRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossChainRecordsResponse) ProtoMessage() {}
+
+// Deprecated: Use CrossChainRecordsResponse.ProtoReflect.Descriptor instead.
+func (*CrossChainRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_node_v1beta1_crosschain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CrossChainRecordsResponse) GetRecords() []*CrossChainRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *CrossChainRecordsResponse) GetNextIndex() uint64 {
+	if x != nil {
+		return x.NextIndex
+	}
+	return 0
+}
+
+// CrossChainRecord is a normalized cross-chain record, the fields which don't apply to its type are empty.
+type CrossChainRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the position of the record in the stream, starting at 0.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// height is the height of the block the record comes from.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_index is the index of the tx of the record in the block, -1 if it doesn't come from a tx.
+	TxIndex int32 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// record_type is package_sent, package_received, relayer_fee or sequence_gap.
+	RecordType string `protobuf:"bytes,4,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// direction is send for the packages sent by this chain, receive for the received ones.
+	Direction   string `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	SrcChainId  uint32 `protobuf:"varint,6,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"`
+	DestChainId uint32 `protobuf:"varint,7,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	ChannelId   uint32 `protobuf:"varint,8,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence    uint64 `protobuf:"varint,9,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// package_type is the type of the package: syn, ack or fail_ack.
+	PackageType string `protobuf:"bytes,10,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	Timestamp   uint64 `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// payload is the hex encoded payload of a sent package, without its header.
+	Payload       string `protobuf:"bytes,12,opt,name=payload,proto3" json:"payload,omitempty"`
+	RelayerFee    string `protobuf:"bytes,13,opt,name=relayer_fee,json=relayerFee,proto3" json:"relayer_fee,omitempty"`
+	AckRelayerFee string `protobuf:"bytes,14,opt,name=ack_relayer_fee,json=ackRelayerFee,proto3" json:"ack_relayer_fee,omitempty"`
+	// outcome is the outcome of the execution of a received package: success, error or crash.
+	Outcome string `protobuf:"bytes,15,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error   string `protobuf:"bytes,16,opt,name=error,proto3" json:"error,omitempty"`
+	// ack_package_type is the type of the package sent back for a received syn package, ack or fail_ack, and
+	// ack_sequence its sequence, set if ack_package_type is.
+	AckPackageType string `protobuf:"bytes,17,opt,name=ack_package_type,json=ackPackageType,proto3" json:"ack_package_type,omitempty"`
+	AckSequence    uint64 `protobuf:"varint,18,opt,name=ack_sequence,json=ackSequence,proto3" json:"ack_sequence,omitempty"`
+	// expected_sequence is the sequence a gap record expected instead of sequence.
+	ExpectedSequence uint64 `protobuf:"varint,19,opt,name=expected_sequence,json=expectedSequence,proto3" json:"expected_sequence,omitempty"`
+}
+
+func (x *CrossChainRecord) Reset() {
+	*x = CrossChainRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrossChainRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossChainRecord) ProtoMessage() {}
+
+// Deprecated: Use CrossChainRecord.ProtoReflect.Descriptor instead.
+func (*CrossChainRecord) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_node_v1beta1_crosschain_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CrossChainRecord) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CrossChainRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CrossChainRecord) GetTxIndex() int32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *CrossChainRecord) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *CrossChainRecord) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *CrossChainRecord) GetSrcChainId() uint32 {
+	if x != nil {
+		return x.SrcChainId
+	}
+	return 0
+}
+
+func (x *CrossChainRecord) GetDestChainId() uint32 {
+	if x != nil {
+		return x.DestChainId
+	}
+	return 0
+}
+
+func (x *CrossChainRecord) GetChannelId() uint32 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *CrossChainRecord) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *CrossChainRecord) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *CrossChainRecord) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CrossChainRecord) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *CrossChainRecord) GetRelayerFee() string {
+	if x != nil {
+		return x.RelayerFee
+	}
+	return ""
+}
+
+func (x *CrossChainRecord) GetAckRelayerFee() string {
+	if x != nil {
+		return x.AckRelayerFee
+	}
+	return ""
+}
+
+func (x *CrossChainRecord) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *CrossChainRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CrossChainRecord) GetAckPackageType() string {
+	if x != nil {
+		return x.AckPackageType
+	}
+	return ""
+}
+
+func (x *CrossChainRecord) GetAckSequence() uint64 {
+	if x != nil {
+		return x.AckSequence
+	}
+	return 0
+}
+
+func (x *CrossChainRecord) GetExpectedSequence() uint64 {
+	if x != nil {
+		return x.ExpectedSequence
+	}
+	return 0
+}
+
+var File_cosmos_base_node_v1beta1_crosschain_proto protoreflect.FileDescriptor
+
+var file_cosmos_base_node_v1beta1_crosschain_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69,
+	0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x80, 0x01, 0x0a,
+	0x19, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0xe9, 0x04, 0x0a, 0x10, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c,
+	0x73, 0x72, 0x63, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x63, 0x6b,
+	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x6b,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x6b, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2b,
+	0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x32, 0xbe, 0x01, 0x0a, 0x11,
+	0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0xa8, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x32, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0xe9, 0x01, 0x0a,
+	0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0f, 0x43,
+	0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x35, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x6e,
+	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6e, 0x6f, 0x64, 0x65,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x4e, 0xaa, 0x02, 0x18,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x4e, 0x6f, 0x64, 0x65, 0x5c, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xe2, 0x02, 0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73,
+	0x65, 0x5c, 0x4e, 0x6f, 0x64, 0x65, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x4e, 0x6f, 0x64, 0x65, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cosmos_base_node_v1beta1_crosschain_proto_rawDescOnce sync.Once
+	file_cosmos_base_node_v1beta1_crosschain_proto_rawDescData = file_cosmos_base_node_v1beta1_crosschain_proto_rawDesc
+)
+
+func file_cosmos_base_node_v1beta1_crosschain_proto_rawDescGZIP() []byte {
+	file_cosmos_base_node_v1beta1_crosschain_proto_rawDescOnce.Do(func() {
+		file_cosmos_base_node_v1beta1_crosschain_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_base_node_v1beta1_crosschain_proto_rawDescData)
+	})
+	return file_cosmos_base_node_v1beta1_crosschain_proto_rawDescData
+}
+
+var file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cosmos_base_node_v1beta1_crosschain_proto_goTypes = []interface{}{
+	(*CrossChainRecordsRequest)(nil),  // 0: cosmos.base.node.v1beta1.CrossChainRecordsRequest
+	(*CrossChainRecordsResponse)(nil), // 1: cosmos.base.node.v1beta1.CrossChainRecordsResponse
+	(*CrossChainRecord)(nil),          // 2: cosmos.base.node.v1beta1.CrossChainRecord
+}
+var file_cosmos_base_node_v1beta1_crosschain_proto_depIdxs = []int32{
+	2, // 0: cosmos.base.node.v1beta1.CrossChainRecordsResponse.records:type_name -> cosmos.base.node.v1beta1.CrossChainRecord
+	0, // 1: cosmos.base.node.v1beta1.CrossChainRecords.Records:input_type -> cosmos.base.node.v1beta1.CrossChainRecordsRequest
+	1, // 2: cosmos.base.node.v1beta1.CrossChainRecords.Records:output_type -> cosmos.base.node.v1beta1.CrossChainRecordsResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_base_node_v1beta1_crosschain_proto_init() }
+func file_cosmos_base_node_v1beta1_crosschain_proto_init() {
+	if File_cosmos_base_node_v1beta1_crosschain_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrossChainRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrossChainRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrossChainRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cosmos_base_node_v1beta1_crosschain_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cosmos_base_node_v1beta1_crosschain_proto_goTypes,
+		DependencyIndexes: file_cosmos_base_node_v1beta1_crosschain_proto_depIdxs,
+		MessageInfos:      file_cosmos_base_node_v1beta1_crosschain_proto_msgTypes,
+	}.Build()
+	File_cosmos_base_node_v1beta1_crosschain_proto = out.File
+	file_cosmos_base_node_v1beta1_crosschain_proto_rawDesc = nil
+	file_cosmos_base_node_v1beta1_crosschain_proto_goTypes = nil
+	file_cosmos_base_node_v1beta1_crosschain_proto_depIdxs = nil
+}
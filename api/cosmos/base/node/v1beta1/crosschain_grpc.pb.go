@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/base/node/v1beta1/crosschain.proto
+
+package nodev1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CrossChainRecords_Records_FullMethodName = "/cosmos.base.node.v1beta1.CrossChainRecords/Records"
+)
+
+// CrossChainRecordsClient is the client API for CrossChainRecords service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CrossChainRecordsClient interface {
+	// Records queries for the records written by the crosschain streaming service which match the filters, in the
+	// order of the stream.
+	Records(ctx context.Context, in *CrossChainRecordsRequest, opts ...grpc.CallOption) (*CrossChainRecordsResponse, error)
+}
+
+type crossChainRecordsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCrossChainRecordsClient(cc grpc.ClientConnInterface) CrossChainRecordsClient {
+	return &crossChainRecordsClient{cc}
+}
+
+func (c *crossChainRecordsClient) Records(ctx context.Context, in *CrossChainRecordsRequest, opts ...grpc.CallOption) (*CrossChainRecordsResponse, error) {
+	out := new(CrossChainRecordsResponse)
+	err := c.cc.Invoke(ctx, CrossChainRecords_Records_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CrossChainRecordsServer is the server API for CrossChainRecords service.
+// All implementations must embed UnimplementedCrossChainRecordsServer
+// for forward compatibility
+type CrossChainRecordsServer interface {
+	// Records queries for the records written by the crosschain streaming service which match the filters, in the
+	// order of the stream.
+	Records(context.Context, *CrossChainRecordsRequest) (*CrossChainRecordsResponse, error)
+	mustEmbedUnimplementedCrossChainRecordsServer()
+}
+
+// UnimplementedCrossChainRecordsServer must be embedded to have forward compatible implementations.
+type UnimplementedCrossChainRecordsServer struct {
+}
+
+func (UnimplementedCrossChainRecordsServer) Records(context.Context, *CrossChainRecordsRequest) (*CrossChainRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+}
+func (UnimplementedCrossChainRecordsServer) mustEmbedUnimplementedCrossChainRecordsServer() {}
+
+// UnsafeCrossChainRecordsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CrossChainRecordsServer will
+// result in compilation errors.
+type UnsafeCrossChainRecordsServer interface {
+	mustEmbedUnimplementedCrossChainRecordsServer()
+}
+
+func RegisterCrossChainRecordsServer(s grpc.ServiceRegistrar, srv CrossChainRecordsServer) {
+	s.RegisterService(&CrossChainRecords_ServiceDesc, srv)
+}
+
+func _CrossChainRecords_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrossChainRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainRecordsServer).Records(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrossChainRecords_Records_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainRecordsServer).Records(ctx, req.(*CrossChainRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CrossChainRecords_ServiceDesc is the grpc.ServiceDesc for CrossChainRecords service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CrossChainRecords_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.node.v1beta1.CrossChainRecords",
+	HandlerType: (*CrossChainRecordsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Records",
+			Handler:    _CrossChainRecords_Records_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/node/v1beta1/crosschain.proto",
+}
@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/base/node/v1beta1/mempool.proto
+
+package nodev1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Mempool_PendingTxs_FullMethodName = "/cosmos.base.node.v1beta1.Mempool/PendingTxs"
+	Mempool_PendingTx_FullMethodName  = "/cosmos.base.node.v1beta1.Mempool/PendingTx"
+	Mempool_NonceGaps_FullMethodName  = "/cosmos.base.node.v1beta1.Mempool/NonceGaps"
+)
+
+// MempoolClient is the client API for Mempool service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MempoolClient interface {
+	// PendingTxs queries for the txs of the app-side mempool in their selection order.
+	PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error)
+	// PendingTx queries for the tx of the app-side mempool with a hash.
+	PendingTx(ctx context.Context, in *PendingTxRequest, opts ...grpc.CallOption) (*PendingTxResponse, error)
+	// NonceGaps queries for the first nonce and the nonces missing between the pending txs of each sender of the
+	// app-side mempool, which keep the txs after them from being included.
+	NonceGaps(ctx context.Context, in *NonceGapsRequest, opts ...grpc.CallOption) (*NonceGapsResponse, error)
+}
+
+type mempoolClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMempoolClient(cc grpc.ClientConnInterface) MempoolClient {
+	return &mempoolClient{cc}
+}
+
+func (c *mempoolClient) PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error) {
+	out := new(PendingTxsResponse)
+	err := c.cc.Invoke(ctx, Mempool_PendingTxs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolClient) PendingTx(ctx context.Context, in *PendingTxRequest, opts ...grpc.CallOption) (*PendingTxResponse, error) {
+	out := new(PendingTxResponse)
+	err := c.cc.Invoke(ctx, Mempool_PendingTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolClient) NonceGaps(ctx context.Context, in *NonceGapsRequest, opts ...grpc.CallOption) (*NonceGapsResponse, error) {
+	out := new(NonceGapsResponse)
+	err := c.cc.Invoke(ctx, Mempool_NonceGaps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MempoolServer is the server API for Mempool service.
+// All implementations must embed UnimplementedMempoolServer
+// for forward compatibility
+type MempoolServer interface {
+	// PendingTxs queries for the txs of the app-side mempool in their selection order.
+	PendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error)
+	// PendingTx queries for the tx of the app-side mempool with a hash.
+	PendingTx(context.Context, *PendingTxRequest) (*PendingTxResponse, error)
+	// NonceGaps queries for the first nonce and the nonces missing between the pending txs of each sender of the
+	// app-side mempool, which keep the txs after them from being included.
+	NonceGaps(context.Context, *NonceGapsRequest) (*NonceGapsResponse, error)
+	mustEmbedUnimplementedMempoolServer()
+}
+
+// UnimplementedMempoolServer must be embedded to have forward compatible implementations.
+type UnimplementedMempoolServer struct {
+}
+
+func (UnimplementedMempoolServer) PendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTxs not implemented")
+}
+func (UnimplementedMempoolServer) PendingTx(context.Context, *PendingTxRequest) (*PendingTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTx not implemented")
+}
+func (UnimplementedMempoolServer) NonceGaps(context.Context, *NonceGapsRequest) (*NonceGapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NonceGaps not implemented")
+}
+func (UnimplementedMempoolServer) mustEmbedUnimplementedMempoolServer() {}
+
+// UnsafeMempoolServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MempoolServer will
+// result in compilation errors.
+type UnsafeMempoolServer interface {
+	mustEmbedUnimplementedMempoolServer()
+}
+
+func RegisterMempoolServer(s grpc.ServiceRegistrar, srv MempoolServer) {
+	s.RegisterService(&Mempool_ServiceDesc, srv)
+}
+
+func _Mempool_PendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServer).PendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mempool_PendingTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServer).PendingTxs(ctx, req.(*PendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mempool_PendingTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServer).PendingTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mempool_PendingTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServer).PendingTx(ctx, req.(*PendingTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mempool_NonceGaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonceGapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServer).NonceGaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mempool_NonceGaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServer).NonceGaps(ctx, req.(*NonceGapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Mempool_ServiceDesc is the grpc.ServiceDesc for Mempool service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Mempool_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.node.v1beta1.Mempool",
+	HandlerType: (*MempoolServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingTxs",
+			Handler:    _Mempool_PendingTxs_Handler,
+		},
+		{
+			MethodName: "PendingTx",
+			Handler:    _Mempool_PendingTx_Handler,
+		},
+		{
+			MethodName: "NonceGaps",
+			Handler:    _Mempool_NonceGaps_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/node/v1beta1/mempool.proto",
+}
+
+const (
+	MempoolAdmin_EvictTx_FullMethodName = "/cosmos.base.node.v1beta1.MempoolAdmin/EvictTx"
+)
+
+// MempoolAdminClient is the client API for MempoolAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MempoolAdminClient interface {
+	// EvictTx removes the tx with a hash from the app-side mempool, which requires enable-evict to be set in
+	// [mempool] of app.toml. The tx isn't removed from the mempool of CometBFT: it's still gossiped to the
+	// peers, and may be inserted again in the app-side mempool by the recheck of the txs after a block.
+	EvictTx(ctx context.Context, in *EvictTxRequest, opts ...grpc.CallOption) (*EvictTxResponse, error)
+}
+
+type mempoolAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMempoolAdminClient(cc grpc.ClientConnInterface) MempoolAdminClient {
+	return &mempoolAdminClient{cc}
+}
+
+func (c *mempoolAdminClient) EvictTx(ctx context.Context, in *EvictTxRequest, opts ...grpc.CallOption) (*EvictTxResponse, error) {
+	out := new(EvictTxResponse)
+	err := c.cc.Invoke(ctx, MempoolAdmin_EvictTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MempoolAdminServer is the server API for MempoolAdmin service.
+// All implementations must embed UnimplementedMempoolAdminServer
+// for forward compatibility
+type MempoolAdminServer interface {
+	// EvictTx removes the tx with a hash from the app-side mempool, which requires enable-evict to be set in
+	// [mempool] of app.toml. The tx isn't removed from the mempool of CometBFT: it's still gossiped to the
+	// peers, and may be inserted again in the app-side mempool by the recheck of the txs after a block.
+	EvictTx(context.Context, *EvictTxRequest) (*EvictTxResponse, error)
+	mustEmbedUnimplementedMempoolAdminServer()
+}
+
+// UnimplementedMempoolAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedMempoolAdminServer struct {
+}
+
+func (UnimplementedMempoolAdminServer) EvictTx(context.Context, *EvictTxRequest) (*EvictTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictTx not implemented")
+}
+func (UnimplementedMempoolAdminServer) mustEmbedUnimplementedMempoolAdminServer() {}
+
+// UnsafeMempoolAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MempoolAdminServer will
+// result in compilation errors.
+type UnsafeMempoolAdminServer interface {
+	mustEmbedUnimplementedMempoolAdminServer()
+}
+
+func RegisterMempoolAdminServer(s grpc.ServiceRegistrar, srv MempoolAdminServer) {
+	s.RegisterService(&MempoolAdmin_ServiceDesc, srv)
+}
+
+func _MempoolAdmin_EvictTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolAdminServer).EvictTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MempoolAdmin_EvictTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolAdminServer).EvictTx(ctx, req.(*EvictTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MempoolAdmin_ServiceDesc is the grpc.ServiceDesc for MempoolAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MempoolAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.node.v1beta1.MempoolAdmin",
+	HandlerType: (*MempoolAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EvictTx",
+			Handler:    _MempoolAdmin_EvictTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/node/v1beta1/mempool.proto",
+}
@@ -0,0 +1,2496 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package nodev1beta1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_MsgProfilesRequest_1_list)(nil)
+
+type _MsgProfilesRequest_1_list struct {
+	list *[]string
+}
+
+func (x *_MsgProfilesRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgProfilesRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgProfilesRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgProfilesRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgProfilesRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgProfilesRequest at list field TypeUrls as it is not of Message kind"))
+}
+
+func (x *_MsgProfilesRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgProfilesRequest_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgProfilesRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgProfilesRequest           protoreflect.MessageDescriptor
+	fd_MsgProfilesRequest_type_urls protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_node_v1beta1_profiler_proto_init()
+	md_MsgProfilesRequest = File_cosmos_base_node_v1beta1_profiler_proto.Messages().ByName("MsgProfilesRequest")
+	fd_MsgProfilesRequest_type_urls = md_MsgProfilesRequest.Fields().ByName("type_urls")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgProfilesRequest)(nil)
+
+type fastReflection_MsgProfilesRequest MsgProfilesRequest
+
+func (x *MsgProfilesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProfilesRequest)(x)
+}
+
+func (x *MsgProfilesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_node_v1beta1_profiler_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgProfilesRequest_messageType fastReflection_MsgProfilesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProfilesRequest_messageType{}
+
+type fastReflection_MsgProfilesRequest_messageType struct{}
+
+func (x fastReflection_MsgProfilesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProfilesRequest)(nil)
+}
+func (x fastReflection_MsgProfilesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProfilesRequest)
+}
+func (x fastReflection_MsgProfilesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProfilesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProfilesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProfilesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProfilesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProfilesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProfilesRequest) New() protoreflect.Message {
+	return new(fastReflection_MsgProfilesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProfilesRequest) Interface() protoreflect.ProtoMessage {
+	return (*MsgProfilesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProfilesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TypeUrls) != 0 {
+		value := protoreflect.ValueOfList(&_MsgProfilesRequest_1_list{list: &x.TypeUrls})
+		if !f(fd_MsgProfilesRequest_type_urls, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProfilesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesRequest.type_urls":
+		return len(x.TypeUrls) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfilesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesRequest.type_urls":
+		x.TypeUrls = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProfilesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesRequest.type_urls":
+		if len(x.TypeUrls) == 0 {
+			return protoreflect.ValueOfList(&_MsgProfilesRequest_1_list{})
+		}
+		listValue := &_MsgProfilesRequest_1_list{list: &x.TypeUrls}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfilesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesRequest.type_urls":
+		lv := value.List()
+		clv := lv.(*_MsgProfilesRequest_1_list)
+		x.TypeUrls = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfilesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesRequest.type_urls":
+		if x.TypeUrls == nil {
+			x.TypeUrls = []string{}
+		}
+		value := &_MsgProfilesRequest_1_list{list: &x.TypeUrls}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProfilesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesRequest.type_urls":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgProfilesRequest_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProfilesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.node.v1beta1.MsgProfilesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProfilesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfilesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProfilesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProfilesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProfilesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.TypeUrls) > 0 {
+			for _, s := range x.TypeUrls {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProfilesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TypeUrls) > 0 {
+			for iNdEx := len(x.TypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.TypeUrls[iNdEx])
+				copy(dAtA[i:], x.TypeUrls[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TypeUrls[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProfilesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProfilesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProfilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TypeUrls", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TypeUrls = append(x.TypeUrls, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgProfilesResponse_3_list)(nil)
+
+type _MsgProfilesResponse_3_list struct {
+	list *[]*MsgProfile
+}
+
+func (x *_MsgProfilesResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgProfilesResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgProfilesResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgProfile)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgProfilesResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgProfile)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgProfilesResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(MsgProfile)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgProfilesResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgProfilesResponse_3_list) NewElement() protoreflect.Value {
+	v := new(MsgProfile)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgProfilesResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgProfilesResponse              protoreflect.MessageDescriptor
+	fd_MsgProfilesResponse_height       protoreflect.FieldDescriptor
+	fd_MsgProfilesResponse_since_height protoreflect.FieldDescriptor
+	fd_MsgProfilesResponse_profiles     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_node_v1beta1_profiler_proto_init()
+	md_MsgProfilesResponse = File_cosmos_base_node_v1beta1_profiler_proto.Messages().ByName("MsgProfilesResponse")
+	fd_MsgProfilesResponse_height = md_MsgProfilesResponse.Fields().ByName("height")
+	fd_MsgProfilesResponse_since_height = md_MsgProfilesResponse.Fields().ByName("since_height")
+	fd_MsgProfilesResponse_profiles = md_MsgProfilesResponse.Fields().ByName("profiles")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgProfilesResponse)(nil)
+
+type fastReflection_MsgProfilesResponse MsgProfilesResponse
+
+func (x *MsgProfilesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProfilesResponse)(x)
+}
+
+func (x *MsgProfilesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_node_v1beta1_profiler_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgProfilesResponse_messageType fastReflection_MsgProfilesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProfilesResponse_messageType{}
+
+type fastReflection_MsgProfilesResponse_messageType struct{}
+
+func (x fastReflection_MsgProfilesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProfilesResponse)(nil)
+}
+func (x fastReflection_MsgProfilesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProfilesResponse)
+}
+func (x fastReflection_MsgProfilesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProfilesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProfilesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProfilesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProfilesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProfilesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProfilesResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgProfilesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProfilesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgProfilesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProfilesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_MsgProfilesResponse_height, value) {
+			return
+		}
+	}
+	if x.SinceHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SinceHeight)
+		if !f(fd_MsgProfilesResponse_since_height, value) {
+			return
+		}
+	}
+	if len(x.Profiles) != 0 {
+		value := protoreflect.ValueOfList(&_MsgProfilesResponse_3_list{list: &x.Profiles})
+		if !f(fd_MsgProfilesResponse_profiles, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProfilesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.height":
+		return x.Height != int64(0)
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.since_height":
+		return x.SinceHeight != int64(0)
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.profiles":
+		return len(x.Profiles) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfilesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.height":
+		x.Height = int64(0)
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.since_height":
+		x.SinceHeight = int64(0)
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.profiles":
+		x.Profiles = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProfilesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.since_height":
+		value := x.SinceHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.profiles":
+		if len(x.Profiles) == 0 {
+			return protoreflect.ValueOfList(&_MsgProfilesResponse_3_list{})
+		}
+		listValue := &_MsgProfilesResponse_3_list{list: &x.Profiles}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfilesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.height":
+		x.Height = value.Int()
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.since_height":
+		x.SinceHeight = value.Int()
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.profiles":
+		lv := value.List()
+		clv := lv.(*_MsgProfilesResponse_3_list)
+		x.Profiles = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfilesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.profiles":
+		if x.Profiles == nil {
+			x.Profiles = []*MsgProfile{}
+		}
+		value := &_MsgProfilesResponse_3_list{list: &x.Profiles}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.height":
+		panic(fmt.Errorf("field height of message cosmos.base.node.v1beta1.MsgProfilesResponse is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.since_height":
+		panic(fmt.Errorf("field since_height of message cosmos.base.node.v1beta1.MsgProfilesResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProfilesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.since_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.base.node.v1beta1.MsgProfilesResponse.profiles":
+		list := []*MsgProfile{}
+		return protoreflect.ValueOfList(&_MsgProfilesResponse_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfilesResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfilesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProfilesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.node.v1beta1.MsgProfilesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProfilesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfilesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProfilesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProfilesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProfilesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.SinceHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SinceHeight))
+		}
+		if len(x.Profiles) > 0 {
+			for _, e := range x.Profiles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProfilesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Profiles) > 0 {
+			for iNdEx := len(x.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Profiles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.SinceHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SinceHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProfilesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProfilesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProfilesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+				}
+				x.SinceHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SinceHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Profiles = append(x.Profiles, &MsgProfile{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Profiles[len(x.Profiles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgProfile                  protoreflect.MessageDescriptor
+	fd_MsgProfile_type_url         protoreflect.FieldDescriptor
+	fd_MsgProfile_count            protoreflect.FieldDescriptor
+	fd_MsgProfile_failed           protoreflect.FieldDescriptor
+	fd_MsgProfile_wall_time_ns     protoreflect.FieldDescriptor
+	fd_MsgProfile_max_wall_time_ns protoreflect.FieldDescriptor
+	fd_MsgProfile_reads            protoreflect.FieldDescriptor
+	fd_MsgProfile_iterated         protoreflect.FieldDescriptor
+	fd_MsgProfile_writes           protoreflect.FieldDescriptor
+	fd_MsgProfile_read_bytes       protoreflect.FieldDescriptor
+	fd_MsgProfile_written_bytes    protoreflect.FieldDescriptor
+	fd_MsgProfile_metered_gas      protoreflect.FieldDescriptor
+	fd_MsgProfile_consumed_gas     protoreflect.FieldDescriptor
+	fd_MsgProfile_charged_gas      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_base_node_v1beta1_profiler_proto_init()
+	md_MsgProfile = File_cosmos_base_node_v1beta1_profiler_proto.Messages().ByName("MsgProfile")
+	fd_MsgProfile_type_url = md_MsgProfile.Fields().ByName("type_url")
+	fd_MsgProfile_count = md_MsgProfile.Fields().ByName("count")
+	fd_MsgProfile_failed = md_MsgProfile.Fields().ByName("failed")
+	fd_MsgProfile_wall_time_ns = md_MsgProfile.Fields().ByName("wall_time_ns")
+	fd_MsgProfile_max_wall_time_ns = md_MsgProfile.Fields().ByName("max_wall_time_ns")
+	fd_MsgProfile_reads = md_MsgProfile.Fields().ByName("reads")
+	fd_MsgProfile_iterated = md_MsgProfile.Fields().ByName("iterated")
+	fd_MsgProfile_writes = md_MsgProfile.Fields().ByName("writes")
+	fd_MsgProfile_read_bytes = md_MsgProfile.Fields().ByName("read_bytes")
+	fd_MsgProfile_written_bytes = md_MsgProfile.Fields().ByName("written_bytes")
+	fd_MsgProfile_metered_gas = md_MsgProfile.Fields().ByName("metered_gas")
+	fd_MsgProfile_consumed_gas = md_MsgProfile.Fields().ByName("consumed_gas")
+	fd_MsgProfile_charged_gas = md_MsgProfile.Fields().ByName("charged_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgProfile)(nil)
+
+type fastReflection_MsgProfile MsgProfile
+
+func (x *MsgProfile) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProfile)(x)
+}
+
+func (x *MsgProfile) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_base_node_v1beta1_profiler_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgProfile_messageType fastReflection_MsgProfile_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProfile_messageType{}
+
+type fastReflection_MsgProfile_messageType struct{}
+
+func (x fastReflection_MsgProfile_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProfile)(nil)
+}
+func (x fastReflection_MsgProfile_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProfile)
+}
+func (x fastReflection_MsgProfile_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProfile
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProfile) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProfile
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProfile) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProfile_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProfile) New() protoreflect.Message {
+	return new(fastReflection_MsgProfile)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProfile) Interface() protoreflect.ProtoMessage {
+	return (*MsgProfile)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProfile) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TypeUrl != "" {
+		value := protoreflect.ValueOfString(x.TypeUrl)
+		if !f(fd_MsgProfile_type_url, value) {
+			return
+		}
+	}
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_MsgProfile_count, value) {
+			return
+		}
+	}
+	if x.Failed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Failed)
+		if !f(fd_MsgProfile_failed, value) {
+			return
+		}
+	}
+	if x.WallTimeNs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WallTimeNs)
+		if !f(fd_MsgProfile_wall_time_ns, value) {
+			return
+		}
+	}
+	if x.MaxWallTimeNs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxWallTimeNs)
+		if !f(fd_MsgProfile_max_wall_time_ns, value) {
+			return
+		}
+	}
+	if x.Reads != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Reads)
+		if !f(fd_MsgProfile_reads, value) {
+			return
+		}
+	}
+	if x.Iterated != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Iterated)
+		if !f(fd_MsgProfile_iterated, value) {
+			return
+		}
+	}
+	if x.Writes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Writes)
+		if !f(fd_MsgProfile_writes, value) {
+			return
+		}
+	}
+	if x.ReadBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReadBytes)
+		if !f(fd_MsgProfile_read_bytes, value) {
+			return
+		}
+	}
+	if x.WrittenBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WrittenBytes)
+		if !f(fd_MsgProfile_written_bytes, value) {
+			return
+		}
+	}
+	if x.MeteredGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MeteredGas)
+		if !f(fd_MsgProfile_metered_gas, value) {
+			return
+		}
+	}
+	if x.ConsumedGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ConsumedGas)
+		if !f(fd_MsgProfile_consumed_gas, value) {
+			return
+		}
+	}
+	if x.ChargedGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChargedGas)
+		if !f(fd_MsgProfile_charged_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProfile) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfile.type_url":
+		return x.TypeUrl != ""
+	case "cosmos.base.node.v1beta1.MsgProfile.count":
+		return x.Count != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.failed":
+		return x.Failed != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.wall_time_ns":
+		return x.WallTimeNs != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.max_wall_time_ns":
+		return x.MaxWallTimeNs != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.reads":
+		return x.Reads != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.iterated":
+		return x.Iterated != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.writes":
+		return x.Writes != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.read_bytes":
+		return x.ReadBytes != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.written_bytes":
+		return x.WrittenBytes != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.metered_gas":
+		return x.MeteredGas != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.consumed_gas":
+		return x.ConsumedGas != uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.charged_gas":
+		return x.ChargedGas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfile"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfile) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfile.type_url":
+		x.TypeUrl = ""
+	case "cosmos.base.node.v1beta1.MsgProfile.count":
+		x.Count = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.failed":
+		x.Failed = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.wall_time_ns":
+		x.WallTimeNs = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.max_wall_time_ns":
+		x.MaxWallTimeNs = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.reads":
+		x.Reads = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.iterated":
+		x.Iterated = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.writes":
+		x.Writes = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.read_bytes":
+		x.ReadBytes = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.written_bytes":
+		x.WrittenBytes = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.metered_gas":
+		x.MeteredGas = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.consumed_gas":
+		x.ConsumedGas = uint64(0)
+	case "cosmos.base.node.v1beta1.MsgProfile.charged_gas":
+		x.ChargedGas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfile"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProfile) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfile.type_url":
+		value := x.TypeUrl
+		return protoreflect.ValueOfString(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.failed":
+		value := x.Failed
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.wall_time_ns":
+		value := x.WallTimeNs
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.max_wall_time_ns":
+		value := x.MaxWallTimeNs
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.reads":
+		value := x.Reads
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.iterated":
+		value := x.Iterated
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.writes":
+		value := x.Writes
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.read_bytes":
+		value := x.ReadBytes
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.written_bytes":
+		value := x.WrittenBytes
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.metered_gas":
+		value := x.MeteredGas
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.consumed_gas":
+		value := x.ConsumedGas
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.base.node.v1beta1.MsgProfile.charged_gas":
+		value := x.ChargedGas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfile"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfile does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfile) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfile.type_url":
+		x.TypeUrl = value.Interface().(string)
+	case "cosmos.base.node.v1beta1.MsgProfile.count":
+		x.Count = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.failed":
+		x.Failed = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.wall_time_ns":
+		x.WallTimeNs = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.max_wall_time_ns":
+		x.MaxWallTimeNs = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.reads":
+		x.Reads = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.iterated":
+		x.Iterated = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.writes":
+		x.Writes = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.read_bytes":
+		x.ReadBytes = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.written_bytes":
+		x.WrittenBytes = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.metered_gas":
+		x.MeteredGas = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.consumed_gas":
+		x.ConsumedGas = value.Uint()
+	case "cosmos.base.node.v1beta1.MsgProfile.charged_gas":
+		x.ChargedGas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfile"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfile) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfile.type_url":
+		panic(fmt.Errorf("field type_url of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.count":
+		panic(fmt.Errorf("field count of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.failed":
+		panic(fmt.Errorf("field failed of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.wall_time_ns":
+		panic(fmt.Errorf("field wall_time_ns of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.max_wall_time_ns":
+		panic(fmt.Errorf("field max_wall_time_ns of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.reads":
+		panic(fmt.Errorf("field reads of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.iterated":
+		panic(fmt.Errorf("field iterated of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.writes":
+		panic(fmt.Errorf("field writes of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.read_bytes":
+		panic(fmt.Errorf("field read_bytes of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.written_bytes":
+		panic(fmt.Errorf("field written_bytes of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.metered_gas":
+		panic(fmt.Errorf("field metered_gas of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.consumed_gas":
+		panic(fmt.Errorf("field consumed_gas of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	case "cosmos.base.node.v1beta1.MsgProfile.charged_gas":
+		panic(fmt.Errorf("field charged_gas of message cosmos.base.node.v1beta1.MsgProfile is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfile"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProfile) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.base.node.v1beta1.MsgProfile.type_url":
+		return protoreflect.ValueOfString("")
+	case "cosmos.base.node.v1beta1.MsgProfile.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.failed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.wall_time_ns":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.max_wall_time_ns":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.reads":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.iterated":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.writes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.read_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.written_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.metered_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.consumed_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.base.node.v1beta1.MsgProfile.charged_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.base.node.v1beta1.MsgProfile"))
+		}
+		panic(fmt.Errorf("message cosmos.base.node.v1beta1.MsgProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProfile) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.base.node.v1beta1.MsgProfile", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProfile) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProfile) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProfile) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProfile) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProfile)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.Failed != 0 {
+			n += 1 + runtime.Sov(uint64(x.Failed))
+		}
+		if x.WallTimeNs != 0 {
+			n += 1 + runtime.Sov(uint64(x.WallTimeNs))
+		}
+		if x.MaxWallTimeNs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxWallTimeNs))
+		}
+		if x.Reads != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reads))
+		}
+		if x.Iterated != 0 {
+			n += 1 + runtime.Sov(uint64(x.Iterated))
+		}
+		if x.Writes != 0 {
+			n += 1 + runtime.Sov(uint64(x.Writes))
+		}
+		if x.ReadBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReadBytes))
+		}
+		if x.WrittenBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.WrittenBytes))
+		}
+		if x.MeteredGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MeteredGas))
+		}
+		if x.ConsumedGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConsumedGas))
+		}
+		if x.ChargedGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChargedGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProfile)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ChargedGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChargedGas))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.ConsumedGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsumedGas))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.MeteredGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MeteredGas))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.WrittenBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WrittenBytes))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ReadBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReadBytes))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Writes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Writes))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Iterated != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Iterated))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Reads != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reads))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxWallTimeNs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxWallTimeNs))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.WallTimeNs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WallTimeNs))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Failed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Failed))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.TypeUrl) > 0 {
+			i -= len(x.TypeUrl)
+			copy(dAtA[i:], x.TypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProfile)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProfile: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+				}
+				x.Failed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Failed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WallTimeNs", wireType)
+				}
+				x.WallTimeNs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WallTimeNs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxWallTimeNs", wireType)
+				}
+				x.MaxWallTimeNs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxWallTimeNs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+				}
+				x.Reads = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reads |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Iterated", wireType)
+				}
+				x.Iterated = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Iterated |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+				}
+				x.Writes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Writes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
+				}
+				x.ReadBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReadBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WrittenBytes", wireType)
+				}
+				x.WrittenBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WrittenBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MeteredGas", wireType)
+				}
+				x.MeteredGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MeteredGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsumedGas", wireType)
+				}
+				x.ConsumedGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConsumedGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChargedGas", wireType)
+				}
+				x.ChargedGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChargedGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/base/node/v1beta1/profiler.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgProfilesRequest defines the request structure for the MsgProfiles gRPC query.
+type MsgProfilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type_urls filters the profiles of the msgs of these type URLs if it isn't empty.
+	TypeUrls []string `protobuf:"bytes,1,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty"`
+}
+
+func (x *MsgProfilesRequest) Reset() {
+	*x = MsgProfilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_node_v1beta1_profiler_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgProfilesRequest) ProtoMessage() {}
+
+// Deprecated: Use MsgProfilesRequest.ProtoReflect.Descriptor instead.
+func (*MsgProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_node_v1beta1_profiler_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgProfilesRequest) GetTypeUrls() []string {
+	if x != nil {
+		return x.TypeUrls
+	}
+	return nil
+}
+
+// MsgProfilesResponse defines the response structure for the MsgProfiles gRPC query.
+type MsgProfilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the height at which the profiles were queried.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// since_height is the height of the first msg profiled.
+	SinceHeight int64 `protobuf:"varint,2,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+	// profiles are the profiles of the msg types sorted by type URL.
+	Profiles []*MsgProfile `protobuf:"bytes,3,rep,name=profiles,proto3" json:"profiles,omitempty"`
+}
+
+func (x *MsgProfilesResponse) Reset() {
+	*x = MsgProfilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_node_v1beta1_profiler_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgProfilesResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgProfilesResponse.ProtoReflect.Descriptor instead.
+func (*MsgProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_node_v1beta1_profiler_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgProfilesResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MsgProfilesResponse) GetSinceHeight() int64 {
+	if x != nil {
+		return x.SinceHeight
+	}
+	return 0
+}
+
+func (x *MsgProfilesResponse) GetProfiles() []*MsgProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+// MsgProfile aggregates the executions of the delivered msgs of a type.
+type MsgProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// count is the number of msgs executed.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// failed is the number of msgs whose handler returned an error.
+	Failed uint64 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// wall_time_ns is the total duration of the executions in nanoseconds.
+	WallTimeNs uint64 `protobuf:"varint,4,opt,name=wall_time_ns,json=wallTimeNs,proto3" json:"wall_time_ns,omitempty"`
+	// max_wall_time_ns is the duration of the longest execution in nanoseconds.
+	MaxWallTimeNs uint64 `protobuf:"varint,5,opt,name=max_wall_time_ns,json=maxWallTimeNs,proto3" json:"max_wall_time_ns,omitempty"`
+	// reads counts the Get and Has calls on the KV stores.
+	Reads uint64 `protobuf:"varint,6,opt,name=reads,proto3" json:"reads,omitempty"`
+	// iterated counts the entries of the KV stores iterated over.
+	Iterated uint64 `protobuf:"varint,7,opt,name=iterated,proto3" json:"iterated,omitempty"`
+	// writes counts the Set and Delete calls on the KV stores.
+	Writes uint64 `protobuf:"varint,8,opt,name=writes,proto3" json:"writes,omitempty"`
+	// read_bytes is the size of the keys and values read.
+	ReadBytes uint64 `protobuf:"varint,9,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	// written_bytes is the size of the keys and values written.
+	WrittenBytes uint64 `protobuf:"varint,10,opt,name=written_bytes,json=writtenBytes,proto3" json:"written_bytes,omitempty"`
+	// metered_gas is the gas the gaskv stores metered for the store accesses, which is not consumed.
+	MeteredGas uint64 `protobuf:"varint,11,opt,name=metered_gas,json=meteredGas,proto3" json:"metered_gas,omitempty"`
+	// consumed_gas is the gas the executions consumed explicitly.
+	ConsumedGas uint64 `protobuf:"varint,12,opt,name=consumed_gas,json=consumedGas,proto3" json:"consumed_gas,omitempty"`
+	// charged_gas is the gas charged for the msgs by their type, by the gashub module.
+	ChargedGas uint64 `protobuf:"varint,13,opt,name=charged_gas,json=chargedGas,proto3" json:"charged_gas,omitempty"`
+}
+
+func (x *MsgProfile) Reset() {
+	*x = MsgProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_base_node_v1beta1_profiler_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgProfile) ProtoMessage() {}
+
+// Deprecated: Use MsgProfile.ProtoReflect.Descriptor instead.
+func (*MsgProfile) Descriptor() ([]byte, []int) {
+	return file_cosmos_base_node_v1beta1_profiler_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgProfile) GetTypeUrl() string {
+	if x != nil {
+		return x.TypeUrl
+	}
+	return ""
+}
+
+func (x *MsgProfile) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetWallTimeNs() uint64 {
+	if x != nil {
+		return x.WallTimeNs
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetMaxWallTimeNs() uint64 {
+	if x != nil {
+		return x.MaxWallTimeNs
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetReads() uint64 {
+	if x != nil {
+		return x.Reads
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetIterated() uint64 {
+	if x != nil {
+		return x.Iterated
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetWrites() uint64 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetReadBytes() uint64 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetWrittenBytes() uint64 {
+	if x != nil {
+		return x.WrittenBytes
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetMeteredGas() uint64 {
+	if x != nil {
+		return x.MeteredGas
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetConsumedGas() uint64 {
+	if x != nil {
+		return x.ConsumedGas
+	}
+	return 0
+}
+
+func (x *MsgProfile) GetChargedGas() uint64 {
+	if x != nil {
+		return x.ChargedGas
+	}
+	return 0
+}
+
+var File_cosmos_base_node_v1beta1_profiler_proto protoreflect.FileDescriptor
+
+var file_cosmos_base_node_v1beta1_profiler_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x31, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65,
+	0x55, 0x72, 0x6c, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x93, 0x03, 0x0a, 0x0a, 0x4d, 0x73,
+	0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x79, 0x70, 0x65,
+	0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x12, 0x20, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x77, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x4e, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x57, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4e, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x77, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x47, 0x61, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x47, 0x61, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x47, 0x61, 0x73, 0x32,
+	0xa7, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x9a, 0x01, 0x0a,
+	0x0b, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x12, 0x26, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d, 0x73, 0x67,
+	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x42, 0xe7, 0x01, 0x0a, 0x1c, 0x63, 0x6f,
+	0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x6e, 0x6f,
+	0x64, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0d, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6e, 0x6f, 0x64, 0x65, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x42, 0x4e, 0xaa, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xca, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73,
+	0x65, 0x5c, 0x4e, 0x6f, 0x64, 0x65, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
+	0x24, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x4e, 0x6f, 0x64,
+	0x65, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
+	0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x4e, 0x6f, 0x64, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cosmos_base_node_v1beta1_profiler_proto_rawDescOnce sync.Once
+	file_cosmos_base_node_v1beta1_profiler_proto_rawDescData = file_cosmos_base_node_v1beta1_profiler_proto_rawDesc
+)
+
+func file_cosmos_base_node_v1beta1_profiler_proto_rawDescGZIP() []byte {
+	file_cosmos_base_node_v1beta1_profiler_proto_rawDescOnce.Do(func() {
+		file_cosmos_base_node_v1beta1_profiler_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_base_node_v1beta1_profiler_proto_rawDescData)
+	})
+	return file_cosmos_base_node_v1beta1_profiler_proto_rawDescData
+}
+
+var file_cosmos_base_node_v1beta1_profiler_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cosmos_base_node_v1beta1_profiler_proto_goTypes = []interface{}{
+	(*MsgProfilesRequest)(nil),  // 0: cosmos.base.node.v1beta1.MsgProfilesRequest
+	(*MsgProfilesResponse)(nil), // 1: cosmos.base.node.v1beta1.MsgProfilesResponse
+	(*MsgProfile)(nil),          // 2: cosmos.base.node.v1beta1.MsgProfile
+}
+var file_cosmos_base_node_v1beta1_profiler_proto_depIdxs = []int32{
+	2, // 0: cosmos.base.node.v1beta1.MsgProfilesResponse.profiles:type_name -> cosmos.base.node.v1beta1.MsgProfile
+	0, // 1: cosmos.base.node.v1beta1.Profiler.MsgProfiles:input_type -> cosmos.base.node.v1beta1.MsgProfilesRequest
+	1, // 2: cosmos.base.node.v1beta1.Profiler.MsgProfiles:output_type -> cosmos.base.node.v1beta1.MsgProfilesResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_base_node_v1beta1_profiler_proto_init() }
+func file_cosmos_base_node_v1beta1_profiler_proto_init() {
+	if File_cosmos_base_node_v1beta1_profiler_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_base_node_v1beta1_profiler_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProfilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_node_v1beta1_profiler_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProfilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_base_node_v1beta1_profiler_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cosmos_base_node_v1beta1_profiler_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cosmos_base_node_v1beta1_profiler_proto_goTypes,
+		DependencyIndexes: file_cosmos_base_node_v1beta1_profiler_proto_depIdxs,
+		MessageInfos:      file_cosmos_base_node_v1beta1_profiler_proto_msgTypes,
+	}.Build()
+	File_cosmos_base_node_v1beta1_profiler_proto = out.File
+	file_cosmos_base_node_v1beta1_profiler_proto_rawDesc = nil
+	file_cosmos_base_node_v1beta1_profiler_proto_goTypes = nil
+	file_cosmos_base_node_v1beta1_profiler_proto_depIdxs = nil
+}
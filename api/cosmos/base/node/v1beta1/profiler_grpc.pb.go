@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/base/node/v1beta1/profiler.proto
+
+package nodev1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Profiler_MsgProfiles_FullMethodName = "/cosmos.base.node.v1beta1.Profiler/MsgProfiles"
+)
+
+// ProfilerClient is the client API for Profiler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProfilerClient interface {
+	// MsgProfiles queries for the execution profiles of the msgs delivered since the node started by type URL, with
+	// the gas charged for them by their type next to the gas their execution consumed, which requires msg-profiling
+	// to be set in app.toml.
+	MsgProfiles(ctx context.Context, in *MsgProfilesRequest, opts ...grpc.CallOption) (*MsgProfilesResponse, error)
+}
+
+type profilerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProfilerClient(cc grpc.ClientConnInterface) ProfilerClient {
+	return &profilerClient{cc}
+}
+
+func (c *profilerClient) MsgProfiles(ctx context.Context, in *MsgProfilesRequest, opts ...grpc.CallOption) (*MsgProfilesResponse, error) {
+	out := new(MsgProfilesResponse)
+	err := c.cc.Invoke(ctx, Profiler_MsgProfiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfilerServer is the server API for Profiler service.
+// All implementations must embed UnimplementedProfilerServer
+// for forward compatibility
+type ProfilerServer interface {
+	// MsgProfiles queries for the execution profiles of the msgs delivered since the node started by type URL, with
+	// the gas charged for them by their type next to the gas their execution consumed, which requires msg-profiling
+	// to be set in app.toml.
+	MsgProfiles(context.Context, *MsgProfilesRequest) (*MsgProfilesResponse, error)
+	mustEmbedUnimplementedProfilerServer()
+}
+
+// UnimplementedProfilerServer must be embedded to have forward compatible implementations.
+type UnimplementedProfilerServer struct {
+}
+
+func (UnimplementedProfilerServer) MsgProfiles(context.Context, *MsgProfilesRequest) (*MsgProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgProfiles not implemented")
+}
+func (UnimplementedProfilerServer) mustEmbedUnimplementedProfilerServer() {}
+
+// UnsafeProfilerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProfilerServer will
+// result in compilation errors.
+type UnsafeProfilerServer interface {
+	mustEmbedUnimplementedProfilerServer()
+}
+
+func RegisterProfilerServer(s grpc.ServiceRegistrar, srv ProfilerServer) {
+	s.RegisterService(&Profiler_ServiceDesc, srv)
+}
+
+func _Profiler_MsgProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilerServer).MsgProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profiler_MsgProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilerServer).MsgProfiles(ctx, req.(*MsgProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Profiler_ServiceDesc is the grpc.ServiceDesc for Profiler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Profiler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.node.v1beta1.Profiler",
+	HandlerType: (*ProfilerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MsgProfiles",
+			Handler:    _Profiler_MsgProfiles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/node/v1beta1/profiler.proto",
+}
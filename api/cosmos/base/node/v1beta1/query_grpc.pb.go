@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Config_FullMethodName = "/cosmos.base.node.v1beta1.Service/Config"
+	Service_Config_FullMethodName        = "/cosmos.base.node.v1beta1.Service/Config"
+	Service_StateSize_FullMethodName     = "/cosmos.base.node.v1beta1.Service/StateSize"
+	Service_PinnedHeights_FullMethodName = "/cosmos.base.node.v1beta1.Service/PinnedHeights"
 )
 
 // ServiceClient is the client API for Service service.
@@ -28,6 +30,12 @@ const (
 type ServiceClient interface {
 	// Config queries for the operator configuration.
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
+	// StateSize queries for the key count and byte size of the persisted stores at a height, which requires
+	// state-size-tracking to be enabled.
+	StateSize(ctx context.Context, in *StateSizeRequest, opts ...grpc.CallOption) (*StateSizeResponse, error)
+	// PinnedHeights queries for the heights kept from pruning by the retention constraints of the modules, with
+	// the reason of each.
+	PinnedHeights(ctx context.Context, in *PinnedHeightsRequest, opts ...grpc.CallOption) (*PinnedHeightsResponse, error)
 }
 
 type serviceClient struct {
@@ -47,12 +55,36 @@ func (c *serviceClient) Config(ctx context.Context, in *ConfigRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) StateSize(ctx context.Context, in *StateSizeRequest, opts ...grpc.CallOption) (*StateSizeResponse, error) {
+	out := new(StateSizeResponse)
+	err := c.cc.Invoke(ctx, Service_StateSize_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) PinnedHeights(ctx context.Context, in *PinnedHeightsRequest, opts ...grpc.CallOption) (*PinnedHeightsResponse, error) {
+	out := new(PinnedHeightsResponse)
+	err := c.cc.Invoke(ctx, Service_PinnedHeights_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
 type ServiceServer interface {
 	// Config queries for the operator configuration.
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
+	// StateSize queries for the key count and byte size of the persisted stores at a height, which requires
+	// state-size-tracking to be enabled.
+	StateSize(context.Context, *StateSizeRequest) (*StateSizeResponse, error)
+	// PinnedHeights queries for the heights kept from pruning by the retention constraints of the modules, with
+	// the reason of each.
+	PinnedHeights(context.Context, *PinnedHeightsRequest) (*PinnedHeightsResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -63,6 +95,12 @@ type UnimplementedServiceServer struct {
 func (UnimplementedServiceServer) Config(context.Context, *ConfigRequest) (*ConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
+func (UnimplementedServiceServer) StateSize(context.Context, *StateSizeRequest) (*StateSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateSize not implemented")
+}
+func (UnimplementedServiceServer) PinnedHeights(context.Context, *PinnedHeightsRequest) (*PinnedHeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinnedHeights not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +132,42 @@ func _Service_Config_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_StateSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).StateSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_StateSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).StateSize(ctx, req.(*StateSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_PinnedHeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinnedHeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PinnedHeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_PinnedHeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PinnedHeights(ctx, req.(*PinnedHeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +179,14 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Service_Config_Handler,
 		},
+		{
+			MethodName: "StateSize",
+			Handler:    _Service_StateSize_Handler,
+		},
+		{
+			MethodName: "PinnedHeights",
+			Handler:    _Service_PinnedHeights_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
@@ -3,6 +3,7 @@ package crosschainv1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -810,6 +811,7 @@ var (
 	fd_QueryCrossChainPackageRequest_dest_chain_id protoreflect.FieldDescriptor
 	fd_QueryCrossChainPackageRequest_channel_id    protoreflect.FieldDescriptor
 	fd_QueryCrossChainPackageRequest_sequence      protoreflect.FieldDescriptor
+	fd_QueryCrossChainPackageRequest_decode        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -818,6 +820,7 @@ func init() {
 	fd_QueryCrossChainPackageRequest_dest_chain_id = md_QueryCrossChainPackageRequest.Fields().ByName("dest_chain_id")
 	fd_QueryCrossChainPackageRequest_channel_id = md_QueryCrossChainPackageRequest.Fields().ByName("channel_id")
 	fd_QueryCrossChainPackageRequest_sequence = md_QueryCrossChainPackageRequest.Fields().ByName("sequence")
+	fd_QueryCrossChainPackageRequest_decode = md_QueryCrossChainPackageRequest.Fields().ByName("decode")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryCrossChainPackageRequest)(nil)
@@ -903,6 +906,12 @@ func (x *fastReflection_QueryCrossChainPackageRequest) Range(f func(protoreflect
 			return
 		}
 	}
+	if x.Decode != false {
+		value := protoreflect.ValueOfBool(x.Decode)
+		if !f(fd_QueryCrossChainPackageRequest_decode, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -924,6 +933,8 @@ func (x *fastReflection_QueryCrossChainPackageRequest) Has(fd protoreflect.Field
 		return x.ChannelId != uint32(0)
 	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.sequence":
 		return x.Sequence != uint64(0)
+	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.decode":
+		return x.Decode != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageRequest"))
@@ -946,6 +957,8 @@ func (x *fastReflection_QueryCrossChainPackageRequest) Clear(fd protoreflect.Fie
 		x.ChannelId = uint32(0)
 	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.sequence":
 		x.Sequence = uint64(0)
+	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.decode":
+		x.Decode = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageRequest"))
@@ -971,6 +984,9 @@ func (x *fastReflection_QueryCrossChainPackageRequest) Get(descriptor protorefle
 	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.sequence":
 		value := x.Sequence
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.decode":
+		value := x.Decode
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageRequest"))
@@ -997,6 +1013,8 @@ func (x *fastReflection_QueryCrossChainPackageRequest) Set(fd protoreflect.Field
 		x.ChannelId = uint32(value.Uint())
 	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.sequence":
 		x.Sequence = value.Uint()
+	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.decode":
+		x.Decode = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageRequest"))
@@ -1023,6 +1041,8 @@ func (x *fastReflection_QueryCrossChainPackageRequest) Mutable(fd protoreflect.F
 		panic(fmt.Errorf("field channel_id of message cosmos.crosschain.v1.QueryCrossChainPackageRequest is not mutable"))
 	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.sequence":
 		panic(fmt.Errorf("field sequence of message cosmos.crosschain.v1.QueryCrossChainPackageRequest is not mutable"))
+	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.decode":
+		panic(fmt.Errorf("field decode of message cosmos.crosschain.v1.QueryCrossChainPackageRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageRequest"))
@@ -1042,6 +1062,8 @@ func (x *fastReflection_QueryCrossChainPackageRequest) NewField(fd protoreflect.
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.sequence":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.crosschain.v1.QueryCrossChainPackageRequest.decode":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageRequest"))
@@ -1120,6 +1142,9 @@ func (x *fastReflection_QueryCrossChainPackageRequest) ProtoMethods() *protoifac
 		if x.Sequence != 0 {
 			n += 1 + runtime.Sov(uint64(x.Sequence))
 		}
+		if x.Decode {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1149,6 +1174,16 @@ func (x *fastReflection_QueryCrossChainPackageRequest) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Decode {
+			i--
+			if x.Decode {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.Sequence != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
 			i--
@@ -1175,7 +1210,987 @@ func (x *fastReflection_QueryCrossChainPackageRequest) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCrossChainPackageRequest)
+		x := input.Message.Interface().(*QueryCrossChainPackageRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCrossChainPackageRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCrossChainPackageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+				}
+				x.DestChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestChainId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				x.ChannelId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChannelId |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decode", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Decode = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCrossChainPackageResponse         protoreflect.MessageDescriptor
+	fd_QueryCrossChainPackageResponse_package protoreflect.FieldDescriptor
+	fd_QueryCrossChainPackageResponse_decoded protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crosschain_v1_query_proto_init()
+	md_QueryCrossChainPackageResponse = File_cosmos_crosschain_v1_query_proto.Messages().ByName("QueryCrossChainPackageResponse")
+	fd_QueryCrossChainPackageResponse_package = md_QueryCrossChainPackageResponse.Fields().ByName("package")
+	fd_QueryCrossChainPackageResponse_decoded = md_QueryCrossChainPackageResponse.Fields().ByName("decoded")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCrossChainPackageResponse)(nil)
+
+type fastReflection_QueryCrossChainPackageResponse QueryCrossChainPackageResponse
+
+func (x *QueryCrossChainPackageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCrossChainPackageResponse)(x)
+}
+
+func (x *QueryCrossChainPackageResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCrossChainPackageResponse_messageType fastReflection_QueryCrossChainPackageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCrossChainPackageResponse_messageType{}
+
+type fastReflection_QueryCrossChainPackageResponse_messageType struct{}
+
+func (x fastReflection_QueryCrossChainPackageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCrossChainPackageResponse)(nil)
+}
+func (x fastReflection_QueryCrossChainPackageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCrossChainPackageResponse)
+}
+func (x fastReflection_QueryCrossChainPackageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCrossChainPackageResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCrossChainPackageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCrossChainPackageResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCrossChainPackageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCrossChainPackageResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCrossChainPackageResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCrossChainPackageResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCrossChainPackageResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCrossChainPackageResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCrossChainPackageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Package) != 0 {
+		value := protoreflect.ValueOfBytes(x.Package)
+		if !f(fd_QueryCrossChainPackageResponse_package, value) {
+			return
+		}
+	}
+	if x.Decoded != "" {
+		value := protoreflect.ValueOfString(x.Decoded)
+		if !f(fd_QueryCrossChainPackageResponse_decoded, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCrossChainPackageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
+		return len(x.Package) != 0
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.decoded":
+		return x.Decoded != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCrossChainPackageResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
+		x.Package = nil
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.decoded":
+		x.Decoded = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCrossChainPackageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
+		value := x.Package
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.decoded":
+		value := x.Decoded
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCrossChainPackageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
+		x.Package = value.Bytes()
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.decoded":
+		x.Decoded = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCrossChainPackageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
+		panic(fmt.Errorf("field package of message cosmos.crosschain.v1.QueryCrossChainPackageResponse is not mutable"))
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.decoded":
+		panic(fmt.Errorf("field decoded of message cosmos.crosschain.v1.QueryCrossChainPackageResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCrossChainPackageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.decoded":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCrossChainPackageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crosschain.v1.QueryCrossChainPackageResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCrossChainPackageResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCrossChainPackageResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCrossChainPackageResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCrossChainPackageResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCrossChainPackageResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Package)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Decoded)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCrossChainPackageResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Decoded) > 0 {
+			i -= len(x.Decoded)
+			copy(dAtA[i:], x.Decoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Decoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Package) > 0 {
+			i -= len(x.Package)
+			copy(dAtA[i:], x.Package)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Package)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCrossChainPackageResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCrossChainPackageResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCrossChainPackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Package = append(x.Package[:0], dAtA[iNdEx:postIndex]...)
+				if x.Package == nil {
+					x.Package = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Decoded = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDecodeCrossChainPackageRequest            protoreflect.MessageDescriptor
+	fd_QueryDecodeCrossChainPackageRequest_channel_id protoreflect.FieldDescriptor
+	fd_QueryDecodeCrossChainPackageRequest_package    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crosschain_v1_query_proto_init()
+	md_QueryDecodeCrossChainPackageRequest = File_cosmos_crosschain_v1_query_proto.Messages().ByName("QueryDecodeCrossChainPackageRequest")
+	fd_QueryDecodeCrossChainPackageRequest_channel_id = md_QueryDecodeCrossChainPackageRequest.Fields().ByName("channel_id")
+	fd_QueryDecodeCrossChainPackageRequest_package = md_QueryDecodeCrossChainPackageRequest.Fields().ByName("package")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDecodeCrossChainPackageRequest)(nil)
+
+type fastReflection_QueryDecodeCrossChainPackageRequest QueryDecodeCrossChainPackageRequest
+
+func (x *QueryDecodeCrossChainPackageRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDecodeCrossChainPackageRequest)(x)
+}
+
+func (x *QueryDecodeCrossChainPackageRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDecodeCrossChainPackageRequest_messageType fastReflection_QueryDecodeCrossChainPackageRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDecodeCrossChainPackageRequest_messageType{}
+
+type fastReflection_QueryDecodeCrossChainPackageRequest_messageType struct{}
+
+func (x fastReflection_QueryDecodeCrossChainPackageRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDecodeCrossChainPackageRequest)(nil)
+}
+func (x fastReflection_QueryDecodeCrossChainPackageRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDecodeCrossChainPackageRequest)
+}
+func (x fastReflection_QueryDecodeCrossChainPackageRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDecodeCrossChainPackageRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDecodeCrossChainPackageRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDecodeCrossChainPackageRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDecodeCrossChainPackageRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDecodeCrossChainPackageRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChannelId != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ChannelId)
+		if !f(fd_QueryDecodeCrossChainPackageRequest_channel_id, value) {
+			return
+		}
+	}
+	if len(x.Package) != 0 {
+		value := protoreflect.ValueOfBytes(x.Package)
+		if !f(fd_QueryDecodeCrossChainPackageRequest_package, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.channel_id":
+		return x.ChannelId != uint32(0)
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.package":
+		return len(x.Package) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.channel_id":
+		x.ChannelId = uint32(0)
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.package":
+		x.Package = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.package":
+		value := x.Package
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.channel_id":
+		x.ChannelId = uint32(value.Uint())
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.package":
+		x.Package = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.channel_id":
+		panic(fmt.Errorf("field channel_id of message cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest is not mutable"))
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.package":
+		panic(fmt.Errorf("field package of message cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.channel_id":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest.package":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDecodeCrossChainPackageRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDecodeCrossChainPackageRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ChannelId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChannelId))
+		}
+		l = len(x.Package)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDecodeCrossChainPackageRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Package) > 0 {
+			i -= len(x.Package)
+			copy(dAtA[i:], x.Package)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Package)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ChannelId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChannelId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDecodeCrossChainPackageRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1207,32 +2222,13 @@ func (x *fastReflection_QueryCrossChainPackageRequest) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCrossChainPackageRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDecodeCrossChainPackageRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCrossChainPackageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDecodeCrossChainPackageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
-				}
-				x.DestChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DestChainId |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 				}
@@ -1251,11 +2247,11 @@ func (x *fastReflection_QueryCrossChainPackageRequest) ProtoMethods() *protoifac
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
 				}
-				x.Sequence = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1265,11 +2261,26 @@ func (x *fastReflection_QueryCrossChainPackageRequest) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Package = append(x.Package[:0], dAtA[iNdEx:postIndex]...)
+				if x.Package == nil {
+					x.Package = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1306,26 +2317,26 @@ func (x *fastReflection_QueryCrossChainPackageRequest) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryCrossChainPackageResponse         protoreflect.MessageDescriptor
-	fd_QueryCrossChainPackageResponse_package protoreflect.FieldDescriptor
+	md_QueryDecodeCrossChainPackageResponse         protoreflect.MessageDescriptor
+	fd_QueryDecodeCrossChainPackageResponse_decoded protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_crosschain_v1_query_proto_init()
-	md_QueryCrossChainPackageResponse = File_cosmos_crosschain_v1_query_proto.Messages().ByName("QueryCrossChainPackageResponse")
-	fd_QueryCrossChainPackageResponse_package = md_QueryCrossChainPackageResponse.Fields().ByName("package")
+	md_QueryDecodeCrossChainPackageResponse = File_cosmos_crosschain_v1_query_proto.Messages().ByName("QueryDecodeCrossChainPackageResponse")
+	fd_QueryDecodeCrossChainPackageResponse_decoded = md_QueryDecodeCrossChainPackageResponse.Fields().ByName("decoded")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCrossChainPackageResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryDecodeCrossChainPackageResponse)(nil)
 
-type fastReflection_QueryCrossChainPackageResponse QueryCrossChainPackageResponse
+type fastReflection_QueryDecodeCrossChainPackageResponse QueryDecodeCrossChainPackageResponse
 
-func (x *QueryCrossChainPackageResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCrossChainPackageResponse)(x)
+func (x *QueryDecodeCrossChainPackageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDecodeCrossChainPackageResponse)(x)
 }
 
-func (x *QueryCrossChainPackageResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[3]
+func (x *QueryDecodeCrossChainPackageResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,43 +2347,43 @@ func (x *QueryCrossChainPackageResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCrossChainPackageResponse_messageType fastReflection_QueryCrossChainPackageResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCrossChainPackageResponse_messageType{}
+var _fastReflection_QueryDecodeCrossChainPackageResponse_messageType fastReflection_QueryDecodeCrossChainPackageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDecodeCrossChainPackageResponse_messageType{}
 
-type fastReflection_QueryCrossChainPackageResponse_messageType struct{}
+type fastReflection_QueryDecodeCrossChainPackageResponse_messageType struct{}
 
-func (x fastReflection_QueryCrossChainPackageResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCrossChainPackageResponse)(nil)
+func (x fastReflection_QueryDecodeCrossChainPackageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDecodeCrossChainPackageResponse)(nil)
 }
-func (x fastReflection_QueryCrossChainPackageResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCrossChainPackageResponse)
+func (x fastReflection_QueryDecodeCrossChainPackageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDecodeCrossChainPackageResponse)
 }
-func (x fastReflection_QueryCrossChainPackageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCrossChainPackageResponse
+func (x fastReflection_QueryDecodeCrossChainPackageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDecodeCrossChainPackageResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCrossChainPackageResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCrossChainPackageResponse
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDecodeCrossChainPackageResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCrossChainPackageResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCrossChainPackageResponse_messageType
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDecodeCrossChainPackageResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCrossChainPackageResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCrossChainPackageResponse)
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDecodeCrossChainPackageResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCrossChainPackageResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCrossChainPackageResponse)(x)
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDecodeCrossChainPackageResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1380,10 +2391,10 @@ func (x *fastReflection_QueryCrossChainPackageResponse) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCrossChainPackageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Package) != 0 {
-		value := protoreflect.ValueOfBytes(x.Package)
-		if !f(fd_QueryCrossChainPackageResponse_package, value) {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Decoded != "" {
+		value := protoreflect.ValueOfString(x.Decoded)
+		if !f(fd_QueryDecodeCrossChainPackageResponse_decoded, value) {
 			return
 		}
 	}
@@ -1400,15 +2411,15 @@ func (x *fastReflection_QueryCrossChainPackageResponse) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCrossChainPackageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
-		return len(x.Package) != 0
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse.decoded":
+		return x.Decoded != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1418,15 +2429,15 @@ func (x *fastReflection_QueryCrossChainPackageResponse) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCrossChainPackageResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
-		x.Package = nil
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse.decoded":
+		x.Decoded = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1436,16 +2447,16 @@ func (x *fastReflection_QueryCrossChainPackageResponse) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCrossChainPackageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
-		value := x.Package
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse.decoded":
+		value := x.Decoded
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1459,15 +2470,15 @@ func (x *fastReflection_QueryCrossChainPackageResponse) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCrossChainPackageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
-		x.Package = value.Bytes()
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse.decoded":
+		x.Decoded = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1481,40 +2492,40 @@ func (x *fastReflection_QueryCrossChainPackageResponse) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCrossChainPackageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
-		panic(fmt.Errorf("field package of message cosmos.crosschain.v1.QueryCrossChainPackageResponse is not mutable"))
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse.decoded":
+		panic(fmt.Errorf("field decoded of message cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCrossChainPackageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.crosschain.v1.QueryCrossChainPackageResponse.package":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse.decoded":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryCrossChainPackageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryCrossChainPackageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCrossChainPackageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.crosschain.v1.QueryCrossChainPackageResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1522,7 +2533,7 @@ func (x *fastReflection_QueryCrossChainPackageResponse) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCrossChainPackageResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1533,7 +2544,7 @@ func (x *fastReflection_QueryCrossChainPackageResponse) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCrossChainPackageResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1545,7 +2556,7 @@ func (x *fastReflection_QueryCrossChainPackageResponse) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCrossChainPackageResponse) IsValid() bool {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1555,9 +2566,9 @@ func (x *fastReflection_QueryCrossChainPackageResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCrossChainPackageResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryDecodeCrossChainPackageResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCrossChainPackageResponse)
+		x := input.Message.Interface().(*QueryDecodeCrossChainPackageResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1569,7 +2580,7 @@ func (x *fastReflection_QueryCrossChainPackageResponse) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		l = len(x.Package)
+		l = len(x.Decoded)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1583,7 +2594,7 @@ func (x *fastReflection_QueryCrossChainPackageResponse) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCrossChainPackageResponse)
+		x := input.Message.Interface().(*QueryDecodeCrossChainPackageResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1602,10 +2613,10 @@ func (x *fastReflection_QueryCrossChainPackageResponse) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Package) > 0 {
-			i -= len(x.Package)
-			copy(dAtA[i:], x.Package)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Package)))
+		if len(x.Decoded) > 0 {
+			i -= len(x.Decoded)
+			copy(dAtA[i:], x.Decoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Decoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1620,7 +2631,7 @@ func (x *fastReflection_QueryCrossChainPackageResponse) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCrossChainPackageResponse)
+		x := input.Message.Interface().(*QueryDecodeCrossChainPackageResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1652,17 +2663,17 @@ func (x *fastReflection_QueryCrossChainPackageResponse) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCrossChainPackageResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDecodeCrossChainPackageResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCrossChainPackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDecodeCrossChainPackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1672,25 +2683,23 @@ func (x *fastReflection_QueryCrossChainPackageResponse) ProtoMethods() *protoifa
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Package = append(x.Package[:0], dAtA[iNdEx:postIndex]...)
-				if x.Package == nil {
-					x.Package = []byte{}
-				}
+				x.Decoded = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1749,7 +2758,7 @@ func (x *QuerySendSequenceRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QuerySendSequenceRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[4]
+	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2199,7 +3208,7 @@ func (x *QuerySendSequenceResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QuerySendSequenceResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[5]
+	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2605,7 +3614,7 @@ func (x *QueryReceiveSequenceRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryReceiveSequenceRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[6]
+	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3055,7 +4064,7 @@ func (x *QueryReceiveSequenceResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryReceiveSequenceResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[7]
+	mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3528,6 +4537,8 @@ type QueryCrossChainPackageRequest struct {
 	ChannelId uint32 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	// sequence of the cross chain package
 	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// decode defines whether to also return the decoded package
+	Decode bool `protobuf:"varint,4,opt,name=decode,proto3" json:"decode,omitempty"`
 }
 
 func (x *QueryCrossChainPackageRequest) Reset() {
@@ -3571,6 +4582,13 @@ func (x *QueryCrossChainPackageRequest) GetSequence() uint64 {
 	return 0
 }
 
+func (x *QueryCrossChainPackageRequest) GetDecode() bool {
+	if x != nil {
+		return x.Decode
+	}
+	return false
+}
+
 // QueryCrossChainPackageResponse is the response type for the Query/CrossChainPackage RPC method.
 type QueryCrossChainPackageResponse struct {
 	state         protoimpl.MessageState
@@ -3579,6 +4597,8 @@ type QueryCrossChainPackageResponse struct {
 
 	// content of the cross chain package
 	Package []byte `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// decoded package in JSON, if requested and the package exists
+	Decoded string `protobuf:"bytes,2,opt,name=decoded,proto3" json:"decoded,omitempty"`
 }
 
 func (x *QueryCrossChainPackageResponse) Reset() {
@@ -3608,6 +4628,96 @@ func (x *QueryCrossChainPackageResponse) GetPackage() []byte {
 	return nil
 }
 
+func (x *QueryCrossChainPackageResponse) GetDecoded() string {
+	if x != nil {
+		return x.Decoded
+	}
+	return ""
+}
+
+// QueryDecodeCrossChainPackageRequest is the request type for the Query/DecodeCrossChainPackage RPC method.
+type QueryDecodeCrossChainPackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// channel id of the cross chain package
+	ChannelId uint32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// content of the cross chain package, with its header
+	Package []byte `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+}
+
+func (x *QueryDecodeCrossChainPackageRequest) Reset() {
+	*x = QueryDecodeCrossChainPackageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDecodeCrossChainPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDecodeCrossChainPackageRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryDecodeCrossChainPackageRequest.ProtoReflect.Descriptor instead.
+func (*QueryDecodeCrossChainPackageRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_crosschain_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryDecodeCrossChainPackageRequest) GetChannelId() uint32 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *QueryDecodeCrossChainPackageRequest) GetPackage() []byte {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+// QueryDecodeCrossChainPackageResponse is the response type for the Query/DecodeCrossChainPackage RPC method.
+type QueryDecodeCrossChainPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// decoded package in JSON
+	Decoded string `protobuf:"bytes,1,opt,name=decoded,proto3" json:"decoded,omitempty"`
+}
+
+func (x *QueryDecodeCrossChainPackageResponse) Reset() {
+	*x = QueryDecodeCrossChainPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDecodeCrossChainPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDecodeCrossChainPackageResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryDecodeCrossChainPackageResponse.ProtoReflect.Descriptor instead.
+func (*QueryDecodeCrossChainPackageResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_crosschain_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryDecodeCrossChainPackageResponse) GetDecoded() string {
+	if x != nil {
+		return x.Decoded
+	}
+	return ""
+}
+
 // QuerySendSequenceRequest is the request type for the Query/SendSequence RPC method.
 type QuerySendSequenceRequest struct {
 	state         protoimpl.MessageState
@@ -3623,7 +4733,7 @@ type QuerySendSequenceRequest struct {
 func (x *QuerySendSequenceRequest) Reset() {
 	*x = QuerySendSequenceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[4]
+		mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3637,7 +4747,7 @@ func (*QuerySendSequenceRequest) ProtoMessage() {}
 
 // Deprecated: Use QuerySendSequenceRequest.ProtoReflect.Descriptor instead.
 func (*QuerySendSequenceRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_crosschain_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_crosschain_v1_query_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *QuerySendSequenceRequest) GetDestChainId() uint32 {
@@ -3667,7 +4777,7 @@ type QuerySendSequenceResponse struct {
 func (x *QuerySendSequenceResponse) Reset() {
 	*x = QuerySendSequenceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[5]
+		mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3681,7 +4791,7 @@ func (*QuerySendSequenceResponse) ProtoMessage() {}
 
 // Deprecated: Use QuerySendSequenceResponse.ProtoReflect.Descriptor instead.
 func (*QuerySendSequenceResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_crosschain_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_crosschain_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *QuerySendSequenceResponse) GetSequence() uint64 {
@@ -3706,7 +4816,7 @@ type QueryReceiveSequenceRequest struct {
 func (x *QueryReceiveSequenceRequest) Reset() {
 	*x = QueryReceiveSequenceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[6]
+		mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3720,7 +4830,7 @@ func (*QueryReceiveSequenceRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryReceiveSequenceRequest.ProtoReflect.Descriptor instead.
 func (*QueryReceiveSequenceRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_crosschain_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_crosschain_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *QueryReceiveSequenceRequest) GetDestChainId() uint32 {
@@ -3750,7 +4860,7 @@ type QueryReceiveSequenceResponse struct {
 func (x *QueryReceiveSequenceResponse) Reset() {
 	*x = QueryReceiveSequenceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[7]
+		mi := &file_cosmos_crosschain_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3764,7 +4874,7 @@ func (*QueryReceiveSequenceResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryReceiveSequenceResponse.ProtoReflect.Descriptor instead.
 func (*QueryReceiveSequenceResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_crosschain_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_crosschain_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryReceiveSequenceResponse) GetSequence() uint64 {
@@ -3780,105 +4890,134 @@ var file_cosmos_crosschain_v1_query_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68,
 	0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x13, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x7e, 0x0a, 0x1d,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
-	0x0d, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x3a, 0x0a, 0x1e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72,
+	0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14,
+	0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x73,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x54, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x73, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x13, 0xd2,
+	0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0x40, 0x0a, 0x24, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x5d, 0x0a,
+	0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x73,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x60, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x73,
 	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
 	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x22, 0x60, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x22, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x22, 0x3a, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x32, 0x8b,
-	0x05, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f,
-	0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
-	0x12, 0x1c, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xb1,
-	0x01, 0x0a, 0x11, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72,
-	0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x32, 0xd8, 0x06, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x83, 0x01,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73,
+	0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0xb1, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31,
 	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x72, 0x6f, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f,
-	0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f,
-	0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x63,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x72, 0x6f, 0x73, 0x73,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0xa8, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x53, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0xca, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x12, 0x39, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f,
+	0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x32, 0x12, 0x30, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x6f, 0x73,
+	0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63,
 	0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x53, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72,
-	0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0xcc, 0x01, 0x0a,
-	0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73,
-	0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x63,
-	0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43,
-	0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x6f,
-	0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63,
+	0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0xcc,
+	0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72,
+	0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31,
+	0x3b, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x43, 0x43, 0x58, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x6f, 0x73, 0x73,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43,
+	0x72, 0x6f, 0x73, 0x73, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3893,33 +5032,37 @@ func file_cosmos_crosschain_v1_query_proto_rawDescGZIP() []byte {
 	return file_cosmos_crosschain_v1_query_proto_rawDescData
 }
 
-var file_cosmos_crosschain_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_cosmos_crosschain_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_cosmos_crosschain_v1_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),             // 0: cosmos.crosschain.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),            // 1: cosmos.crosschain.v1.QueryParamsResponse
-	(*QueryCrossChainPackageRequest)(nil),  // 2: cosmos.crosschain.v1.QueryCrossChainPackageRequest
-	(*QueryCrossChainPackageResponse)(nil), // 3: cosmos.crosschain.v1.QueryCrossChainPackageResponse
-	(*QuerySendSequenceRequest)(nil),       // 4: cosmos.crosschain.v1.QuerySendSequenceRequest
-	(*QuerySendSequenceResponse)(nil),      // 5: cosmos.crosschain.v1.QuerySendSequenceResponse
-	(*QueryReceiveSequenceRequest)(nil),    // 6: cosmos.crosschain.v1.QueryReceiveSequenceRequest
-	(*QueryReceiveSequenceResponse)(nil),   // 7: cosmos.crosschain.v1.QueryReceiveSequenceResponse
-	(*Params)(nil),                         // 8: cosmos.crosschain.v1.Params
+	(*QueryParamsRequest)(nil),                   // 0: cosmos.crosschain.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                  // 1: cosmos.crosschain.v1.QueryParamsResponse
+	(*QueryCrossChainPackageRequest)(nil),        // 2: cosmos.crosschain.v1.QueryCrossChainPackageRequest
+	(*QueryCrossChainPackageResponse)(nil),       // 3: cosmos.crosschain.v1.QueryCrossChainPackageResponse
+	(*QueryDecodeCrossChainPackageRequest)(nil),  // 4: cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest
+	(*QueryDecodeCrossChainPackageResponse)(nil), // 5: cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse
+	(*QuerySendSequenceRequest)(nil),             // 6: cosmos.crosschain.v1.QuerySendSequenceRequest
+	(*QuerySendSequenceResponse)(nil),            // 7: cosmos.crosschain.v1.QuerySendSequenceResponse
+	(*QueryReceiveSequenceRequest)(nil),          // 8: cosmos.crosschain.v1.QueryReceiveSequenceRequest
+	(*QueryReceiveSequenceResponse)(nil),         // 9: cosmos.crosschain.v1.QueryReceiveSequenceResponse
+	(*Params)(nil),                               // 10: cosmos.crosschain.v1.Params
 }
 var file_cosmos_crosschain_v1_query_proto_depIdxs = []int32{
-	8, // 0: cosmos.crosschain.v1.QueryParamsResponse.params:type_name -> cosmos.crosschain.v1.Params
-	0, // 1: cosmos.crosschain.v1.Query.Params:input_type -> cosmos.crosschain.v1.QueryParamsRequest
-	2, // 2: cosmos.crosschain.v1.Query.CrossChainPackage:input_type -> cosmos.crosschain.v1.QueryCrossChainPackageRequest
-	4, // 3: cosmos.crosschain.v1.Query.SendSequence:input_type -> cosmos.crosschain.v1.QuerySendSequenceRequest
-	6, // 4: cosmos.crosschain.v1.Query.ReceiveSequence:input_type -> cosmos.crosschain.v1.QueryReceiveSequenceRequest
-	1, // 5: cosmos.crosschain.v1.Query.Params:output_type -> cosmos.crosschain.v1.QueryParamsResponse
-	3, // 6: cosmos.crosschain.v1.Query.CrossChainPackage:output_type -> cosmos.crosschain.v1.QueryCrossChainPackageResponse
-	5, // 7: cosmos.crosschain.v1.Query.SendSequence:output_type -> cosmos.crosschain.v1.QuerySendSequenceResponse
-	7, // 8: cosmos.crosschain.v1.Query.ReceiveSequence:output_type -> cosmos.crosschain.v1.QueryReceiveSequenceResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	10, // 0: cosmos.crosschain.v1.QueryParamsResponse.params:type_name -> cosmos.crosschain.v1.Params
+	0,  // 1: cosmos.crosschain.v1.Query.Params:input_type -> cosmos.crosschain.v1.QueryParamsRequest
+	2,  // 2: cosmos.crosschain.v1.Query.CrossChainPackage:input_type -> cosmos.crosschain.v1.QueryCrossChainPackageRequest
+	4,  // 3: cosmos.crosschain.v1.Query.DecodeCrossChainPackage:input_type -> cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest
+	6,  // 4: cosmos.crosschain.v1.Query.SendSequence:input_type -> cosmos.crosschain.v1.QuerySendSequenceRequest
+	8,  // 5: cosmos.crosschain.v1.Query.ReceiveSequence:input_type -> cosmos.crosschain.v1.QueryReceiveSequenceRequest
+	1,  // 6: cosmos.crosschain.v1.Query.Params:output_type -> cosmos.crosschain.v1.QueryParamsResponse
+	3,  // 7: cosmos.crosschain.v1.Query.CrossChainPackage:output_type -> cosmos.crosschain.v1.QueryCrossChainPackageResponse
+	5,  // 8: cosmos.crosschain.v1.Query.DecodeCrossChainPackage:output_type -> cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse
+	7,  // 9: cosmos.crosschain.v1.Query.SendSequence:output_type -> cosmos.crosschain.v1.QuerySendSequenceResponse
+	9,  // 10: cosmos.crosschain.v1.Query.ReceiveSequence:output_type -> cosmos.crosschain.v1.QueryReceiveSequenceResponse
+	6,  // [6:11] is the sub-list for method output_type
+	1,  // [1:6] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_crosschain_v1_query_proto_init() }
@@ -3978,7 +5121,7 @@ func file_cosmos_crosschain_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_crosschain_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySendSequenceRequest); i {
+			switch v := v.(*QueryDecodeCrossChainPackageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3990,7 +5133,7 @@ func file_cosmos_crosschain_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_crosschain_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySendSequenceResponse); i {
+			switch v := v.(*QueryDecodeCrossChainPackageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4002,7 +5145,7 @@ func file_cosmos_crosschain_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_crosschain_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryReceiveSequenceRequest); i {
+			switch v := v.(*QuerySendSequenceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4014,6 +5157,30 @@ func file_cosmos_crosschain_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_crosschain_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySendSequenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crosschain_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryReceiveSequenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crosschain_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryReceiveSequenceResponse); i {
 			case 0:
 				return &v.state
@@ -4032,7 +5199,7 @@ func file_cosmos_crosschain_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_crosschain_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName            = "/cosmos.crosschain.v1.Query/Params"
-	Query_CrossChainPackage_FullMethodName = "/cosmos.crosschain.v1.Query/CrossChainPackage"
-	Query_SendSequence_FullMethodName      = "/cosmos.crosschain.v1.Query/SendSequence"
-	Query_ReceiveSequence_FullMethodName   = "/cosmos.crosschain.v1.Query/ReceiveSequence"
+	Query_Params_FullMethodName                  = "/cosmos.crosschain.v1.Query/Params"
+	Query_CrossChainPackage_FullMethodName       = "/cosmos.crosschain.v1.Query/CrossChainPackage"
+	Query_DecodeCrossChainPackage_FullMethodName = "/cosmos.crosschain.v1.Query/DecodeCrossChainPackage"
+	Query_SendSequence_FullMethodName            = "/cosmos.crosschain.v1.Query/SendSequence"
+	Query_ReceiveSequence_FullMethodName         = "/cosmos.crosschain.v1.Query/ReceiveSequence"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +34,8 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// CrossChainPackage returns the specified cross chain package
 	CrossChainPackage(ctx context.Context, in *QueryCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryCrossChainPackageResponse, error)
+	// DecodeCrossChainPackage decodes a cross chain package of a channel
+	DecodeCrossChainPackage(ctx context.Context, in *QueryDecodeCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryDecodeCrossChainPackageResponse, error)
 	// SendSequence returns the send sequence of the channel
 	SendSequence(ctx context.Context, in *QuerySendSequenceRequest, opts ...grpc.CallOption) (*QuerySendSequenceResponse, error)
 	// ReceiveSequence returns the receive sequence of the channel
@@ -65,6 +68,15 @@ func (c *queryClient) CrossChainPackage(ctx context.Context, in *QueryCrossChain
 	return out, nil
 }
 
+func (c *queryClient) DecodeCrossChainPackage(ctx context.Context, in *QueryDecodeCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryDecodeCrossChainPackageResponse, error) {
+	out := new(QueryDecodeCrossChainPackageResponse)
+	err := c.cc.Invoke(ctx, Query_DecodeCrossChainPackage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SendSequence(ctx context.Context, in *QuerySendSequenceRequest, opts ...grpc.CallOption) (*QuerySendSequenceResponse, error) {
 	out := new(QuerySendSequenceResponse)
 	err := c.cc.Invoke(ctx, Query_SendSequence_FullMethodName, in, out, opts...)
@@ -91,6 +103,8 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// CrossChainPackage returns the specified cross chain package
 	CrossChainPackage(context.Context, *QueryCrossChainPackageRequest) (*QueryCrossChainPackageResponse, error)
+	// DecodeCrossChainPackage decodes a cross chain package of a channel
+	DecodeCrossChainPackage(context.Context, *QueryDecodeCrossChainPackageRequest) (*QueryDecodeCrossChainPackageResponse, error)
 	// SendSequence returns the send sequence of the channel
 	SendSequence(context.Context, *QuerySendSequenceRequest) (*QuerySendSequenceResponse, error)
 	// ReceiveSequence returns the receive sequence of the channel
@@ -108,6 +122,9 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) CrossChainPackage(context.Context, *QueryCrossChainPackageRequest) (*QueryCrossChainPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CrossChainPackage not implemented")
 }
+func (UnimplementedQueryServer) DecodeCrossChainPackage(context.Context, *QueryDecodeCrossChainPackageRequest) (*QueryDecodeCrossChainPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeCrossChainPackage not implemented")
+}
 func (UnimplementedQueryServer) SendSequence(context.Context, *QuerySendSequenceRequest) (*QuerySendSequenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendSequence not implemented")
 }
@@ -163,6 +180,24 @@ func _Query_CrossChainPackage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DecodeCrossChainPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecodeCrossChainPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecodeCrossChainPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DecodeCrossChainPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecodeCrossChainPackage(ctx, req.(*QueryDecodeCrossChainPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SendSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySendSequenceRequest)
 	if err := dec(in); err != nil {
@@ -214,6 +249,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CrossChainPackage",
 			Handler:    _Query_CrossChainPackage_Handler,
 		},
+		{
+			MethodName: "DecodeCrossChainPackage",
+			Handler:    _Query_DecodeCrossChainPackage_Handler,
+		},
 		{
 			MethodName: "SendSequence",
 			Handler:    _Query_SendSequence_Handler,
@@ -23,6 +23,8 @@ const (
 	Query_AppliedPlan_FullMethodName            = "/cosmos.upgrade.v1beta1.Query/AppliedPlan"
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
+	Query_Readiness_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Readiness"
+	Query_Features_FullMethodName               = "/cosmos.upgrade.v1beta1.Query/Features"
 )
 
 // QueryClient is the client API for Query service.
@@ -45,6 +47,11 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// Readiness queries the readiness signals of the validators for an upgrade
+	// plan, aggregated by voting power.
+	Readiness(ctx context.Context, in *QueryReadinessRequest, opts ...grpc.CallOption) (*QueryReadinessResponse, error)
+	// Features queries all the registered features with their activation state.
+	Features(ctx context.Context, in *QueryFeaturesRequest, opts ...grpc.CallOption) (*QueryFeaturesResponse, error)
 }
 
 type queryClient struct {
@@ -92,6 +99,24 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) Readiness(ctx context.Context, in *QueryReadinessRequest, opts ...grpc.CallOption) (*QueryReadinessResponse, error) {
+	out := new(QueryReadinessResponse)
+	err := c.cc.Invoke(ctx, Query_Readiness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Features(ctx context.Context, in *QueryFeaturesRequest, opts ...grpc.CallOption) (*QueryFeaturesResponse, error) {
+	out := new(QueryFeaturesResponse)
+	err := c.cc.Invoke(ctx, Query_Features_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -112,6 +137,11 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// Readiness queries the readiness signals of the validators for an upgrade
+	// plan, aggregated by voting power.
+	Readiness(context.Context, *QueryReadinessRequest) (*QueryReadinessResponse, error)
+	// Features queries all the registered features with their activation state.
+	Features(context.Context, *QueryFeaturesRequest) (*QueryFeaturesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -131,6 +161,12 @@ func (UnimplementedQueryServer) UpgradedConsensusState(context.Context, *QueryUp
 func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (UnimplementedQueryServer) Readiness(context.Context, *QueryReadinessRequest) (*QueryReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Readiness not implemented")
+}
+func (UnimplementedQueryServer) Features(context.Context, *QueryFeaturesRequest) (*QueryFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Features not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +252,42 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Readiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Readiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Readiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Readiness(ctx, req.(*QueryReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Features_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Features(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Features_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Features(ctx, req.(*QueryFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -239,6 +311,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "Readiness",
+			Handler:    _Query_Readiness_Handler,
+		},
+		{
+			MethodName: "Features",
+			Handler:    _Query_Features_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -6,12 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"runtime/debug"
 	"sort"
 	"strings"
 	"syscall"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -200,6 +202,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 			WithBlockHeight(req.Header.Height)
 	}
 
+	app.startBlockSpan(req.Header.Height)
+
 	app.queryStateMtx.Lock()
 	if app.queryState == nil {
 		app.setQueryState(req.Header)
@@ -227,8 +231,14 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		app.checkStateMtx.Unlock()
 	}
 
+	if app.parallel != nil {
+		app.parallel.beginBlock(req.Hash)
+	}
+
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		ctx, span := sdk.StartChildSpan(app.deliverState.ctx, "BeginBlock")
+		res = app.beginBlocker(ctx, req)
+		sdk.EndSpan(ctx, span, nil)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 		res.ExtraData = sdk.Uint64ToBigEndian(app.deliverState.ctx.GasMeter().RwConsumed())
 	}
@@ -252,7 +262,9 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	}
 
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		ctx, span := sdk.StartChildSpan(app.deliverState.ctx, "EndBlock")
+		res = app.endBlocker(ctx, req)
+		sdk.EndSpan(ctx, span, nil)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 		res.ExtraData = sdk.Uint64ToBigEndian(app.deliverState.ctx.GasMeter().RwConsumed())
 	}
@@ -367,6 +379,10 @@ func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) (resp abci.
 		WithConsensusParams(app.GetConsensusParams(app.processProposalState.ctx)).
 		WithBlockGasMeter(app.getBlockGasMeter(app.processProposalState.ctx))
 
+	if app.parallel != nil {
+		app.parallel.recordProposal(req.Hash, req.Txs)
+	}
+
 	defer func() {
 		if err := recover(); err != nil {
 			app.logger.Error(
@@ -447,7 +463,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "rwused")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	gInfo, result, anteEvents, err := app.runDeliverTx(req.Tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -471,7 +487,9 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 // height.
 func (app *BaseApp) Commit() abci.ResponseCommit {
 	header := app.deliverState.ctx.BlockHeader()
+	app.pinRetainedHeights(app.deliverState.ctx)
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
+	app.prefetcher.endBlock(header.Height)
 
 	rms, ok := app.cms.(*rootmulti.Store)
 	if ok {
@@ -482,8 +500,11 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
 	// checkState needs to be locked here to prevent a race condition
+	_, span := telemetry.StartSpan(app.deliverState.ctx.Context(), "Commit")
 	app.deliverState.ms.Write()
 	commitID := app.cms.Commit()
+	span.End()
+	app.endBlockSpan()
 
 	app.queryStateMtx.Lock()
 	app.setQueryState(header)
@@ -503,6 +524,10 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	if app.changeSetChecker != nil {
+		app.changeSetChecker.check(header.Height)
+	}
+
 	// Reset the Check state to the latest committed.
 	app.checkStateMtx.Lock()
 	app.setState(runTxModeCheck, header)
@@ -510,6 +535,9 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 
 	// empty/reset the deliver state
 	app.deliverState = nil
+	if app.parallel != nil {
+		app.parallel.reset()
+	}
 
 	var halt bool
 
@@ -536,6 +564,7 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 
 // PreBeginBlock implements the ABCI application interface.
 func (app *BaseApp) PreBeginBlock(req abci.RequestPreBeginBlock) (res abci.ResponsePrefetch) {
+	defer telemetry.MeasureSince(time.Now(), "prefetch", "begin_block")
 	defer func() {
 		if r := recover(); r != nil {
 			res = abci.ResponsePrefetch{Error: errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r).Error()}
@@ -551,23 +580,29 @@ func (app *BaseApp) PreBeginBlock(req abci.RequestPreBeginBlock) (res abci.Respo
 		app.cms.SetTracingContext(map[string]interface{}{"blockHeight": req.Header.Height})
 	}
 
-	if app.IsIavlStore() {
+	if app.prefetcher.beginBlock(req.Header.Height) {
 		// Initialize the preDeliverTx state.
 		app.setPreState(req.StateNumber, req.Header)
+	} else {
+		app.preDeliverStates = app.preDeliverStates[:0]
 	}
 
 	res = abci.ResponsePrefetch{Code: abci.CodeTypeOK}
 	return
 }
 
+// PreDeliverTx implements the ABCI application interface. The failures are counted and logged, as the
+// prefetch doesn't affect the state.
 func (app *BaseApp) PreDeliverTx(req abci.RequestPreDeliverTx) {
+	defer telemetry.MeasureSince(time.Now(), "prefetch", "tx")
 	defer func() {
 		if r := recover(); r != nil {
-			return
+			telemetry.IncrCounter(1, "prefetch", "tx", "panic")
+			app.logger.Error("panic in prefetch tx", "err", r, "stack", string(debug.Stack()))
 		}
 	}()
 
-	if !app.IsIavlStore() {
+	if req.StateIndex < 0 || req.StateIndex >= int64(len(app.preDeliverStates)) {
 		return
 	}
 
@@ -576,23 +611,41 @@ func (app *BaseApp) PreDeliverTx(req abci.RequestPreDeliverTx) {
 		return
 	}
 
+	tx, err := app.txDecoder(req.Tx)
+	if err != nil {
+		telemetry.IncrCounter(1, "prefetch", "tx", "failed")
+		return
+	}
+	txType := prefetchTxType(tx.GetMsgs())
+	if !app.prefetcher.shouldPrefetchTx(preState.ctx.BlockHeight(), txType) {
+		telemetry.IncrCounterWithLabels([]string{"prefetch", "tx", "skipped"}, 1,
+			[]metrics.Label{telemetry.NewLabel("type", txType)})
+		return
+	}
+
 	ctx := preState.ctx.
 		WithTxBytes(req.Tx).
 		WithVoteInfos(app.voteInfos)
 
 	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
 
-	app.runTxOnContext(ctx, runTxModePreDeliver, req.Tx)
+	if _, _, _, _, err := app.runTxOnContext(ctx, runTxModePreDeliver, req.Tx, tx); err != nil {
+		telemetry.IncrCounterWithLabels([]string{"prefetch", "tx", "failed"}, 1,
+			[]metrics.Label{telemetry.NewLabel("type", txType)})
+		app.logger.Debug("prefetch tx failed", "type", txType, "err", err)
+	}
 }
 
+// PreCommit implements the ABCI application interface.
 func (app *BaseApp) PreCommit(req abci.RequestPreCommit) (res abci.ResponsePrefetch) {
+	defer telemetry.MeasureSince(time.Now(), "prefetch", "commit")
 	defer func() {
 		if r := recover(); r != nil {
 			res = abci.ResponsePrefetch{Error: errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r).Error()}
 		}
 	}()
 
-	if app.IsIavlStore() {
+	if req.StateIndex >= 0 && req.StateIndex < int64(len(app.preDeliverStates)) {
 		app.preDeliverStates[req.StateIndex].ms.Write()
 	}
 
@@ -1008,6 +1061,9 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool, path ...string)
 // all blocks, e.g. via a local config option min-retain-blocks. There may also
 // be a need to vary retention for other nodes, e.g. sentry nodes which do not
 // need historical blocks.
+//
+// - Retention constraints: Blocks since the lowest height pinned by the
+// retention constraints of the modules must be available, e.g. for relayers.
 func (app *BaseApp) GetBlockRetentionHeight(commitHeight int64) int64 {
 	// pruning is disabled if minRetainBlocks is zero
 	if app.minRetainBlocks == 0 {
@@ -1049,6 +1105,8 @@ func (app *BaseApp) GetBlockRetentionHeight(commitHeight int64) int64 {
 		}
 	}
 
+	retentionHeight = minNonZero(retentionHeight, app.lowestPinnedHeight())
+
 	v := commitHeight - int64(app.minRetainBlocks)
 	retentionHeight = minNonZero(retentionHeight, v)
 
@@ -1099,6 +1157,68 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.version),
 			}
 
+		case "changeset":
+			rms, ok := app.cms.(*rootmulti.Store)
+			if !ok {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "multistore doesn't support change set commitments"), app.trace)
+			}
+
+			commitment, err := rms.ChangeSetCommitment(req.Height)
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrNotFound, err.Error()), app.trace)
+			}
+
+			bz, err := json.Marshal(commitment)
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode change set commitment"), app.trace)
+			}
+
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "mempool":
+			return handleQueryMempool(app, path[2:], req)
+
+		case "msgprofiles":
+			return handleQueryMsgProfiles(app)
+
+		case "pinnedheights":
+			bz, err := json.Marshal(app.PinnedHeights())
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode pinned heights"), app.trace)
+			}
+
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    app.LastBlockHeight(),
+				Value:     bz,
+			}
+
+		case "statesize":
+			rms, ok := app.cms.(*rootmulti.Store)
+			if !ok {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "multistore doesn't support state sizes"), app.trace)
+			}
+
+			size, err := rms.StateSize(req.Height)
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrNotFound, err.Error()), app.trace)
+			}
+
+			bz, err := json.Marshal(size)
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode state size"), app.trace)
+			}
+
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    size.Version,
+				Value:     bz,
+			}
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
 		}
@@ -2,6 +2,9 @@ package baseapp_test
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -17,6 +20,7 @@ import (
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -1320,6 +1324,245 @@ func TestABCI_GetBlockRetentionHeight(t *testing.T) {
 	}
 }
 
+func TestABCI_RetentionConstraints(t *testing.T) {
+	app := baseapp.NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB(), nil,
+		baseapp.SetPruning(pruningtypes.NewCustomPruningOptions(2, 10)),
+		baseapp.SetMinRetainBlocks(1),
+	)
+	app.MountStores(capKey1)
+	app.SetParamStore(&paramStore{db: dbm.NewMemDB()})
+	app.AddRetentionConstraint(func(ctx sdk.Context) []pruningtypes.PinnedHeights {
+		if ctx.BlockHeight() < 2 {
+			return nil
+		}
+		return []pruningtypes.PinnedHeights{{Start: 2, End: 2, Reason: "test"}}
+	})
+	app.AddRetentionConstraint(func(ctx sdk.Context) []pruningtypes.PinnedHeights {
+		panic("constraint failure")
+	})
+	require.NoError(t, app.LoadLatestVersion())
+	app.InitChain(abci.RequestInitChain{ConsensusParams: &tmproto.ConsensusParams{}})
+
+	for height := int64(1); height <= 10; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		res := app.Commit()
+		// the blocks are retained from the pinned height on
+		if height > 2 {
+			require.Equal(t, int64(2), res.RetainHeight)
+		}
+	}
+
+	expected := []pruningtypes.PinnedHeights{{Start: 2, End: 2, Reason: "test"}}
+	require.Equal(t, expected, app.PinnedHeights())
+
+	res := app.Query(abci.RequestQuery{Path: "/app/pinnedheights"})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(10), res.Height)
+	var pins []pruningtypes.PinnedHeights
+	require.NoError(t, json.Unmarshal(res.Value, &pins))
+	require.Equal(t, expected, pins)
+
+	// the pinned height is kept while the heights around it are pruned
+	store := app.CommitMultiStore().GetCommitKVStore(capKey1).(*iavl.Store)
+	require.True(t, store.VersionExists(2))
+	for _, v := range []int64{1, 3, 7} {
+		require.False(t, store.VersionExists(v), v)
+	}
+}
+
+func TestABCI_Query_Mempool(t *testing.T) {
+	pool := mempool.NewPriorityMempool()
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool))
+	encoder := suite.txConfig.TxEncoder()
+
+	var hashes []string
+	for _, nonce := range []int64{0, 1, 3, 6} {
+		tx := newTxCounter(t, suite.txConfig, nonce, 1)
+		require.NoError(t, pool.Insert(sdk.Context{}.WithPriority(10-nonce), tx))
+		bz, err := encoder(tx)
+		require.NoError(t, err)
+		hashes = append(hashes, fmt.Sprintf("%X", sha256.Sum256(bz)))
+	}
+
+	query := func(path string, data []byte, res any) abci.ResponseQuery {
+		resp := suite.baseApp.Query(abci.RequestQuery{Path: "/app/mempool/" + path, Data: data})
+		if resp.IsOK() {
+			require.NoError(t, json.Unmarshal(resp.Value, res))
+		}
+		return resp
+	}
+
+	var pending []mempool.PendingTx
+	require.True(t, query("txs", nil, &pending).IsOK())
+	require.Len(t, pending, 4)
+	for i, nonce := range []uint64{0, 1, 3, 6} {
+		require.Equal(t, nonce, pending[i].Nonce)
+		require.Equal(t, int64(10-nonce), pending[i].Priority)
+		require.Equal(t, hashes[i], fmt.Sprintf("%X", pending[i].Hash))
+		require.NotZero(t, pending[i].Size)
+		require.Empty(t, pending[i].Tx)
+	}
+	sender := pending[0].Sender
+	require.True(t, query("txs", []byte("other"), &pending).IsOK())
+	require.Empty(t, pending)
+
+	var desc mempool.PendingTx
+	hash := sha256.Sum256([]byte("unknown"))
+	require.Equal(t, sdkerrors.ErrNotFound.ABCICode(), query("tx", hash[:], &desc).Code)
+	hashBz, err := hex.DecodeString(hashes[2])
+	require.NoError(t, err)
+	require.True(t, query("tx", hashBz, &desc).IsOK())
+	require.Equal(t, uint64(3), desc.Nonce)
+	tx, err := suite.txConfig.TxDecoder()(desc.Tx)
+	require.NoError(t, err)
+	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), sigs[0].Sequence)
+
+	var gaps []mempool.NonceGaps
+	require.True(t, query("gaps", nil, &gaps).IsOK())
+	require.Equal(t, []mempool.NonceGaps{{
+		Sender:     sender,
+		FirstNonce: 0,
+		Missing:    []mempool.NonceRange{{Start: 2, End: 2}, {Start: 4, End: 5}},
+	}}, gaps)
+
+	// the eviction is disabled by default
+	require.Nil(t, suite.baseApp.MempoolEvictToken())
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), query("evict", hashBz, &struct{}{}).Code)
+	require.Equal(t, 4, pool.CountTx())
+}
+
+func TestABCI_Query_MempoolEvict(t *testing.T) {
+	pool := mempool.NewSenderNonceMempool()
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool), baseapp.SetEnableMempoolEvict(true))
+
+	tx := newTxCounter(t, suite.txConfig, 0, 1)
+	require.NoError(t, pool.Insert(sdk.Context{}, tx))
+	bz, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	hash := sha256.Sum256(bz)
+
+	// the eviction is refused without the token, as through the RPC of CometBFT
+	token := suite.baseApp.MempoolEvictToken()
+	require.Len(t, token, 32)
+	res := suite.baseApp.Query(abci.RequestQuery{Path: "/app/mempool/evict", Data: hash[:]})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+	wrongToken := append([]byte{token[0] + 1}, token[1:]...)
+	res = suite.baseApp.Query(abci.RequestQuery{Path: "/app/mempool/evict", Data: append(wrongToken, hash[:]...)})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+	require.Equal(t, 1, pool.CountTx())
+
+	data := append(append([]byte{}, token...), hash[:]...)
+	res = suite.baseApp.Query(abci.RequestQuery{Path: "/app/mempool/evict", Data: data})
+	require.True(t, res.IsOK(), res.Log)
+	require.Zero(t, pool.CountTx())
+
+	res = suite.baseApp.Query(abci.RequestQuery{Path: "/app/mempool/evict", Data: data})
+	require.Equal(t, sdkerrors.ErrNotFound.ABCICode(), res.Code)
+}
+
+func TestABCI_Query_MsgProfiles(t *testing.T) {
+	// the msg profiling is disabled by default
+	suite := NewBaseAppSuite(t)
+	res := suite.baseApp.Query(abci.RequestQuery{Path: "/app/msgprofiles"})
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), res.Code)
+
+	gasFuncOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetMsgGasFunc(func(sdk.Context, sdk.Msg) (uint64, error) { return 1000, nil })
+	}
+	suite = NewBaseAppSuite(t, baseapp.SetMsgProfiling(true), gasFuncOpt)
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0, 1))
+	require.NoError(t, err)
+	require.True(t, suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+	txBytes, err = suite.txConfig.TxEncoder()(setFailOnHandler(suite.txConfig, newTxCounter(t, suite.txConfig, 1, 2), true))
+	require.NoError(t, err)
+	require.False(t, suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+	suite.baseApp.EndBlock(abci.RequestEndBlock{})
+	suite.baseApp.Commit()
+
+	// the simulated txs are not profiled
+	_, _, err = suite.baseApp.Simulate(txBytes)
+	require.Error(t, err)
+
+	res = suite.baseApp.Query(abci.RequestQuery{Path: "/app/msgprofiles"})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(1), res.Height)
+	var report baseapp.MsgProfileReport
+	require.NoError(t, json.Unmarshal(res.Value, &report))
+	require.Equal(t, int64(1), report.SinceHeight)
+	require.Len(t, report.Profiles, 1)
+
+	profile := report.Profiles[0]
+	require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), profile.TypeURL)
+	require.Equal(t, uint64(3), profile.Count)
+	require.Equal(t, uint64(1), profile.Failed)
+	require.Positive(t, profile.WallTime)
+	require.GreaterOrEqual(t, profile.WallTime, profile.MaxWallTime)
+
+	// the handlers which succeeded read and wrote the counter
+	require.Equal(t, uint64(2), profile.Reads)
+	require.Equal(t, uint64(2), profile.Writes)
+	require.Equal(t, uint64(2*len(deliverKey)+1), profile.ReadBytes)
+	require.Equal(t, uint64(2*(len(deliverKey)+1)), profile.WrittenBytes)
+	require.Positive(t, profile.MeteredGas)
+	require.Equal(t, uint64(3*5), profile.ConsumedGas)
+	require.Equal(t, uint64(3000), profile.ChargedGas)
+}
+
+func TestABCI_MsgProfilesConsensus(t *testing.T) {
+	// the gas function meters gas, reads, writes and emits events on the context it's given
+	gasFuncOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetMsgGasFunc(func(ctx sdk.Context, msg sdk.Msg) (uint64, error) {
+			ctx.GasMeter().ConsumeGas(1000, "msg gas")
+			store := ctx.KVStore(capKey1)
+			store.Set([]byte("msg-gas"), append(store.Get([]byte("msg-gas")), 1))
+			ctx.EventManager().EmitEvent(sdk.NewEvent("msg-gas"))
+			return 1000, nil
+		})
+	}
+
+	deliver := func(profiling bool) ([]abci.ResponseDeliverTx, []byte) {
+		suite := NewBaseAppSuite(t, baseapp.SetMsgProfiling(profiling), gasFuncOpt)
+		suite.baseApp.InitChain(abci.RequestInitChain{
+			ConsensusParams: &tmproto.ConsensusParams{},
+		})
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+		var responses []abci.ResponseDeliverTx
+		for i, tx := range []sdk.Tx{
+			newTxCounter(t, suite.txConfig, 0, 0, 1),
+			setFailOnHandler(suite.txConfig, newTxCounter(t, suite.txConfig, 1, 2), true),
+			newTxCounter(t, suite.txConfig, 2, 2),
+		} {
+			txBytes, err := suite.txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+			responses = append(responses, suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}))
+			require.Equal(t, i != 1, responses[i].IsOK(), responses[i].Log)
+		}
+		suite.baseApp.EndBlock(abci.RequestEndBlock{})
+		return responses, suite.baseApp.Commit().Data
+	}
+
+	// the profiling changes neither the gas used, nor the data, which holds the rw gas used, nor the app hash
+	responses, appHash := deliver(false)
+	profiledResponses, profiledAppHash := deliver(true)
+	for i := range responses {
+		require.Equal(t, responses[i].GasUsed, profiledResponses[i].GasUsed, "tx %d", i)
+		require.Equal(t, responses[i].Data, profiledResponses[i].Data, "tx %d", i)
+	}
+	require.Equal(t, responses, profiledResponses)
+	require.Equal(t, appHash, profiledAppHash)
+}
+
 func TestABCI_Proposal_HappyPath(t *testing.T) {
 	anteKey := []byte("ante-key")
 	pool := mempool.NewSenderNonceMempool()
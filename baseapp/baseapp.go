@@ -14,6 +14,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/gogoproto/proto"
 	lru "github.com/hashicorp/golang-lru"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/maps"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -168,8 +169,41 @@ type BaseApp struct { //nolint: maligned
 	// enableUnsafeQuery defines whether the unsafe queries will be enabled or not
 	enableUnsafeQuery bool
 
+	// enableMempoolEvict defines whether the txs of the app-side mempool can be evicted by the mempool queries
+	enableMempoolEvict bool
+
+	// mempoolEvictToken is the random token the eviction queries are prefixed with, see MempoolEvictToken
+	mempoolEvictToken []byte
+
 	// enablePlainStore defines whether uses plain db store type or not
 	enablePlainStore bool
+
+	// plainStores are the names of the stores using the plain db store type when the others use IAVL
+	plainStores map[string]bool
+
+	// smtStores are the names of the stores using the sparse Merkle tree store type instead of IAVL
+	smtStores map[string]bool
+
+	// changeSetChecker compares the change set commitments with the ones of a trusted node
+	changeSetChecker *changeSetChecker
+
+	// prefetcher tracks the prefetch coverage and decides what to prefetch
+	prefetcher *prefetchTracker
+
+	// parallel executes the txs of the blocks in parallel, nil if they are executed serially
+	parallel *parallelExecutor
+
+	// retention holds the retention constraints registered by the modules and the heights they pin
+	retention retention
+
+	// blockSpan is the span of the block being delivered, nil unless tracing is enabled
+	blockSpan trace.Span
+
+	// msgProfiler aggregates the execution profiles of the msgs delivered, nil unless msg profiling is enabled
+	msgProfiler *msgProfiler
+
+	// msgGasFunc returns the gas charged for a msg by its type, such as by the gashub module
+	msgGasFunc MsgGasFunc
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -192,6 +226,7 @@ func NewBaseApp(
 		txDecoder:        txDecoder,
 		fauxMerkleMode:   false,
 		preDeliverStates: make([]*state, 0),
+		prefetcher:       newPrefetchTracker(PrefetchModeOn),
 		checkStateMtx:    sync.RWMutex{},
 		queryStateMtx:    sync.RWMutex{},
 	}
@@ -286,14 +321,14 @@ func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
 	app.msgServiceRouter.SetCircuit(cb)
 }
 
-// MountStores mounts all IAVL or DB stores to the provided keys in the BaseApp
+// MountStores mounts all IAVL, SMT or DB stores to the provided keys in the BaseApp
 // multistore.
 func (app *BaseApp) MountStores(keys ...storetypes.StoreKey) {
 	for _, key := range keys {
 		switch key.(type) {
 		case *storetypes.KVStoreKey:
-			if app.IsIavlStore() {
-				app.MountStore(key, storetypes.StoreTypeIAVL)
+			if app.isIavlStore(key.Name()) {
+				app.MountStore(key, app.merkleStoreType(key.Name()))
 			} else {
 				// StoreTypeDB doesn't do anything upon commit, and it doesn't
 				// retain history, but it's useful for faster simulation.
@@ -312,12 +347,12 @@ func (app *BaseApp) MountStores(keys ...storetypes.StoreKey) {
 	}
 }
 
-// MountKVStores mounts all IAVL or DB stores to the provided keys in the
+// MountKVStores mounts all IAVL, SMT or DB stores to the provided keys in the
 // BaseApp multistore.
 func (app *BaseApp) MountKVStores(keys map[string]*storetypes.KVStoreKey) {
 	for _, key := range keys {
-		if app.IsIavlStore() {
-			app.MountStore(key, storetypes.StoreTypeIAVL)
+		if app.isIavlStore(key.Name()) {
+			app.MountStore(key, app.merkleStoreType(key.Name()))
 		} else {
 			// StoreTypeDB doesn't do anything upon commit, and it doesn't
 			// retain history, but it's useful for faster simulation.
@@ -466,6 +501,17 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 // IsIavlStore returns whether IAVL store is used.
 func (app *BaseApp) IsIavlStore() bool { return !app.enablePlainStore && !app.fauxMerkleMode }
 
+// isIavlStore returns whether the KV store of the name is mounted as an IAVL store.
+func (app *BaseApp) isIavlStore(name string) bool { return app.IsIavlStore() && !app.plainStores[name] }
+
+// merkleStoreType returns the type of the merkleized KV store of the name, SMT or IAVL.
+func (app *BaseApp) merkleStoreType(name string) storetypes.StoreType {
+	if app.smtStores[name] {
+		return storetypes.StoreTypeSMT
+	}
+	return storetypes.StoreTypeIAVL
+}
+
 // setState sets the BaseApp's state for the corresponding mode with a branched
 // multi-store (i.e., a CacheMultiStore) and a new Context with the same
 // multi-store branch, and provided header.
@@ -502,13 +548,23 @@ func (app *BaseApp) setState(mode runTxMode, header tmproto.Header) {
 	}
 }
 
+// setPreState sets the states the txs of a block are prefetched on. The IAVL stores are copies of the
+// latest trees, whose caches are warmed when the prefetched writes are committed on them, while the plain DB
+// stores read through the cache DeliverTx reads from and drop the writes.
 func (app *BaseApp) setPreState(number int64, header tmproto.Header) {
 	app.preDeliverStates = app.preDeliverStates[:0] // reset, keep allocated memory
 
+	rs, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return
+	}
+
+	height := header.Height
+	recordPrefetch := func(key string) { app.prefetcher.recordPrefetch(height, key) }
 	for i := int64(0); i < number; i++ {
-		var ms sdk.CacheMultiStore
-		if _, ok := app.cms.(*rootmulti.Store); ok {
-			ms = app.cms.(*rootmulti.Store).DeepCopyAndCache()
+		ms := rs.DeepCopyAndCache()
+		if app.prefetcher.tracking() {
+			ms = newPrefetchMultiStore(ms, recordPrefetch)
 		}
 
 		baseState := &state{
@@ -700,6 +756,10 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 
 	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
 
+	if mode == runTxModeDeliver && app.prefetcher.tracking() {
+		ctx = ctx.WithMultiStore(newPrefetchMultiStore(modeState.ms, app.prefetcher.recordDeliver))
+	}
+
 	if mode == runTxModeReCheck {
 		ctx = ctx.WithIsReCheckTx(true)
 	}
@@ -739,15 +799,20 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	ctx := app.getContextForTx(mode, txBytes)
-	return app.runTxOnContext(ctx, mode, txBytes)
+	return app.runTxOnContext(ctx, mode, txBytes, nil)
 }
 
-func (app *BaseApp) runTxOnContext(ctx sdk.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+// runTxOnContext runs a tx on a context like runTx, tx being the decoded tx bytes if the caller has decoded them
+// already, nil otherwise.
+func (app *BaseApp) runTxOnContext(ctx sdk.Context, mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
+	ctx, span := startTxSpan(ctx, mode, txBytes)
+	defer func() { endTxSpan(span, gInfo, err) }()
+
 	ms := ctx.MultiStore()
 	gInfo.MinGasPrice = app.minGasPrices.String()
 
@@ -789,8 +854,7 @@ func (app *BaseApp) runTxOnContext(ctx sdk.Context, mode runTxMode, txBytes []by
 		defer consumeBlockGas()
 	}
 
-	var tx sdk.Tx
-	if ctx.SigCache() != nil && ctx.TxBytes() != nil {
+	if tx == nil && ctx.SigCache() != nil && ctx.TxBytes() != nil {
 		if txCache, known := ctx.SigCache().Get(string(ctx.TxBytes())); known {
 			tx = txCache.(sdk.Tx)
 		}
@@ -811,6 +875,10 @@ func (app *BaseApp) runTxOnContext(ctx sdk.Context, mode runTxMode, txBytes []by
 		return sdk.GasInfo{}, nil, nil, 0, err
 	}
 
+	if mode == runTxModeDeliver {
+		app.prefetcher.deliverTx(prefetchTxType(msgs))
+	}
+
 	if app.anteHandler != nil {
 		var (
 			anteCtx sdk.Context
@@ -934,7 +1002,14 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		}
 
 		// ADR 031 request type routing
-		msgResult, err := handler(ctx, msg)
+		msgCtx, sample := ctx, (*msgSample)(nil)
+		if app.profilesMsgs(ctx, mode) {
+			msgCtx, sample = app.startMsgSample(ctx, msg)
+		}
+		msgResult, err := handler(msgCtx, msg)
+		if sample != nil {
+			app.msgProfiler.record(msgCtx, sample, err)
+		}
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -0,0 +1,144 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// changeSetCheckQueueSize is the number of committed heights waiting to be checked, the heights committed
+	// while the queue is full are not checked.
+	changeSetCheckQueueSize = 100
+	// changeSetCheckRetries is the number of times the commitment of a height is queried from the trusted
+	// node before giving up, as the trusted node may not have committed the height yet.
+	changeSetCheckRetries  = 10
+	changeSetCheckInterval = time.Second
+)
+
+// changeSetChecker compares the change set commitments of the committed heights with the ones of a trusted
+// node queried over RPC, which catches a node diverging from the chain at the first block it writes
+// differently. A divergence is logged as an error and counted by the store_changeset_divergence metric.
+type changeSetChecker struct {
+	logger  log.Logger
+	cms     sdk.CommitMultiStore
+	client  rpcclient.ABCIClient
+	heights chan int64
+}
+
+func newChangeSetChecker(logger log.Logger, cms sdk.CommitMultiStore, client rpcclient.ABCIClient) *changeSetChecker {
+	c := &changeSetChecker{
+		logger:  logger.With("module", "changeset"),
+		cms:     cms,
+		client:  client,
+		heights: make(chan int64, changeSetCheckQueueSize),
+	}
+	go c.run()
+	return c
+}
+
+// check queues the committed height to be checked.
+func (c *changeSetChecker) check(height int64) {
+	select {
+	case c.heights <- height:
+	default:
+		telemetry.IncrCounter(1, "store", "changeset", "unchecked")
+	}
+}
+
+func (c *changeSetChecker) run() {
+	for height := range c.heights {
+		if err := c.checkHeight(height); err != nil {
+			telemetry.IncrCounter(1, "store", "changeset", "unchecked")
+			c.logger.Debug("failed to check the change set commitment", "height", height, "err", err)
+		}
+	}
+}
+
+func (c *changeSetChecker) checkHeight(height int64) error {
+	rms, ok := c.cms.(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("multistore doesn't support change set commitments")
+	}
+	local, err := rms.ChangeSetCommitment(height)
+	if err != nil {
+		return err
+	}
+
+	var trusted *rootmulti.ChangeSetCommitment
+	for i := 0; ; i++ {
+		trusted, err = c.queryTrusted(height)
+		if err == nil {
+			break
+		}
+		if i == changeSetCheckRetries {
+			return err
+		}
+		time.Sleep(changeSetCheckInterval)
+	}
+
+	if diverged := changeSetDivergence(local, trusted); len(diverged) > 0 {
+		telemetry.IncrCounter(1, "store", "changeset", "divergence")
+		c.logger.Error("the state diverged from the trusted node", "height", height, "stores", diverged)
+		return nil
+	}
+	telemetry.IncrCounter(1, "store", "changeset", "checked")
+	return nil
+}
+
+func (c *changeSetChecker) queryTrusted(height int64) (*rootmulti.ChangeSetCommitment, error) {
+	res, err := c.client.ABCIQueryWithOptions(context.Background(), "/app/changeset", nil, rpcclient.ABCIQueryOptions{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	if res.Response.IsErr() {
+		return nil, fmt.Errorf("query failed with code %d: %s", res.Response.Code, res.Response.Log)
+	}
+
+	commitment := &rootmulti.ChangeSetCommitment{}
+	if err := json.Unmarshal(res.Response.Value, commitment); err != nil {
+		return nil, err
+	}
+	return commitment, nil
+}
+
+// changeSetDivergence returns the names of the stores whose change sets differ, or "chain" if only the chained
+// hashes differ.
+func changeSetDivergence(local, trusted *rootmulti.ChangeSetCommitment) []string {
+	if !bytes.Equal(local.BlockHash, trusted.BlockHash) {
+		trustedStores := make(map[string][]byte, len(trusted.Stores))
+		for _, store := range trusted.Stores {
+			trustedStores[store.Name] = store.BlockHash
+		}
+
+		var diverged []string
+		for _, store := range local.Stores {
+			if hash, ok := trustedStores[store.Name]; !ok || !bytes.Equal(hash, store.BlockHash) {
+				diverged = append(diverged, store.Name)
+			}
+			delete(trustedStores, store.Name)
+		}
+		for name := range trustedStores {
+			diverged = append(diverged, name)
+		}
+		if len(diverged) == 0 {
+			diverged = []string{"block"}
+		}
+		return diverged
+	}
+
+	// the chains can only be compared if they started at the same height
+	if local.StartVersion == trusted.StartVersion && !bytes.Equal(local.Hash, trusted.Hash) {
+		return []string{"chain"}
+	}
+	return nil
+}
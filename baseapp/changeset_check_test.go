@@ -0,0 +1,79 @@
+package baseapp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// trustedChangeSetClient serves the change set commitments of a store like the RPC of a trusted node.
+type trustedChangeSetClient struct {
+	rpcclient.ABCIClient
+
+	store *rootmulti.Store
+}
+
+func (c trustedChangeSetClient) ABCIQueryWithOptions(_ context.Context, _ string, _ bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	commitment, err := c.store.ChangeSetCommitment(opts.Height)
+	if err != nil {
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Code: 1, Log: err.Error()}}, nil
+	}
+	bz, err := json.Marshal(commitment)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func newChangeSetCheckStore(t *testing.T, typ storetypes.StoreType, value string) *rootmulti.Store {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey("test")
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	store.SetChangeSetCommitment(true)
+	store.MountStoreWithDB(key, typ, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	branch := store.CacheMultiStore()
+	branch.GetKVStore(key).Set([]byte("key"), []byte(value))
+	branch.Write()
+	store.Commit()
+	return store
+}
+
+func TestChangeSetChecker(t *testing.T) {
+	trusted := newChangeSetCheckStore(t, storetypes.StoreTypeIAVL, "value")
+	client := trustedChangeSetClient{store: trusted}
+
+	checker := &changeSetChecker{logger: log.NewNopLogger(), cms: newChangeSetCheckStore(t, storetypes.StoreTypeDB, "value"), client: client}
+	require.NoError(t, checker.checkHeight(1))
+
+	local, err := checker.cms.(*rootmulti.Store).ChangeSetCommitment(1)
+	require.NoError(t, err)
+	remote, err := checker.queryTrusted(1)
+	require.NoError(t, err)
+	require.Empty(t, changeSetDivergence(local, remote))
+
+	diverged := newChangeSetCheckStore(t, storetypes.StoreTypeDB, "other")
+	local, err = diverged.ChangeSetCommitment(1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"test"}, changeSetDivergence(local, remote))
+
+	// the change sets are equal but the chains aren't
+	local.Hash = []byte("other")
+	local.BlockHash = remote.BlockHash
+	require.Equal(t, []string{"chain"}, changeSetDivergence(local, remote))
+	local.StartVersion = 2
+	require.Empty(t, changeSetDivergence(local, remote))
+}
@@ -0,0 +1,126 @@
+package baseapp
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// mempoolEvictTokenSize is the size of the token of the eviction queries.
+const mempoolEvictTokenSize = 32
+
+// MempoolTxs returns the txs of the app-side mempool in their selection order, only the ones of a sender if
+// it isn't empty.
+func (app *BaseApp) MempoolTxs(sender string) ([]mempool.PendingTx, error) {
+	_, pending, err := mempool.PendingTxs(context.Background(), app.mempool, app.txEncoder)
+	if err != nil || sender == "" {
+		return pending, err
+	}
+
+	var senderTxs []mempool.PendingTx
+	for _, desc := range pending {
+		if desc.Sender == sender {
+			senderTxs = append(senderTxs, desc)
+		}
+	}
+	return senderTxs, nil
+}
+
+// MempoolTx returns the tx of the app-side mempool with a hash, or mempool.ErrTxNotFound.
+func (app *BaseApp) MempoolTx(hash []byte) (mempool.PendingTx, error) {
+	_, desc, err := mempool.FindTx(context.Background(), app.mempool, app.txEncoder, hash)
+	return desc, err
+}
+
+// MempoolNonceGaps returns the first nonce and the nonce gaps of each sender of the app-side mempool.
+func (app *BaseApp) MempoolNonceGaps() ([]mempool.NonceGaps, error) {
+	_, pending, err := mempool.PendingTxs(context.Background(), app.mempool, app.txEncoder)
+	if err != nil {
+		return nil, err
+	}
+	return mempool.PendingNonceGaps(pending), nil
+}
+
+// EvictMempoolTx removes the tx with a hash from the app-side mempool, if the eviction is enabled.
+func (app *BaseApp) EvictMempoolTx(hash []byte) error {
+	if !app.enableMempoolEvict {
+		return sdkerrors.ErrUnauthorized.Wrap("mempool eviction is disabled, set enable-evict in [mempool] of app.toml")
+	}
+
+	tx, _, err := mempool.FindTx(context.Background(), app.mempool, app.txEncoder, hash)
+	if err != nil {
+		return err
+	}
+	if err := app.mempool.Remove(tx); err != nil {
+		return err
+	}
+
+	app.logger.Info("evicted tx from the mempool", "hash", fmt.Sprintf("%X", hash))
+	return nil
+}
+
+// MempoolEvictToken returns the token the data of the "/app/mempool/evict" queries is prefixed with, nil if the
+// eviction is disabled. It's generated when the eviction is enabled and is only handed to the admin gRPC
+// listener of the node, so that the eviction can't be queried through the RPC of CometBFT.
+func (app *BaseApp) MempoolEvictToken() []byte {
+	return app.mempoolEvictToken
+}
+
+// handleQueryMempool handles the "/app/mempool" queries of the app-side mempool. The queries are served on the
+// ABCI connections, which the local client serializes with the mempool ones, so the eviction goes through them
+// too, but only with the token of MempoolEvictToken.
+func handleQueryMempool(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
+	if len(path) < 1 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no mempool query"), app.trace)
+	}
+
+	var (
+		res  any
+		err  error
+		hash = req.Data
+	)
+	switch path[0] {
+	case "txs":
+		res, err = app.MempoolTxs(string(req.Data))
+	case "tx":
+		res, err = app.MempoolTx(req.Data)
+	case "gaps":
+		res, err = app.MempoolNonceGaps()
+	case "evict":
+		// the eviction mutates the mempool, so it's refused unless the query comes from the admin gRPC listener
+		if len(app.mempoolEvictToken) == 0 || len(req.Data) < len(app.mempoolEvictToken) ||
+			subtle.ConstantTimeCompare(req.Data[:len(app.mempoolEvictToken)], app.mempoolEvictToken) != 1 {
+			return sdkerrors.QueryResult(sdkerrors.ErrUnauthorized.Wrap("mempool eviction is only served by the admin gRPC listener of the node"), app.trace)
+		}
+		hash = req.Data[len(app.mempoolEvictToken):]
+		err = app.EvictMempoolTx(hash)
+		res = struct{}{}
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown mempool query: %s", path[0]), app.trace)
+	}
+	if errors.Is(err, mempool.ErrTxNotFound) {
+		err = sdkerrors.ErrNotFound.Wrapf("tx %s", hex.EncodeToString(hash))
+	}
+	if err != nil {
+		return sdkerrors.QueryResult(err, app.trace)
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode the mempool query response"), app.trace)
+	}
+
+	return abci.ResponseQuery{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    app.LastBlockHeight(),
+		Value:     bz,
+	}
+}
@@ -1,10 +1,12 @@
 package baseapp
 
 import (
+	"crypto/rand"
 	"fmt"
 	"io"
 
 	dbm "github.com/cometbft/cometbft-db"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	lru "github.com/hashicorp/golang-lru"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,6 +14,7 @@ import (
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
@@ -102,16 +105,131 @@ func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
 }
 
+// SetEnableMempoolEvict sets the flag to enable the eviction of the txs of the app-side mempool in BaseApp.
+// The eviction query is then only served with the token of MempoolEvictToken, which doesn't leave the process.
+func SetEnableMempoolEvict(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.enableMempoolEvict = enabled
+		app.mempoolEvictToken = nil
+		if enabled {
+			app.mempoolEvictToken = make([]byte, mempoolEvictTokenSize)
+			if _, err := rand.Read(app.mempoolEvictToken); err != nil {
+				panic(fmt.Errorf("failed to generate the mempool eviction token: %w", err))
+			}
+		}
+	}
+}
+
+// SetMsgProfiling sets whether the execution of the msgs delivered is profiled by msg type, see MsgProfiles.
+func SetMsgProfiling(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if enabled {
+			app.msgProfiler = newMsgProfiler()
+		} else {
+			app.msgProfiler = nil
+		}
+	}
+}
+
 // SetEnableUnsafeQuery sets the flag to enable unsafe query in BaseApp.
 func SetEnableUnsafeQuery(enabled bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.enableUnsafeQuery = enabled }
 }
 
+// SetPrefetchMode sets how the txs of the blocks are prefetched, see PrefetchModeOn, PrefetchModeOff and
+// PrefetchModeAdaptive. An empty mode is PrefetchModeOn.
+func SetPrefetchMode(mode string) func(*BaseApp) {
+	if mode == "" {
+		mode = PrefetchModeOn
+	}
+	if err := ValidatePrefetchMode(mode); err != nil {
+		panic(err)
+	}
+
+	return func(app *BaseApp) { app.prefetcher = newPrefetchTracker(mode) }
+}
+
+// SetParallelExecution enables or disables the parallel execution of the txs of the blocks, with the number of
+// workers executing them, zero for the number of CPUs.
+func SetParallelExecution(enabled bool, workers int) func(*BaseApp) {
+	if !enabled {
+		return func(app *BaseApp) { app.parallel = nil }
+	}
+
+	return func(app *BaseApp) { app.parallel = newParallelExecutor(workers) }
+}
+
 // SetEnablePlainStore sets the flag to enable plain store in BaseApp.
 func SetEnablePlainStore(enabled bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.enablePlainStore = enabled }
 }
 
+// SetChangeSetCommitment enables or disables the commitment to the change sets of the persisted stores at
+// every block, which gives the plain db stores of the fast nodes a state commitment.
+func SetChangeSetCommitment(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if rms, ok := app.cms.(*rootmulti.Store); ok {
+			rms.SetChangeSetCommitment(enabled)
+		}
+	}
+}
+
+// SetChangeSetTrustedRPC sets the RPC address of the trusted node the change set commitments are checked
+// against after every block, no check is made if it is empty.
+func SetChangeSetTrustedRPC(addr string) func(*BaseApp) {
+	if addr == "" {
+		return func(*BaseApp) {}
+	}
+
+	client, err := rpchttp.New(addr, "/websocket")
+	if err != nil {
+		panic(err)
+	}
+	return func(app *BaseApp) { app.changeSetChecker = newChangeSetChecker(app.logger, app.cms, client) }
+}
+
+// SetStateSizeTracking enables or disables the tracking of the key count and byte size of the persisted
+// stores, updated at every commit.
+func SetStateSizeTracking(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if rms, ok := app.cms.(*rootmulti.Store); ok {
+			rms.SetStateSizeTracking(enabled)
+		}
+	}
+}
+
+// SetStateSizePrefixes registers key prefixes whose sizes are tracked apart from the rest of their stores.
+func SetStateSizePrefixes(prefixes ...rootmulti.StateSizePrefix) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if rms, ok := app.cms.(*rootmulti.Store); ok {
+			rms.AddStateSizePrefixes(prefixes...)
+		}
+	}
+}
+
+// SetPlainStores sets the names of the KV stores mounted as plain db stores while the others are mounted as
+// IAVL stores, for the hybrid layout the migrate-store command produces.
+func SetPlainStores(names []string) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.plainStores = make(map[string]bool, len(names))
+		for _, name := range names {
+			app.plainStores[name] = true
+		}
+	}
+}
+
+// SetSMTStores sets the names of the KV stores mounted as sparse Merkle tree stores instead of IAVL stores.
+// The store type is part of the state commitment, so all the nodes of a chain must set the same stores, and
+// a store can only change type through a store upgrade.
+func SetSMTStores(names []string) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.smtStores = make(map[string]bool, len(names))
+		for _, name := range names {
+			app.smtStores[name] = true
+		}
+	}
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -129,6 +247,17 @@ func (app *BaseApp) SetParamStore(ps ParamStore) {
 	app.paramStore = ps
 }
 
+// SetMsgGasFunc sets the function returning the gas charged for a msg by its type, which the msg profiles
+// compare with the gas the execution of the msgs consumes. It's called on a branch of the state of the tx with an
+// infinite gas meter, so that it doesn't change the results of the tx.
+func (app *BaseApp) SetMsgGasFunc(fn MsgGasFunc) {
+	if app.sealed {
+		panic("SetMsgGasFunc() on sealed BaseApp")
+	}
+
+	app.msgGasFunc = fn
+}
+
 // SetVersion sets the application's version string.
 func (app *BaseApp) SetVersion(v string) {
 	if app.sealed {
@@ -0,0 +1,525 @@
+package baseapp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// parallelMinTxs is the number of txs a block must have to be executed in parallel.
+const parallelMinTxs = 2
+
+// parallelExecutor executes the txs of the blocks in parallel. The txs of a block are known from its proposal:
+// once the block begins, they are all executed speculatively on branches of the state after BeginBlock, with
+// their reads and writes tracked. DeliverTx then commits the speculative executions in block order, and
+// executes again, on the state the earlier txs left, the txs which read a key an earlier tx wrote, so that the
+// results are the ones of a serial execution. The blocks whose proposal isn't known, e.g. during block sync,
+// are executed serially.
+type parallelExecutor struct {
+	workers int
+
+	// proposals are the txs of the proposals of the current height, by block hash
+	proposals map[string][][]byte
+	// block is the block being executed in parallel, nil if it is executed serially
+	block *parallelBlock
+}
+
+func newParallelExecutor(workers int) *parallelExecutor {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	return &parallelExecutor{
+		workers:   workers,
+		proposals: make(map[string][][]byte),
+	}
+}
+
+// recordProposal records the txs of a proposed block.
+func (e *parallelExecutor) recordProposal(hash []byte, txs [][]byte) {
+	if len(txs) < parallelMinTxs {
+		return
+	}
+	e.proposals[string(hash)] = txs
+}
+
+// beginBlock starts the parallel execution of the block with the hash if its txs are known.
+func (e *parallelExecutor) beginBlock(hash []byte) {
+	e.block = nil
+	if txs, ok := e.proposals[string(hash)]; ok {
+		e.block = &parallelBlock{txs: txs, written: make(map[string]map[string]struct{})}
+	}
+}
+
+// reset drops the proposals and the block once it is committed.
+func (e *parallelExecutor) reset() {
+	e.proposals = make(map[string][][]byte)
+	e.block = nil
+}
+
+// parallelBlock is a block executed in parallel.
+type parallelBlock struct {
+	txs [][]byte
+	// specs are the speculative executions of the txs, nil until the first tx is delivered
+	specs []*speculativeTx
+	// next is the index of the next tx to deliver
+	next int
+	// written are the keys written by the delivered txs, by store
+	written map[string]map[string]struct{}
+
+	accepted    int
+	reexecuted  int
+	speculation time.Duration
+}
+
+// conflicts returns whether the tx read a key written by a tx delivered before it.
+func (b *parallelBlock) conflicts(access *accessSet) bool {
+	for store, keys := range access.reads {
+		written := b.written[store]
+		for key := range keys {
+			if _, ok := written[key]; ok {
+				return true
+			}
+		}
+	}
+	for _, r := range access.ranges {
+		for key := range b.written[r.store] {
+			if r.contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// addWrites adds the keys written by a delivered tx.
+func (b *parallelBlock) addWrites(access *accessSet) {
+	for store, keys := range access.writes {
+		written, ok := b.written[store]
+		if !ok {
+			written = make(map[string]struct{}, len(keys))
+			b.written[store] = written
+		}
+		for key := range keys {
+			written[key] = struct{}{}
+		}
+	}
+}
+
+// speculativeTx is the execution of a tx on a branch of the state after BeginBlock.
+type speculativeTx struct {
+	ms     sdk.CacheMultiStore
+	access *accessSet
+
+	// gasMeter and blockGasMeter stand for the gas meters of the deliver state, a tx touching them
+	// doesn't get the results it would get on them
+	gasMeter      *touchGasMeter
+	blockGasMeter *touchGasMeter
+
+	tx     sdk.Tx
+	gInfo  sdk.GasInfo
+	result *sdk.Result
+	// anteEvents are non-nil once the tx passed the ante handler
+	anteEvents []abci.Event
+	err        error
+	// panicked is set when the speculation failed outside of the execution of the tx
+	panicked bool
+	// samples are the profiles of the msgs of the tx, if msg profiling is enabled
+	samples []*msgSample
+}
+
+// runDeliverTx executes a tx in DeliverTx mode, from its speculative execution if the block is executed in
+// parallel.
+func (app *BaseApp) runDeliverTx(txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	var block *parallelBlock
+	if app.parallel != nil {
+		block = app.parallel.block
+	}
+	if block == nil {
+		gInfo, result, anteEvents, _, err = app.runTx(runTxModeDeliver, txBytes)
+		return gInfo, result, anteEvents, err
+	}
+
+	// the block isn't the proposal it began as, what remains of it is executed serially
+	if block.next >= len(block.txs) || !bytes.Equal(block.txs[block.next], txBytes) {
+		app.logger.Error("delivered txs differ from the proposal, executing the rest of the block serially",
+			"height", app.deliverState.ctx.BlockHeight(), "index", block.next)
+		app.parallel.block = nil
+		gInfo, result, anteEvents, _, err = app.runTx(runTxModeDeliver, txBytes)
+		return gInfo, result, anteEvents, err
+	}
+
+	if block.specs == nil {
+		app.speculateBlock(block)
+	}
+	spec := block.specs[block.next]
+	block.next++
+
+	ctx := app.getContextForTx(runTxModeDeliver, txBytes)
+	if app.acceptSpeculativeTx(ctx, block, spec) {
+		block.accepted++
+		block.addWrites(spec.access)
+		gInfo, result, anteEvents, err = spec.gInfo, spec.result, spec.anteEvents, spec.err
+		if result != nil && len(anteEvents) > 0 {
+			// append the events in the order of occurrence, as in DeliverTx mode
+			result.Events = append(anteEvents, result.Events...)
+		}
+	} else {
+		// execute the tx again on the state the earlier txs left, tracking its writes for the next ones
+		block.reexecuted++
+		access := newAccessSet()
+		ctx = ctx.WithMultiStore(newAccessMultiStore(ctx.MultiStore().(sdk.CacheMultiStore), access))
+		gInfo, result, anteEvents, _, err = app.runTxOnContext(ctx, runTxModeDeliver, txBytes, nil)
+		block.addWrites(access)
+	}
+
+	if block.next == len(block.txs) {
+		app.endParallelBlock(block)
+	}
+	return gInfo, result, anteEvents, err
+}
+
+// acceptSpeculativeTx returns whether the speculative execution of a tx gives the results of its execution on
+// the deliver state, and commits it then, consuming its gas from the block gas meter.
+func (app *BaseApp) acceptSpeculativeTx(ctx sdk.Context, block *parallelBlock, spec *speculativeTx) bool {
+	if spec.panicked || spec.gasMeter.touched || spec.blockGasMeter.touched || block.conflicts(spec.access) {
+		return false
+	}
+
+	// the tx must fit in the block gas, or it fails in DeliverTx mode
+	blockGasMeter := ctx.BlockGasMeter()
+	gasConsumed := spec.gInfo.GasUsed
+	if gasConsumed > spec.gInfo.GasWanted {
+		gasConsumed = spec.gInfo.GasWanted
+	}
+	if blockGasMeter.IsOutOfGas() || blockGasMeter.Limit()-blockGasMeter.GasConsumed() < gasConsumed {
+		return false
+	}
+
+	// the tx passed the ante handler, so DeliverTx removes it from the mempool before running its messages
+	if spec.anteEvents != nil && !app.removeSpeculativeTx(spec.tx) {
+		return false
+	}
+
+	blockGasMeter.ConsumeGas(gasConsumed, "block gas meter")
+	spec.ms.Write()
+
+	if app.msgProfiler != nil {
+		app.msgProfiler.add(spec.samples...)
+	}
+
+	if app.prefetcher.tracking() && spec.tx != nil {
+		app.prefetcher.deliverTx(prefetchTxType(spec.tx.GetMsgs()))
+		for store, keys := range spec.access.reads {
+			for key := range keys {
+				app.prefetcher.recordDeliver(store + "/" + key)
+			}
+		}
+	}
+	return true
+}
+
+// removeSpeculativeTx removes a tx from the mempool as DeliverTx does, and returns whether it didn't fail.
+func (app *BaseApp) removeSpeculativeTx(tx sdk.Tx) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	err := app.mempool.Remove(tx)
+	return err == nil || errors.Is(err, mempool.ErrTxNotFound)
+}
+
+// speculateBlock executes the txs of a block speculatively with the workers of the parallel executor.
+func (app *BaseApp) speculateBlock(block *parallelBlock) {
+	start := time.Now()
+	block.specs = make([]*speculativeTx, len(block.txs))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < app.parallel.workers && w < len(block.txs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				block.specs[i] = app.speculateTx(block.txs[i])
+			}
+		}()
+	}
+	for i := range block.txs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	block.speculation = time.Since(start)
+}
+
+// speculateTx executes a tx on a branch of the deliver state, in the PreDeliverTx mode which neither consumes
+// block gas nor touches the mempool.
+func (app *BaseApp) speculateTx(txBytes []byte) (spec *speculativeTx) {
+	spec = &speculativeTx{
+		ms:            app.deliverState.ms.CacheMultiStore(),
+		access:        newAccessSet(),
+		gasMeter:      &touchGasMeter{GasMeter: storetypes.NewInfiniteGasMeter()},
+		blockGasMeter: &touchGasMeter{GasMeter: storetypes.NewInfiniteGasMeter()},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error("panic in speculative tx", "err", r)
+			spec.panicked = true
+		}
+	}()
+
+	if tx, err := app.txDecoder(txBytes); err == nil {
+		spec.tx = tx
+	}
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(newAccessMultiStore(spec.ms, spec.access)).
+		WithTxBytes(txBytes).
+		WithVoteInfos(app.voteInfos).
+		WithSigCache(app.sigCache).
+		WithEventManager(sdk.NewEventManager())
+	if app.msgProfiler != nil {
+		ctx = ctx.WithValue(speculativeSamplesKey{}, &spec.samples)
+	}
+
+	// the consensus params are read on the branch, as the reads of the tx, but not metered like them
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))).
+		WithGasMeter(spec.gasMeter).
+		WithBlockGasMeter(spec.blockGasMeter)
+
+	spec.gInfo, spec.result, spec.anteEvents, _, spec.err = app.runTxOnContext(ctx, runTxModePreDeliver, txBytes, spec.tx)
+	return spec
+}
+
+// endParallelBlock reports the parallel execution of a block once all its txs are delivered.
+func (app *BaseApp) endParallelBlock(block *parallelBlock) {
+	telemetry.SetGauge(float32(block.accepted), "parallel", "txs", "accepted")
+	telemetry.SetGauge(float32(block.reexecuted), "parallel", "txs", "reexecuted")
+	telemetry.SetGauge(float32(block.speculation.Milliseconds()), "parallel", "speculation", "ms")
+	app.logger.Debug("executed block in parallel", "height", app.deliverState.ctx.BlockHeight(),
+		"txs", len(block.txs), "reexecuted", block.reexecuted, "speculation", block.speculation)
+}
+
+// accessRange is a range of keys of a store read through an iterator.
+type accessRange struct {
+	store      string
+	start, end []byte
+}
+
+func (r accessRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) && (r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// accessSet records the keys read and written by a tx, by store. The keys read and written by the branches of
+// its state are recorded as well, even if the branches are discarded.
+type accessSet struct {
+	mtx sync.Mutex
+
+	reads  map[string]map[string]struct{}
+	ranges []accessRange
+	writes map[string]map[string]struct{}
+}
+
+func newAccessSet() *accessSet {
+	return &accessSet{
+		reads:  make(map[string]map[string]struct{}),
+		writes: make(map[string]map[string]struct{}),
+	}
+}
+
+func (s *accessSet) read(store string, key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	addAccess(s.reads, store, key)
+}
+
+func (s *accessSet) readRange(store string, start, end []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.ranges = append(s.ranges, accessRange{store: store, start: copyBound(start), end: copyBound(end)})
+}
+
+// copyBound copies an iterator bound, keeping an open bound nil.
+func copyBound(bound []byte) []byte {
+	if bound == nil {
+		return nil
+	}
+	return append([]byte{}, bound...)
+}
+
+func (s *accessSet) write(store string, key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	addAccess(s.writes, store, key)
+}
+
+func addAccess(accesses map[string]map[string]struct{}, store string, key []byte) {
+	keys, ok := accesses[store]
+	if !ok {
+		keys = make(map[string]struct{})
+		accesses[store] = keys
+	}
+	keys[string(key)] = struct{}{}
+}
+
+// accessMultiStore is a multistore which records the keys read and written through the KV stores of itself
+// and of its branches.
+type accessMultiStore struct {
+	cacheMultiStore
+
+	access *accessSet
+}
+
+func newAccessMultiStore(ms sdk.CacheMultiStore, access *accessSet) accessMultiStore {
+	return accessMultiStore{cacheMultiStore: ms, access: access}
+}
+
+// GetKVStore implements MultiStore.
+func (ms accessMultiStore) GetKVStore(key storetypes.StoreKey) sdk.KVStore {
+	return accessKVStore{KVStore: ms.cacheMultiStore.GetKVStore(key), store: key.Name(), access: ms.access}
+}
+
+// CacheMultiStore implements MultiStore.
+func (ms accessMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return newAccessMultiStore(ms.cacheMultiStore.CacheMultiStore(), ms.access)
+}
+
+// CacheWrap implements CacheWrapper.
+func (ms accessMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (ms accessMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// SetTracingContext implements MultiStore.
+func (ms accessMultiStore) SetTracingContext(tc storetypes.TraceContext) storetypes.MultiStore {
+	return newAccessMultiStore(ms.cacheMultiStore.SetTracingContext(tc).(sdk.CacheMultiStore), ms.access)
+}
+
+// accessKVStore is a KVStore which records the keys read and written through it.
+type accessKVStore struct {
+	sdk.KVStore
+
+	store  string
+	access *accessSet
+}
+
+// Get implements KVStore.
+func (s accessKVStore) Get(key []byte) []byte {
+	s.access.read(s.store, key)
+	return s.KVStore.Get(key)
+}
+
+// Has implements KVStore.
+func (s accessKVStore) Has(key []byte) bool {
+	s.access.read(s.store, key)
+	return s.KVStore.Has(key)
+}
+
+// Set implements KVStore.
+func (s accessKVStore) Set(key, value []byte) {
+	s.access.write(s.store, key)
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s accessKVStore) Delete(key []byte) {
+	s.access.write(s.store, key)
+	s.KVStore.Delete(key)
+}
+
+// Iterator implements KVStore.
+func (s accessKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.access.readRange(s.store, start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.
+func (s accessKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.access.readRange(s.store, start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// touchGasMeter is a gas meter which records whether it was used.
+type touchGasMeter struct {
+	storetypes.GasMeter
+
+	touched bool
+}
+
+var _ storetypes.GasMeter = (*touchGasMeter)(nil)
+
+func (g *touchGasMeter) GasConsumed() storetypes.Gas {
+	g.touched = true
+	return g.GasMeter.GasConsumed()
+}
+
+func (g *touchGasMeter) GasConsumedToLimit() storetypes.Gas {
+	g.touched = true
+	return g.GasMeter.GasConsumedToLimit()
+}
+
+func (g *touchGasMeter) GasRemaining() storetypes.Gas {
+	g.touched = true
+	return g.GasMeter.GasRemaining()
+}
+
+func (g *touchGasMeter) Limit() storetypes.Gas {
+	g.touched = true
+	return g.GasMeter.Limit()
+}
+
+func (g *touchGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	g.touched = true
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func (g *touchGasMeter) RefundGas(amount storetypes.Gas, descriptor string) {
+	g.touched = true
+	g.GasMeter.RefundGas(amount, descriptor)
+}
+
+func (g *touchGasMeter) IsPastLimit() bool {
+	g.touched = true
+	return g.GasMeter.IsPastLimit()
+}
+
+func (g *touchGasMeter) IsOutOfGas() bool {
+	g.touched = true
+	return g.GasMeter.IsOutOfGas()
+}
+
+func (g *touchGasMeter) RwConsumed() storetypes.Gas {
+	g.touched = true
+	return g.GasMeter.RwConsumed()
+}
+
+func (g *touchGasMeter) ConsumeRw(amount storetypes.Gas, descriptor string) {
+	g.touched = true
+	g.GasMeter.ConsumeRw(amount, descriptor)
+}
+
+func (g *touchGasMeter) String() string {
+	return fmt.Sprintf("touchGasMeter:\n  %s", g.GasMeter.String())
+}
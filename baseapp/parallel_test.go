@@ -0,0 +1,230 @@
+package baseapp_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// parallelKVServer appends the values of the messages to their keys, or sets them to the number of keys of
+// their first byte, so that the results depend on the order of the txs.
+type parallelKVServer struct {
+	calls *int64
+}
+
+func (s parallelKVServer) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	atomic.AddInt64(s.calls, 1)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey2)
+
+	switch string(msg.Value) {
+	case "fail":
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+	case "count":
+		it := sdk.KVStorePrefixIterator(store, msg.Key[:1])
+		count := 0
+		for ; it.Valid(); it.Next() {
+			count++
+		}
+		it.Close()
+		store.Set(msg.Key, []byte(strconv.Itoa(count)))
+	default:
+		store.Set(msg.Key, append(store.Get(msg.Key), msg.Value...))
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("kv", sdk.NewAttribute("key", string(msg.Key))))
+	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
+}
+
+// parallelAnteHandler sets the gas meter of the txs if setGasMeter is true, and increments the sequence of
+// their account.
+func parallelAnteHandler(t *testing.T, setGasMeter bool) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		vals, err := url.ParseQuery(tx.(sdk.TxWithMemo).GetMemo())
+		require.NoError(t, err)
+
+		if setGasMeter {
+			gas, err := strconv.ParseUint(vals.Get("gas"), 10, 64)
+			require.NoError(t, err)
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gas))
+		}
+		if vals.Get("failOnAnte") == "true" {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+		}
+
+		store := ctx.KVStore(capKey1)
+		seqKey := []byte("seq/" + vals.Get("account"))
+		seq := getIntFromStore(t, store, seqKey)
+		setIntOnStore(store, seqKey, seq+1)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent("ante", sdk.NewAttribute("seq", strconv.FormatInt(seq, 10))))
+		return ctx, nil
+	}
+}
+
+type parallelTestBlock struct {
+	hash []byte
+	txs  [][]byte
+}
+
+// newParallelTestBlocks returns random blocks of txs, most of them touching a few accounts and keys.
+func newParallelTestBlocks(t *testing.T, suite *BaseAppSuite, seed int64, numBlocks, numTxs int) []parallelTestBlock {
+	r := rand.New(rand.NewSource(seed))
+	blocks := make([]parallelTestBlock, numBlocks)
+	for b := range blocks {
+		blocks[b].hash = []byte(fmt.Sprintf("block-%d", b))
+		for i := 0; i < numTxs; i++ {
+			value := []byte{byte('a' + r.Intn(26))}
+			switch n := r.Intn(20); {
+			case n == 0:
+				value = []byte("fail")
+			case n <= 2:
+				value = []byte("count")
+			}
+			gas := 100000
+			if r.Intn(10) == 0 {
+				gas = 1500
+			}
+
+			vals := url.Values{}
+			vals.Set("account", strconv.Itoa(r.Intn(2*numTxs)))
+			vals.Set("gas", strconv.Itoa(gas))
+			vals.Set("failOnAnte", strconv.FormatBool(r.Intn(20) == 0))
+
+			builder := suite.txConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{
+				Key:   []byte(fmt.Sprintf("%c%d", 'a'+r.Intn(3), r.Intn(3*numTxs))),
+				Value: value,
+			}))
+			builder.SetMemo(vals.Encode())
+			setTxSignature(t, builder, uint64(i))
+			txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+
+			blocks[b].txs = append(blocks[b].txs, txBytes)
+		}
+		// a tx which can't be decoded
+		blocks[b].txs = append(blocks[b].txs, []byte("invalid"))
+	}
+	return blocks
+}
+
+type parallelTestResult struct {
+	deliverTxs [][]abci.ResponseDeliverTx
+	appHashes  [][]byte
+	calls      int64
+	profiles   baseapp.MsgProfileReport
+}
+
+// runParallelTestBlocks delivers the blocks after proposing them, with two txs in the middle of the blocks
+// swapped if swap is true.
+func runParallelTestBlocks(t *testing.T, parallel, setGasMeter bool, maxBlockGas int64, swap bool, options ...func(*baseapp.BaseApp)) parallelTestResult {
+	var res parallelTestResult
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(parallelAnteHandler(t, setGasMeter)) }
+	options = append([]func(*baseapp.BaseApp){anteOpt, baseapp.SetParallelExecution(parallel, 4)}, options...)
+	suite := NewBaseAppSuite(t, options...)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), parallelKVServer{calls: &res.calls})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: maxBlockGas}},
+	})
+
+	blocks := newParallelTestBlocks(t, suite, 1, 5, 50)
+	for i, block := range blocks {
+		height := int64(i + 1)
+		suite.baseApp.ProcessProposal(abci.RequestProcessProposal{Hash: block.hash, Height: height, Txs: block.txs})
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Hash: block.hash, Header: tmproto.Header{Height: height}})
+
+		txs := block.txs
+		if swap {
+			txs = append([][]byte{}, txs...)
+			txs[len(txs)/2-1], txs[len(txs)/2] = txs[len(txs)/2], txs[len(txs)/2-1]
+		}
+		responses := make([]abci.ResponseDeliverTx, 0, len(txs))
+		for _, tx := range txs {
+			responses = append(responses, suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: tx}))
+		}
+		suite.baseApp.EndBlock(abci.RequestEndBlock{Height: height})
+
+		res.deliverTxs = append(res.deliverTxs, responses)
+		res.appHashes = append(res.appHashes, suite.baseApp.Commit().Data)
+	}
+	res.profiles, _ = suite.baseApp.MsgProfiles()
+	return res
+}
+
+func TestParallelExecution(t *testing.T) {
+	testCases := map[string]struct {
+		setGasMeter bool
+		maxBlockGas int64
+		swap        bool
+	}{
+		"unlimited block gas": {setGasMeter: true, maxBlockGas: -1},
+		"limited block gas":   {setGasMeter: true, maxBlockGas: 600000},
+		"shared gas meter":    {setGasMeter: false, maxBlockGas: -1},
+		"proposal differs":    {setGasMeter: true, maxBlockGas: -1, swap: true},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			serial := runParallelTestBlocks(t, false, tc.setGasMeter, tc.maxBlockGas, tc.swap)
+			parallel := runParallelTestBlocks(t, true, tc.setGasMeter, tc.maxBlockGas, tc.swap)
+
+			for i := range serial.deliverTxs {
+				require.Equal(t, serial.deliverTxs[i], parallel.deliverTxs[i], "block %d", i+1)
+				require.Equal(t, serial.appHashes[i], parallel.appHashes[i], "block %d", i+1)
+			}
+
+			// the txs are executed speculatively, then the conflicting ones again
+			require.Greater(t, parallel.calls, serial.calls)
+			if tc.setGasMeter {
+				require.Less(t, parallel.calls, 2*serial.calls)
+			}
+		})
+	}
+}
+
+func TestParallelExecutionMsgProfiles(t *testing.T) {
+	options := []func(*baseapp.BaseApp){
+		baseapp.SetMsgProfiling(true),
+		func(bapp *baseapp.BaseApp) {
+			bapp.SetMsgGasFunc(func(ctx sdk.Context, msg sdk.Msg) (uint64, error) {
+				ctx.GasMeter().ConsumeGas(1000, "msg gas")
+				return 1000, nil
+			})
+		},
+	}
+	serial := runParallelTestBlocks(t, false, true, -1, false, options...)
+	parallel := runParallelTestBlocks(t, true, true, -1, false, options...)
+	unprofiled := runParallelTestBlocks(t, true, true, -1, false)
+
+	// the profiling changes neither the results nor the speculative executions accepted
+	require.Equal(t, unprofiled.deliverTxs, parallel.deliverTxs)
+	require.Equal(t, unprofiled.appHashes, parallel.appHashes)
+	require.Equal(t, unprofiled.calls, parallel.calls)
+	require.Equal(t, serial.appHashes, parallel.appHashes)
+
+	// the msgs of the txs whose speculative execution is accepted are profiled once, as in a serial execution
+	require.Len(t, parallel.profiles.Profiles, 1)
+	require.Equal(t, serial.profiles.SinceHeight, parallel.profiles.SinceHeight)
+	profile, serialProfile := parallel.profiles.Profiles[0], serial.profiles.Profiles[0]
+	require.Positive(t, profile.WallTime)
+	profile.WallTime, profile.MaxWallTime = 0, 0
+	serialProfile.WallTime, serialProfile.MaxWallTime = 0, 0
+	require.Equal(t, serialProfile, profile)
+}
@@ -0,0 +1,332 @@
+package baseapp
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/armon/go-metrics"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Prefetch modes of the BaseApp, see SetPrefetchMode.
+const (
+	// PrefetchModeOn pre-executes the txs of every block the consensus engine prefetches.
+	PrefetchModeOn = "on"
+	// PrefetchModeOff never pre-executes txs.
+	PrefetchModeOff = "off"
+	// PrefetchModeAdaptive pauses the prefetch of the blocks and tx types whose reads are not covered by it.
+	PrefetchModeAdaptive = "adaptive"
+)
+
+const (
+	// prefetchMinCoverage is the share of the keys read by DeliverTx which must have been prefetched for the
+	// adaptive mode to keep prefetching a block or a tx type.
+	prefetchMinCoverage = 0.3
+	// prefetchMinSamples is the number of prefetched blocks a block or tx type coverage is measured on before
+	// the adaptive mode pauses its prefetch.
+	prefetchMinSamples = 3
+	// prefetchPauseBlocks is the number of blocks the adaptive mode pauses a prefetch for, after which the
+	// coverage is measured again.
+	prefetchPauseBlocks = 20
+	// prefetchCoverageDecay is the weight of the history in the moving average of a tx type coverage.
+	prefetchCoverageDecay = 0.8
+)
+
+// ValidatePrefetchMode returns an error if the prefetch mode is unknown.
+func ValidatePrefetchMode(mode string) error {
+	switch mode {
+	case PrefetchModeOn, PrefetchModeOff, PrefetchModeAdaptive:
+		return nil
+	default:
+		return fmt.Errorf("invalid prefetch mode %q, expected %s, %s or %s", mode, PrefetchModeOn, PrefetchModeOff, PrefetchModeAdaptive)
+	}
+}
+
+// prefetchStats measures the coverage of the prefetch of a block or a tx type.
+type prefetchStats struct {
+	// coverage is the moving average of the share of the keys read by DeliverTx which had been prefetched
+	coverage float64
+	// samples is the number of blocks the coverage was measured on
+	samples int
+	// pausedUntil is the height until which the prefetch is paused by the adaptive mode
+	pausedUntil int64
+}
+
+// observe adds the coverage of a block, and pauses the prefetch until pausedUntil if it doesn't pay off.
+func (s *prefetchStats) observe(coverage, decay float64, pausedUntil int64) bool {
+	if s.samples == 0 {
+		s.coverage = coverage
+	} else {
+		s.coverage = decay*s.coverage + (1-decay)*coverage
+	}
+	s.samples++
+
+	if s.samples >= prefetchMinSamples && s.coverage < prefetchMinCoverage {
+		*s = prefetchStats{pausedUntil: pausedUntil}
+		return true
+	}
+	return false
+}
+
+// prefetchTxTypeReads counts the keys a tx type read in DeliverTx, and how many of them had been prefetched.
+type prefetchTxTypeReads struct {
+	reads int
+	hits  int
+}
+
+// prefetchTracker records the keys read by the prefetch of a block and by its DeliverTx to report the
+// prefetch coverage, and decides what to prefetch in the adaptive mode. Only the point reads of the KV
+// stores are recorded, not the iterations.
+type prefetchTracker struct {
+	mtx sync.Mutex
+
+	mode string
+	// telemetryEnabled returns whether the metrics are emitted, in which case the coverage is tracked
+	telemetryEnabled func() bool
+	// height is the height of the block being prefetched, zero if the block isn't prefetched or its coverage
+	// isn't tracked
+	height int64
+
+	prefetched map[string]struct{}
+	delivered  map[string]struct{}
+	hits       int
+	// late counts the keys prefetched after DeliverTx read them
+	late int
+	// txType is the type of the tx being delivered, the reads are attributed to it
+	txType  string
+	txReads map[string]*prefetchTxTypeReads
+
+	blockStats  prefetchStats
+	txTypeStats map[string]*prefetchStats
+}
+
+func newPrefetchTracker(mode string) *prefetchTracker {
+	return &prefetchTracker{
+		mode:             mode,
+		telemetryEnabled: telemetry.IsTelemetryEnabled,
+		txTypeStats:      make(map[string]*prefetchStats),
+	}
+}
+
+// beginBlock starts tracking the prefetch of the block at the height, in the adaptive mode or if the telemetry
+// is enabled, and returns whether it should be prefetched.
+func (t *prefetchTracker) beginBlock(height int64) bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.height = 0
+	switch {
+	case t.mode == PrefetchModeOff:
+		return false
+	case t.mode == PrefetchModeAdaptive && height < t.blockStats.pausedUntil:
+		telemetry.IncrCounter(1, "prefetch", "block", "paused")
+		return false
+	}
+	// the coverage is only needed by the adaptive mode and the telemetry, and tracking it costs every read
+	if t.mode != PrefetchModeAdaptive && !t.telemetryEnabled() {
+		return true
+	}
+
+	t.height = height
+	t.prefetched = make(map[string]struct{})
+	t.delivered = make(map[string]struct{})
+	t.hits = 0
+	t.late = 0
+	t.txType = ""
+	t.txReads = make(map[string]*prefetchTxTypeReads)
+	return true
+}
+
+// shouldPrefetchTx returns whether a tx of the type should be prefetched at the height.
+func (t *prefetchTracker) shouldPrefetchTx(height int64, txType string) bool {
+	if t.mode != PrefetchModeAdaptive {
+		return true
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	stats, ok := t.txTypeStats[txType]
+	return !ok || height >= stats.pausedUntil
+}
+
+// recordPrefetch records a key read by the prefetch of the block at the height.
+func (t *prefetchTracker) recordPrefetch(height int64, key string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if height != t.height {
+		return
+	}
+	if _, ok := t.prefetched[key]; ok {
+		return
+	}
+	t.prefetched[key] = struct{}{}
+	if _, ok := t.delivered[key]; ok {
+		t.late++
+	}
+}
+
+// deliverTx sets the type of the tx whose reads are recorded next.
+func (t *prefetchTracker) deliverTx(txType string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.height == 0 {
+		return
+	}
+	t.txType = txType
+}
+
+// recordDeliver records a key read by DeliverTx in the block being prefetched.
+func (t *prefetchTracker) recordDeliver(key string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.height == 0 {
+		return
+	}
+	if _, ok := t.delivered[key]; ok {
+		return
+	}
+	t.delivered[key] = struct{}{}
+
+	reads, ok := t.txReads[t.txType]
+	if !ok {
+		reads = &prefetchTxTypeReads{}
+		t.txReads[t.txType] = reads
+	}
+	reads.reads++
+	if _, ok := t.prefetched[key]; ok {
+		t.hits++
+		reads.hits++
+	}
+}
+
+// tracking returns whether the reads of DeliverTx are recorded.
+func (t *prefetchTracker) tracking() bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.height != 0
+}
+
+// endBlock reports the coverage of the prefetch of the block once it has been delivered, updates the
+// adaptive mode decisions and stops the tracking.
+func (t *prefetchTracker) endBlock(height int64) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.height == 0 || t.height != height {
+		t.height = 0
+		return
+	}
+	t.height = 0
+
+	coverage := 1.0
+	if len(t.delivered) > 0 {
+		coverage = float64(t.hits) / float64(len(t.delivered))
+	}
+	wasted := len(t.prefetched) - t.hits
+
+	telemetry.SetGauge(float32(len(t.prefetched)), "prefetch", "keys", "prefetched")
+	telemetry.SetGauge(float32(len(t.delivered)), "prefetch", "keys", "read")
+	telemetry.SetGauge(float32(t.hits), "prefetch", "keys", "hit")
+	telemetry.SetGauge(float32(wasted), "prefetch", "keys", "wasted")
+	telemetry.SetGauge(float32(t.late), "prefetch", "keys", "late")
+	telemetry.SetGauge(float32(coverage), "prefetch", "coverage")
+
+	for txType, reads := range t.txReads {
+		if txType == "" || reads.reads == 0 {
+			continue
+		}
+		stats, ok := t.txTypeStats[txType]
+		if !ok {
+			stats = &prefetchStats{}
+			t.txTypeStats[txType] = stats
+		}
+		// the txs of a paused type haven't been prefetched
+		if height < stats.pausedUntil {
+			continue
+		}
+
+		txCoverage := float64(reads.hits) / float64(reads.reads)
+		telemetry.SetGaugeWithLabels([]string{"prefetch", "tx", "coverage"}, float32(txCoverage),
+			[]metrics.Label{telemetry.NewLabel("type", txType)})
+		if t.mode == PrefetchModeAdaptive && stats.observe(txCoverage, prefetchCoverageDecay, height+prefetchPauseBlocks) {
+			telemetry.IncrCounterWithLabels([]string{"prefetch", "tx", "pauses"}, 1,
+				[]metrics.Label{telemetry.NewLabel("type", txType)})
+		}
+	}
+
+	if t.mode == PrefetchModeAdaptive && t.blockStats.observe(coverage, prefetchCoverageDecay, height+prefetchPauseBlocks) {
+		telemetry.IncrCounter(1, "prefetch", "block", "pauses")
+	}
+}
+
+// prefetchTxType returns the type of a tx used to track the prefetch coverage, the type of its first message.
+func prefetchTxType(msgs []sdk.Msg) string {
+	if len(msgs) == 0 {
+		return ""
+	}
+	return sdk.MsgTypeURL(msgs[0])
+}
+
+// prefetchKey returns the key of a store key read by the prefetch tracker.
+func prefetchKey(storeKey storetypes.StoreKey, key []byte) string {
+	return storeKey.Name() + "/" + string(key)
+}
+
+// cacheMultiStore names the CacheMultiStore embedded in prefetchMultiStore, which overrides its method of
+// the same name.
+type cacheMultiStore = sdk.CacheMultiStore
+
+// prefetchMultiStore is a multistore which records the keys read through the KV stores of itself and of its
+// branches.
+type prefetchMultiStore struct {
+	cacheMultiStore
+
+	record func(key string)
+}
+
+func newPrefetchMultiStore(ms sdk.CacheMultiStore, record func(key string)) prefetchMultiStore {
+	return prefetchMultiStore{cacheMultiStore: ms, record: record}
+}
+
+// GetKVStore implements MultiStore.
+func (ms prefetchMultiStore) GetKVStore(key storetypes.StoreKey) sdk.KVStore {
+	return prefetchKVStore{KVStore: ms.cacheMultiStore.GetKVStore(key), storeKey: key, record: ms.record}
+}
+
+// CacheMultiStore implements MultiStore.
+func (ms prefetchMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return newPrefetchMultiStore(ms.cacheMultiStore.CacheMultiStore(), ms.record)
+}
+
+// SetTracingContext implements MultiStore.
+func (ms prefetchMultiStore) SetTracingContext(tc storetypes.TraceContext) storetypes.MultiStore {
+	return newPrefetchMultiStore(ms.cacheMultiStore.SetTracingContext(tc).(sdk.CacheMultiStore), ms.record)
+}
+
+// prefetchKVStore is a KVStore which records the keys read from it.
+type prefetchKVStore struct {
+	sdk.KVStore
+
+	storeKey storetypes.StoreKey
+	record   func(key string)
+}
+
+// Get implements KVStore.
+func (s prefetchKVStore) Get(key []byte) []byte {
+	s.record(prefetchKey(s.storeKey, key))
+	return s.KVStore.Get(key)
+}
+
+// Has implements KVStore.
+func (s prefetchKVStore) Has(key []byte) bool {
+	s.record(prefetchKey(s.storeKey, key))
+	return s.KVStore.Has(key)
+}
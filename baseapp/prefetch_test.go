@@ -0,0 +1,110 @@
+package baseapp
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestPrefetchTrackerCoverage(t *testing.T) {
+	tracker := newPrefetchTracker(PrefetchModeOn)
+	require.False(t, tracker.tracking())
+
+	// the coverage isn't tracked outside of the adaptive mode without telemetry
+	tracker.telemetryEnabled = func() bool { return false }
+	require.True(t, tracker.beginBlock(9))
+	require.False(t, tracker.tracking())
+	tracker.recordPrefetch(9, "a")
+	require.Empty(t, tracker.prefetched)
+
+	tracker.telemetryEnabled = func() bool { return true }
+	require.True(t, tracker.beginBlock(10))
+	require.True(t, tracker.tracking())
+	tracker.recordPrefetch(10, "a")
+	tracker.recordPrefetch(10, "b")
+	tracker.recordPrefetch(10, "b")
+	// reads of the prefetch of another block are ignored
+	tracker.recordPrefetch(9, "c")
+
+	tracker.deliverTx("send")
+	tracker.recordDeliver("a")
+	tracker.recordDeliver("a")
+	tracker.recordDeliver("c")
+	tracker.deliverTx("vote")
+	tracker.recordDeliver("d")
+	// prefetched after DeliverTx read it
+	tracker.recordPrefetch(10, "c")
+
+	require.Len(t, tracker.prefetched, 3)
+	require.Len(t, tracker.delivered, 3)
+	require.Equal(t, 1, tracker.hits)
+	require.Equal(t, 1, tracker.late)
+	require.Equal(t, prefetchTxTypeReads{reads: 2, hits: 1}, *tracker.txReads["send"])
+	require.Equal(t, prefetchTxTypeReads{reads: 1, hits: 0}, *tracker.txReads["vote"])
+
+	tracker.endBlock(10)
+	require.False(t, tracker.tracking())
+	// nothing is paused outside of the adaptive mode
+	require.True(t, tracker.shouldPrefetchTx(11, "vote"))
+	require.True(t, tracker.beginBlock(11))
+
+	require.False(t, newPrefetchTracker(PrefetchModeOff).beginBlock(10))
+}
+
+func TestPrefetchTrackerAdaptive(t *testing.T) {
+	tracker := newPrefetchTracker(PrefetchModeAdaptive)
+
+	height := int64(1)
+	for ; height <= prefetchMinSamples; height++ {
+		require.True(t, tracker.beginBlock(height))
+		require.True(t, tracker.shouldPrefetchTx(height, "vote"))
+
+		tracker.recordPrefetch(height, "a")
+		tracker.deliverTx("send")
+		tracker.recordDeliver("a")
+		tracker.deliverTx("vote")
+		tracker.recordDeliver("b")
+		tracker.endBlock(height)
+	}
+
+	// the votes are never covered by the prefetch, the sends always are
+	require.False(t, tracker.shouldPrefetchTx(height, "vote"))
+	require.True(t, tracker.shouldPrefetchTx(height, "send"))
+	require.True(t, tracker.shouldPrefetchTx(height+prefetchPauseBlocks, "vote"))
+
+	// a block whose reads are not covered is paused
+	for ; height <= 2*prefetchMinSamples; height++ {
+		require.True(t, tracker.beginBlock(height))
+		tracker.deliverTx("send")
+		tracker.recordDeliver("c")
+		tracker.endBlock(height)
+	}
+	require.False(t, tracker.beginBlock(height))
+	require.False(t, tracker.tracking())
+	height += prefetchPauseBlocks
+	require.True(t, tracker.beginBlock(height))
+}
+
+func TestPrefetchMultiStore(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	var keys []string
+	ms := newPrefetchMultiStore(cms.CacheMultiStore(), func(key string) { keys = append(keys, key) })
+
+	// the reads of the branches are recorded, and their writes reach the parent
+	branch := ms.CacheMultiStore()
+	branch.GetKVStore(key).Set([]byte("k1"), []byte("v1"))
+	require.Equal(t, []byte("v1"), branch.GetKVStore(key).Get([]byte("k1")))
+	require.False(t, branch.GetKVStore(key).Has([]byte("k2")))
+	branch.Write()
+	require.Equal(t, []byte("v1"), ms.GetKVStore(key).Get([]byte("k1")))
+
+	require.Equal(t, []string{"test/k1", "test/k2", "test/k1"}, keys)
+}
@@ -0,0 +1,328 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgGasFunc returns the gas charged for a msg by its type whatever the work its execution does, such as the gas
+// the gashub module charges.
+type MsgGasFunc func(ctx sdk.Context, msg sdk.Msg) (uint64, error)
+
+// MsgProfile aggregates the executions of the delivered msgs of a type.
+type MsgProfile struct {
+	TypeURL string `json:"type_url"`
+	// Count is the number of msgs executed, Failed the number of them whose handler returned an error.
+	Count  uint64 `json:"count"`
+	Failed uint64 `json:"failed"`
+	// WallTime is the total duration of the executions, MaxWallTime the longest one.
+	WallTime    time.Duration `json:"wall_time"`
+	MaxWallTime time.Duration `json:"max_wall_time"`
+	// Reads counts the Get and Has calls on the KV stores, Iterated the entries iterated over and Writes the Set
+	// and Delete calls. ReadBytes and WrittenBytes are the sizes of the keys and values read and written.
+	Reads        uint64 `json:"reads"`
+	Iterated     uint64 `json:"iterated"`
+	Writes       uint64 `json:"writes"`
+	ReadBytes    uint64 `json:"read_bytes"`
+	WrittenBytes uint64 `json:"written_bytes"`
+	// MeteredGas is the gas the gaskv stores metered for the store accesses, which is not consumed, ConsumedGas
+	// the gas the executions consumed explicitly and ChargedGas the gas charged for the msgs by the MsgGasFunc of
+	// the app.
+	MeteredGas  uint64 `json:"metered_gas"`
+	ConsumedGas uint64 `json:"consumed_gas"`
+	ChargedGas  uint64 `json:"charged_gas"`
+}
+
+// add adds the executions of another profile of the same type.
+func (p *MsgProfile) add(o *MsgProfile) {
+	p.Count += o.Count
+	p.Failed += o.Failed
+	p.WallTime += o.WallTime
+	if o.MaxWallTime > p.MaxWallTime {
+		p.MaxWallTime = o.MaxWallTime
+	}
+	p.Reads += o.Reads
+	p.Iterated += o.Iterated
+	p.Writes += o.Writes
+	p.ReadBytes += o.ReadBytes
+	p.WrittenBytes += o.WrittenBytes
+	p.MeteredGas += o.MeteredGas
+	p.ConsumedGas += o.ConsumedGas
+	p.ChargedGas += o.ChargedGas
+}
+
+// MsgProfileReport is the report of the msg profiler, the profiles of the msg types delivered since SinceHeight.
+type MsgProfileReport struct {
+	SinceHeight int64        `json:"since_height"`
+	Profiles    []MsgProfile `json:"profiles"`
+}
+
+// msgProfiler aggregates the profiles of the msgs delivered by type URL. The msgs executed in DeliverTx are
+// profiled, and so are the ones executed speculatively by the parallel executor, whose samples are only recorded
+// if the speculative execution is accepted, so that every msg delivered is profiled once.
+type msgProfiler struct {
+	mtx         sync.Mutex
+	sinceHeight int64
+	profiles    map[string]*MsgProfile
+}
+
+func newMsgProfiler() *msgProfiler {
+	return &msgProfiler{profiles: make(map[string]*MsgProfile)}
+}
+
+// msgSample is the profile of the execution of a msg in progress.
+type msgSample struct {
+	profile     MsgProfile
+	height      int64
+	start       time.Time
+	gasConsumed storetypes.Gas
+	rwConsumed  storetypes.Gas
+}
+
+// speculativeSamplesKey is the context key of the samples of the msgs of a tx executed speculatively, which are
+// recorded once its execution is accepted.
+type speculativeSamplesKey struct{}
+
+// profilesMsgs returns whether the msgs executed in a mode with a context are profiled.
+func (app *BaseApp) profilesMsgs(ctx sdk.Context, mode runTxMode) bool {
+	if app.msgProfiler == nil {
+		return false
+	}
+	if mode == runTxModeDeliver {
+		return true
+	}
+	_, speculative := ctx.Value(speculativeSamplesKey{}).(*[]*msgSample)
+	return mode == runTxModePreDeliver && speculative
+}
+
+// startMsgSample starts profiling the execution of a msg, returning the context to execute it with, whose
+// multistore counts the store accesses.
+func (app *BaseApp) startMsgSample(ctx sdk.Context, msg sdk.Msg) (sdk.Context, *msgSample) {
+	sample := &msgSample{profile: MsgProfile{TypeURL: sdk.MsgTypeURL(msg), Count: 1}, height: ctx.BlockHeight()}
+	if app.msgGasFunc != nil {
+		// the gas is computed on a branch, so that neither the gas nor the store reads and writes of the
+		// function change the results of the tx
+		gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).
+			WithMultiStore(ctx.MultiStore().CacheMultiStore()).
+			WithEventManager(sdk.NewEventManager())
+		if gas, err := app.msgGasFunc(gasCtx, msg); err == nil {
+			sample.profile.ChargedGas = gas
+		}
+	}
+	if ms, ok := ctx.MultiStore().(sdk.CacheMultiStore); ok {
+		ctx = ctx.WithMultiStore(newProfileMultiStore(ms, &sample.profile))
+	}
+	gasMeter := untouchedGasMeter(ctx.GasMeter())
+	sample.gasConsumed = gasMeter.GasConsumed()
+	sample.rwConsumed = gasMeter.RwConsumed()
+	sample.start = time.Now()
+	return ctx, sample
+}
+
+// record ends the sample of a msg executed with a context, and adds it to the profile of its type, or to the
+// samples of its tx if it's executed speculatively.
+func (p *msgProfiler) record(ctx sdk.Context, sample *msgSample, err error) {
+	s := &sample.profile
+	s.WallTime = time.Since(sample.start)
+	s.MaxWallTime = s.WallTime
+	gasMeter := untouchedGasMeter(ctx.GasMeter())
+	s.ConsumedGas = gasMeter.GasConsumed() - sample.gasConsumed
+	s.MeteredGas = gasMeter.RwConsumed() - sample.rwConsumed
+	if err != nil {
+		s.Failed = 1
+	}
+
+	if samples, ok := ctx.Value(speculativeSamplesKey{}).(*[]*msgSample); ok {
+		*samples = append(*samples, sample)
+		return
+	}
+	p.add(sample)
+}
+
+// add adds samples of msgs to the profiles of their types.
+func (p *msgProfiler) add(samples ...*msgSample) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, sample := range samples {
+		if p.sinceHeight == 0 {
+			p.sinceHeight = sample.height
+		}
+		profile, ok := p.profiles[sample.profile.TypeURL]
+		if !ok {
+			profile = &MsgProfile{TypeURL: sample.profile.TypeURL}
+			p.profiles[sample.profile.TypeURL] = profile
+		}
+		profile.add(&sample.profile)
+	}
+}
+
+// untouchedGasMeter returns the gas meter a touchGasMeter of the parallel executor wraps, so that the profiling
+// doesn't mark it as used and make the speculative execution of a tx rejected.
+func untouchedGasMeter(gasMeter storetypes.GasMeter) storetypes.GasMeter {
+	if touch, ok := gasMeter.(*touchGasMeter); ok {
+		return touch.GasMeter
+	}
+	return gasMeter
+}
+
+// report returns the profiles of the msg types sorted by type URL.
+func (p *msgProfiler) report() MsgProfileReport {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	report := MsgProfileReport{SinceHeight: p.sinceHeight, Profiles: make([]MsgProfile, 0, len(p.profiles))}
+	for _, profile := range p.profiles {
+		report.Profiles = append(report.Profiles, *profile)
+	}
+	sort.Slice(report.Profiles, func(i, j int) bool {
+		return report.Profiles[i].TypeURL < report.Profiles[j].TypeURL
+	})
+	return report
+}
+
+// MsgProfiles returns the execution profiles of the msgs delivered since the node started, by type URL, if msg
+// profiling is enabled.
+func (app *BaseApp) MsgProfiles() (MsgProfileReport, error) {
+	if app.msgProfiler == nil {
+		return MsgProfileReport{}, sdkerrors.ErrUnauthorized.Wrap("msg profiling is disabled, set msg-profiling in app.toml")
+	}
+	return app.msgProfiler.report(), nil
+}
+
+// handleQueryMsgProfiles handles the "/app/msgprofiles" query of the msg profiles.
+func handleQueryMsgProfiles(app *BaseApp) abci.ResponseQuery {
+	report, err := app.MsgProfiles()
+	if err != nil {
+		return sdkerrors.QueryResult(err, app.trace)
+	}
+
+	bz, err := json.Marshal(report)
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode the msg profiles"), app.trace)
+	}
+
+	return abci.ResponseQuery{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    app.LastBlockHeight(),
+		Value:     bz,
+	}
+}
+
+// profileMultiStore is a multistore which counts the accesses to the KV stores of itself and of its branches in
+// the profile of a msg.
+type profileMultiStore struct {
+	cacheMultiStore
+
+	profile *MsgProfile
+}
+
+func newProfileMultiStore(ms sdk.CacheMultiStore, profile *MsgProfile) profileMultiStore {
+	return profileMultiStore{cacheMultiStore: ms, profile: profile}
+}
+
+// GetKVStore implements MultiStore.
+func (ms profileMultiStore) GetKVStore(key storetypes.StoreKey) sdk.KVStore {
+	return profileKVStore{KVStore: ms.cacheMultiStore.GetKVStore(key), profile: ms.profile}
+}
+
+// CacheMultiStore implements MultiStore.
+func (ms profileMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return newProfileMultiStore(ms.cacheMultiStore.CacheMultiStore(), ms.profile)
+}
+
+// CacheWrap implements CacheWrapper.
+func (ms profileMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (ms profileMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// SetTracingContext implements MultiStore.
+func (ms profileMultiStore) SetTracingContext(tc storetypes.TraceContext) storetypes.MultiStore {
+	return newProfileMultiStore(ms.cacheMultiStore.SetTracingContext(tc).(sdk.CacheMultiStore), ms.profile)
+}
+
+// profileKVStore is a KVStore which counts the accesses to it in the profile of a msg.
+type profileKVStore struct {
+	sdk.KVStore
+
+	profile *MsgProfile
+}
+
+// Get implements KVStore.
+func (s profileKVStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.profile.Reads++
+	s.profile.ReadBytes += uint64(len(key) + len(value))
+	return value
+}
+
+// Has implements KVStore.
+func (s profileKVStore) Has(key []byte) bool {
+	s.profile.Reads++
+	s.profile.ReadBytes += uint64(len(key))
+	return s.KVStore.Has(key)
+}
+
+// Set implements KVStore.
+func (s profileKVStore) Set(key, value []byte) {
+	s.profile.Writes++
+	s.profile.WrittenBytes += uint64(len(key) + len(value))
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s profileKVStore) Delete(key []byte) {
+	s.profile.Writes++
+	s.profile.WrittenBytes += uint64(len(key))
+	s.KVStore.Delete(key)
+}
+
+// Iterator implements KVStore.
+func (s profileKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	return newProfileIterator(s.KVStore.Iterator(start, end), s.profile)
+}
+
+// ReverseIterator implements KVStore.
+func (s profileKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return newProfileIterator(s.KVStore.ReverseIterator(start, end), s.profile)
+}
+
+// profileIterator is an iterator which counts the entries it iterates over in the profile of a msg.
+type profileIterator struct {
+	storetypes.Iterator
+
+	profile *MsgProfile
+}
+
+func newProfileIterator(it storetypes.Iterator, profile *MsgProfile) storetypes.Iterator {
+	pit := profileIterator{Iterator: it, profile: profile}
+	pit.count()
+	return pit
+}
+
+// Next implements Iterator.
+func (it profileIterator) Next() {
+	it.Iterator.Next()
+	it.count()
+}
+
+// count counts the entry the iterator is at, if any.
+func (it profileIterator) count() {
+	if it.Iterator.Valid() {
+		it.profile.Iterated++
+		it.profile.ReadBytes += uint64(len(it.Iterator.Key()) + len(it.Iterator.Value()))
+	}
+}
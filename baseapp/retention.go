@@ -0,0 +1,90 @@
+package baseapp
+
+import (
+	"fmt"
+	"sync"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RetentionConstraint returns the heights of the state that a module still needs, e.g. for relayers to query
+// them, given the state of the block being committed. The heights it returns are kept from pruning, and the
+// blocks from them on from being pruned by CometBFT, until it stops returning them. It only reads the state.
+type RetentionConstraint func(ctx sdk.Context) []pruningtypes.PinnedHeights
+
+// retention evaluates the retention constraints at every commit and holds the heights they pin.
+type retention struct {
+	constraints []RetentionConstraint
+
+	mtx    sync.Mutex
+	pinned []pruningtypes.PinnedHeights
+}
+
+// AddRetentionConstraint registers a retention constraint, evaluated at every commit.
+func (app *BaseApp) AddRetentionConstraint(constraint RetentionConstraint) {
+	if app.sealed {
+		panic("AddRetentionConstraint() on sealed BaseApp")
+	}
+
+	app.retention.constraints = append(app.retention.constraints, constraint)
+}
+
+// PinnedHeights returns the heights pinned by the retention constraints at the last commit, sorted by their
+// start.
+func (app *BaseApp) PinnedHeights() []pruningtypes.PinnedHeights {
+	app.retention.mtx.Lock()
+	defer app.retention.mtx.Unlock()
+
+	return append([]pruningtypes.PinnedHeights{}, app.retention.pinned...)
+}
+
+// pinRetainedHeights evaluates the retention constraints on the state of the block being committed, and pins
+// the heights they return in the multistore. A constraint which panics pins nothing, the pins are local to
+// the node so they mustn't halt it.
+func (app *BaseApp) pinRetainedHeights(ctx sdk.Context) {
+	if len(app.retention.constraints) == 0 {
+		return
+	}
+
+	pins := []pruningtypes.PinnedHeights{}
+	for _, constraint := range app.retention.constraints {
+		pins = append(pins, app.evalRetentionConstraint(ctx, constraint)...)
+	}
+	pruningtypes.SortPinnedHeights(pins)
+
+	app.retention.mtx.Lock()
+	app.retention.pinned = pins
+	app.retention.mtx.Unlock()
+
+	if cms, ok := app.cms.(interface {
+		SetPinnedHeights([]pruningtypes.PinnedHeights)
+	}); ok {
+		cms.SetPinnedHeights(pins)
+	}
+}
+
+func (app *BaseApp) evalRetentionConstraint(ctx sdk.Context, constraint RetentionConstraint) (pins []pruningtypes.PinnedHeights) {
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error("retention constraint panicked", "err", fmt.Sprint(r))
+			pins = nil
+		}
+	}()
+
+	// the constraints read a branch of the state, so they neither write nor consume gas
+	cacheCtx, _ := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+	return constraint(cacheCtx)
+}
+
+// lowestPinnedHeight returns the lowest height pinned at the last commit, 0 if none is.
+func (app *BaseApp) lowestPinnedHeight() int64 {
+	app.retention.mtx.Lock()
+	defer app.retention.mtx.Unlock()
+
+	if len(app.retention.pinned) == 0 {
+		return 0
+	}
+	return app.retention.pinned[0].Start
+}
@@ -0,0 +1,77 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Span attribute keys of the ABCI calls, messages and queries.
+const (
+	spanAttributeBlockHeight = "block.height"
+	spanAttributeTxHash      = "tx.hash"
+	spanAttributeGasWanted   = "gas.wanted"
+	spanAttributeMsgTypeURL  = "msg.type_url"
+)
+
+// txSpanNames are the names of the spans of the txs run in the modes which are traced.
+var txSpanNames = map[runTxMode]string{
+	runTxModeCheck:      "CheckTx",
+	runTxModeReCheck:    "ReCheckTx",
+	runTxModeSimulate:   "SimulateTx",
+	runTxModeDeliver:    "DeliverTx",
+	runTxModePreDeliver: "PreDeliverTx",
+}
+
+// startBlockSpan starts the span of the block being delivered, the parent of the spans of its ABCI calls until it
+// is committed.
+func (app *BaseApp) startBlockSpan(height int64) {
+	if !telemetry.IsTracingEnabled() {
+		return
+	}
+	// the previous block was not committed
+	app.endBlockSpan()
+
+	ctx, span := telemetry.StartSpan(context.Background(), "Block", attribute.Int64(spanAttributeBlockHeight, height))
+	app.blockSpan = span
+	app.deliverState.ctx = app.deliverState.ctx.WithContext(ctx)
+}
+
+// endBlockSpan ends the span of the block being delivered, if any.
+func (app *BaseApp) endBlockSpan() {
+	if app.blockSpan != nil {
+		app.blockSpan.End()
+		app.blockSpan = nil
+	}
+}
+
+// startTxSpan starts the span of a tx run in a mode, if the mode is traced. The spans of the txs delivered are
+// children of the span of their block.
+func startTxSpan(ctx sdk.Context, mode runTxMode, txBytes []byte) (sdk.Context, trace.Span) {
+	name, ok := txSpanNames[mode]
+	if !ok || !telemetry.IsTracingEnabled() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	goCtx, span := telemetry.StartSpan(ctx.Context(), name,
+		attribute.Int64(spanAttributeBlockHeight, ctx.BlockHeight()),
+		attribute.String(spanAttributeTxHash, fmt.Sprintf("%X", tmhash.Sum(txBytes))),
+	)
+	return ctx.WithContext(goCtx), span
+}
+
+// endTxSpan ends the span of a tx with the gas it used.
+func endTxSpan(span trace.Span, gInfo sdk.GasInfo, err error) {
+	if span.IsRecording() {
+		span.SetAttributes(
+			attribute.Int64(spanAttributeGasWanted, int64(gInfo.GasWanted)),
+			attribute.Int64(sdk.SpanAttributeGasUsed, int64(gInfo.GasUsed)),
+		)
+	}
+	telemetry.EndSpan(span, err)
+}
@@ -0,0 +1,117 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// anteHandlerDecorator is an ante decorator running an ante handler.
+type anteHandlerDecorator struct {
+	handler sdk.AnteHandler
+}
+
+func (d anteHandlerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	newCtx, err := d.handler(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+	return next(newCtx, tx, simulate)
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key string) (attribute.Value, bool) {
+	for _, attr := range span.Attributes() {
+		if string(attr.Key) == key {
+			return attr.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestABCI_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	telemetry.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer telemetry.SetTracerProvider(nil)
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(sdk.ChainAnteDecorators(anteHandlerDecorator{anteHandlerTxTest(t, capKey1, anteKey)}))
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+	suite.baseApp.EndBlock(abci.RequestEndBlock{})
+	suite.baseApp.Commit()
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	block, ok := spans["Block"]
+	require.True(t, ok)
+	height, _ := spanAttribute(block, "block.height")
+	require.Equal(t, int64(1), height.AsInt64())
+
+	// the spans of the ABCI calls are children of the span of the block
+	deliverTx, ok := spans["DeliverTx"]
+	require.True(t, ok)
+	require.Equal(t, block.SpanContext().SpanID(), deliverTx.Parent().SpanID())
+	commit, ok := spans["Commit"]
+	require.True(t, ok)
+	require.Equal(t, block.SpanContext().SpanID(), commit.Parent().SpanID())
+	gasUsed, _ := spanAttribute(deliverTx, sdk.SpanAttributeGasUsed)
+	require.Equal(t, res.GasUsed, gasUsed.AsInt64())
+
+	// the spans of the ante decorators and the message handlers are children of the span of the tx
+	ante, ok := spans["baseapp_test.anteHandlerDecorator"]
+	require.True(t, ok)
+	require.Equal(t, deliverTx.SpanContext().SpanID(), ante.Parent().SpanID())
+	msg, ok := spans["/Counter/IncrementCounter"]
+	require.True(t, ok)
+	require.Equal(t, deliverTx.SpanContext().SpanID(), msg.Parent().SpanID())
+
+	// with the store accesses they make
+	for _, span := range []sdktrace.ReadOnlySpan{ante, msg} {
+		reads, ok := spanAttribute(span, sdk.SpanAttributeStoreReads)
+		require.True(t, ok)
+		require.Equal(t, int64(1), reads.AsInt64())
+		writes, ok := spanAttribute(span, sdk.SpanAttributeStoreWrites)
+		require.True(t, ok)
+		require.Equal(t, int64(1), writes.AsInt64())
+	}
+
+	// the txs checked are traced in their own traces
+	recorder = tracetest.NewSpanRecorder()
+	telemetry.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	txBytes, err = suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 1, 1))
+	require.NoError(t, err)
+	require.True(t, suite.baseApp.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+	var checkTx sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == "CheckTx" {
+			checkTx = span
+		}
+	}
+	require.NotNil(t, checkTx)
+	require.False(t, checkTx.Parent().IsValid())
+}
@@ -133,7 +133,14 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
 		if keyringBackend != "" {
-			kr, err := NewKeyringFromBackend(clientCtx, keyringBackend)
+			krCtx := clientCtx
+			if keyringBackend == keyring.BackendRemote {
+				opts := append(clientCtx.KeyringOptions[:len(clientCtx.KeyringOptions):len(clientCtx.KeyringOptions)],
+					readRemoteSignerFlags(flagSet))
+				krCtx = krCtx.WithKeyringOptions(opts...)
+			}
+
+			kr, err := NewKeyringFromBackend(krCtx, keyringBackend)
 			if err != nil {
 				return clientCtx, err
 			}
@@ -316,6 +323,22 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 	return clientCtx, nil
 }
 
+// readRemoteSignerFlags returns the keyring option configuring the remote signer of the remote keyring
+// backend from the command flags.
+func readRemoteSignerFlags(flagSet *pflag.FlagSet) keyring.Option {
+	addr, _ := flagSet.GetString(flags.FlagKeyringRemoteAddr)
+	caFile, _ := flagSet.GetString(flags.FlagKeyringRemoteCA)
+	certFile, _ := flagSet.GetString(flags.FlagKeyringRemoteCert)
+	keyFile, _ := flagSet.GetString(flags.FlagKeyringRemoteKey)
+
+	return keyring.WithRemoteSigner(keyring.RemoteSignerConfig{
+		Addr:     addr,
+		CAFile:   caFile,
+		CertFile: certFile,
+		KeyFile:  keyFile,
+	})
+}
+
 // GetClientQueryContext returns a Context from a command with fields set based on flags
 // defined in AddQueryFlagsToCmd. An error is returned if any flag query fails.
 //
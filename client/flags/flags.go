@@ -91,6 +91,12 @@ const (
 	FlagLogFormat = "log_format"
 
 	FlagPrintEIP712MsgType = "print-eip712-msg-type"
+
+	// Remote keyring backend flags
+	FlagKeyringRemoteAddr = "keyring-remote-addr"
+	FlagKeyringRemoteCA   = "keyring-remote-ca"
+	FlagKeyringRemoteCert = "keyring-remote-cert"
+	FlagKeyringRemoteKey  = "keyring-remote-key"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -146,7 +152,11 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 // AddKeyringFlags sets common keyring flags
 func AddKeyringFlags(flags *pflag.FlagSet) {
 	flags.String(FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory|remote)")
+	flags.String(FlagKeyringRemoteAddr, "", "The gRPC address of the remote signer of the remote keyring backend")
+	flags.String(FlagKeyringRemoteCA, "", "The CA certificate file verifying the remote signer")
+	flags.String(FlagKeyringRemoteCert, "", "The client certificate file presented to the remote signer")
+	flags.String(FlagKeyringRemoteKey, "", "The client key file presented to the remote signer")
 }
 
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
@@ -0,0 +1,1832 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/node/v1beta1/crosschain.proto
+
+package node
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CrossChainRecordsRequest defines the request structure for the Records gRPC query.
+type CrossChainRecordsRequest struct {
+	// from_index is the index of the first record returned.
+	FromIndex uint64 `protobuf:"varint,1,opt,name=from_index,json=fromIndex,proto3" json:"from_index,omitempty"`
+	// limit is the maximum number of records returned, up to 1000, which is also the limit if it is 0.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// record_types are the types of the records returned, all of them if empty.
+	RecordTypes []string `protobuf:"bytes,3,rep,name=record_types,json=recordTypes,proto3" json:"record_types,omitempty"`
+	// min_height and max_height bound the heights of the records returned, 0 for no bound.
+	MinHeight int64 `protobuf:"varint,4,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight int64 `protobuf:"varint,5,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// channel_id is the channel of the records returned if filter_channel is set, any channel otherwise.
+	ChannelId     uint32 `protobuf:"varint,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	FilterChannel bool   `protobuf:"varint,7,opt,name=filter_channel,json=filterChannel,proto3" json:"filter_channel,omitempty"`
+}
+
+func (m *CrossChainRecordsRequest) Reset()         { *m = CrossChainRecordsRequest{} }
+func (m *CrossChainRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*CrossChainRecordsRequest) ProtoMessage()    {}
+func (*CrossChainRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_377722372b943aec, []int{0}
+}
+func (m *CrossChainRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossChainRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossChainRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossChainRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainRecordsRequest.Merge(m, src)
+}
+func (m *CrossChainRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossChainRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossChainRecordsRequest proto.InternalMessageInfo
+
+func (m *CrossChainRecordsRequest) GetFromIndex() uint64 {
+	if m != nil {
+		return m.FromIndex
+	}
+	return 0
+}
+
+func (m *CrossChainRecordsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *CrossChainRecordsRequest) GetRecordTypes() []string {
+	if m != nil {
+		return m.RecordTypes
+	}
+	return nil
+}
+
+func (m *CrossChainRecordsRequest) GetMinHeight() int64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *CrossChainRecordsRequest) GetMaxHeight() int64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *CrossChainRecordsRequest) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *CrossChainRecordsRequest) GetFilterChannel() bool {
+	if m != nil {
+		return m.FilterChannel
+	}
+	return false
+}
+
+// CrossChainRecordsResponse defines the response structure for the Records gRPC query.
+type CrossChainRecordsResponse struct {
+	Records []*CrossChainRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// next_index is the index to query the next records from.
+	NextIndex uint64 `protobuf:"varint,2,opt,name=next_index,json=nextIndex,proto3" json:"next_index,omitempty"`
+}
+
+func (m *CrossChainRecordsResponse) Reset()         { *m = CrossChainRecordsResponse{} }
+func (m *CrossChainRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*CrossChainRecordsResponse) ProtoMessage()    {}
+func (*CrossChainRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_377722372b943aec, []int{1}
+}
+func (m *CrossChainRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossChainRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossChainRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossChainRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainRecordsResponse.Merge(m, src)
+}
+func (m *CrossChainRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossChainRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossChainRecordsResponse proto.InternalMessageInfo
+
+func (m *CrossChainRecordsResponse) GetRecords() []*CrossChainRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *CrossChainRecordsResponse) GetNextIndex() uint64 {
+	if m != nil {
+		return m.NextIndex
+	}
+	return 0
+}
+
+// CrossChainRecord is a normalized cross-chain record, the fields which don't apply to its type are empty.
+type CrossChainRecord struct {
+	// index is the position of the record in the stream, starting at 0.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// height is the height of the block the record comes from.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_index is the index of the tx of the record in the block, -1 if it doesn't come from a tx.
+	TxIndex int32 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// record_type is package_sent, package_received, relayer_fee or sequence_gap.
+	RecordType string `protobuf:"bytes,4,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// direction is send for the packages sent by this chain, receive for the received ones.
+	Direction   string `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	SrcChainId  uint32 `protobuf:"varint,6,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"`
+	DestChainId uint32 `protobuf:"varint,7,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	ChannelId   uint32 `protobuf:"varint,8,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence    uint64 `protobuf:"varint,9,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// package_type is the type of the package: syn, ack or fail_ack.
+	PackageType string `protobuf:"bytes,10,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	Timestamp   uint64 `protobuf:"varint,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// payload is the hex encoded payload of a sent package, without its header.
+	Payload       string `protobuf:"bytes,12,opt,name=payload,proto3" json:"payload,omitempty"`
+	RelayerFee    string `protobuf:"bytes,13,opt,name=relayer_fee,json=relayerFee,proto3" json:"relayer_fee,omitempty"`
+	AckRelayerFee string `protobuf:"bytes,14,opt,name=ack_relayer_fee,json=ackRelayerFee,proto3" json:"ack_relayer_fee,omitempty"`
+	// outcome is the outcome of the execution of a received package: success, error or crash.
+	Outcome string `protobuf:"bytes,15,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error   string `protobuf:"bytes,16,opt,name=error,proto3" json:"error,omitempty"`
+	// ack_package_type is the type of the package sent back for a received syn package, ack or fail_ack, and
+	// ack_sequence its sequence, set if ack_package_type is.
+	AckPackageType string `protobuf:"bytes,17,opt,name=ack_package_type,json=ackPackageType,proto3" json:"ack_package_type,omitempty"`
+	AckSequence    uint64 `protobuf:"varint,18,opt,name=ack_sequence,json=ackSequence,proto3" json:"ack_sequence,omitempty"`
+	// expected_sequence is the sequence a gap record expected instead of sequence.
+	ExpectedSequence uint64 `protobuf:"varint,19,opt,name=expected_sequence,json=expectedSequence,proto3" json:"expected_sequence,omitempty"`
+}
+
+func (m *CrossChainRecord) Reset()         { *m = CrossChainRecord{} }
+func (m *CrossChainRecord) String() string { return proto.CompactTextString(m) }
+func (*CrossChainRecord) ProtoMessage()    {}
+func (*CrossChainRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_377722372b943aec, []int{2}
+}
+func (m *CrossChainRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossChainRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossChainRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossChainRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainRecord.Merge(m, src)
+}
+func (m *CrossChainRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossChainRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossChainRecord proto.InternalMessageInfo
+
+func (m *CrossChainRecord) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *CrossChainRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CrossChainRecord) GetTxIndex() int32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *CrossChainRecord) GetRecordType() string {
+	if m != nil {
+		return m.RecordType
+	}
+	return ""
+}
+
+func (m *CrossChainRecord) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *CrossChainRecord) GetSrcChainId() uint32 {
+	if m != nil {
+		return m.SrcChainId
+	}
+	return 0
+}
+
+func (m *CrossChainRecord) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *CrossChainRecord) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *CrossChainRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *CrossChainRecord) GetPackageType() string {
+	if m != nil {
+		return m.PackageType
+	}
+	return ""
+}
+
+func (m *CrossChainRecord) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *CrossChainRecord) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *CrossChainRecord) GetRelayerFee() string {
+	if m != nil {
+		return m.RelayerFee
+	}
+	return ""
+}
+
+func (m *CrossChainRecord) GetAckRelayerFee() string {
+	if m != nil {
+		return m.AckRelayerFee
+	}
+	return ""
+}
+
+func (m *CrossChainRecord) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *CrossChainRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CrossChainRecord) GetAckPackageType() string {
+	if m != nil {
+		return m.AckPackageType
+	}
+	return ""
+}
+
+func (m *CrossChainRecord) GetAckSequence() uint64 {
+	if m != nil {
+		return m.AckSequence
+	}
+	return 0
+}
+
+func (m *CrossChainRecord) GetExpectedSequence() uint64 {
+	if m != nil {
+		return m.ExpectedSequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CrossChainRecordsRequest)(nil), "cosmos.base.node.v1beta1.CrossChainRecordsRequest")
+	proto.RegisterType((*CrossChainRecordsResponse)(nil), "cosmos.base.node.v1beta1.CrossChainRecordsResponse")
+	proto.RegisterType((*CrossChainRecord)(nil), "cosmos.base.node.v1beta1.CrossChainRecord")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/node/v1beta1/crosschain.proto", fileDescriptor_377722372b943aec)
+}
+
+var fileDescriptor_377722372b943aec = []byte{
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xae, 0x93, 0xb6, 0x89, 0x27, 0x49, 0x9b, 0xce, 0xbd, 0xba, 0x9a, 0x56, 0xbd, 0xb9, 0x6e,
+	0xa4, 0x8b, 0xcc, 0x4f, 0x6d, 0xb5, 0xe5, 0x09, 0x28, 0x02, 0xba, 0x43, 0x86, 0x15, 0x1b, 0x6b,
+	0x32, 0x3e, 0x4d, 0x46, 0x89, 0x3d, 0xc6, 0x33, 0x45, 0xe9, 0x0e, 0xf1, 0x04, 0x48, 0xbc, 0x04,
+	0x4f, 0xc1, 0x9a, 0x65, 0x25, 0x36, 0x2c, 0x51, 0xcb, 0x86, 0x17, 0x60, 0x8d, 0x66, 0xc6, 0xae,
+	0xd3, 0x02, 0x52, 0x59, 0x25, 0xe7, 0x3b, 0xdf, 0xf9, 0x66, 0xce, 0x77, 0x8e, 0x07, 0xdd, 0x66,
+	0x42, 0xa6, 0x42, 0x86, 0x23, 0x2a, 0x21, 0xcc, 0x44, 0x02, 0xe1, 0xab, 0xbd, 0x11, 0x28, 0xba,
+	0x17, 0xb2, 0x42, 0x48, 0xc9, 0x26, 0x94, 0x67, 0x41, 0x5e, 0x08, 0x25, 0x30, 0xb1, 0xd4, 0x40,
+	0x53, 0x03, 0x4d, 0x0d, 0x4a, 0xea, 0xd6, 0xf6, 0x58, 0x88, 0xf1, 0x0c, 0x42, 0x9a, 0xf3, 0x90,
+	0x66, 0x99, 0x50, 0x54, 0x71, 0x91, 0x49, 0x5b, 0x37, 0xfc, 0xee, 0x20, 0x72, 0xa8, 0xc5, 0x0e,
+	0xb5, 0x58, 0x04, 0x4c, 0x14, 0x89, 0x8c, 0xe0, 0xe5, 0x09, 0x48, 0x85, 0xff, 0x45, 0xe8, 0xb8,
+	0x10, 0x69, 0xcc, 0xb3, 0x04, 0xe6, 0xc4, 0xf1, 0x1c, 0x7f, 0x39, 0x72, 0x35, 0x72, 0xa4, 0x01,
+	0xfc, 0x37, 0x5a, 0x99, 0xf1, 0x94, 0x2b, 0xd2, 0xf0, 0x1c, 0xbf, 0x17, 0xd9, 0x00, 0xef, 0xa0,
+	0x6e, 0x61, 0x64, 0x62, 0x75, 0x9a, 0x83, 0x24, 0x4d, 0xaf, 0xe9, 0xbb, 0x51, 0xc7, 0x62, 0xcf,
+	0x35, 0xa4, 0x75, 0x53, 0x9e, 0xc5, 0x13, 0xe0, 0xe3, 0x89, 0x22, 0xcb, 0x9e, 0xe3, 0x37, 0x23,
+	0x37, 0xe5, 0xd9, 0x13, 0x03, 0x98, 0x34, 0x9d, 0x57, 0xe9, 0x95, 0x32, 0x4d, 0xe7, 0x75, 0x9a,
+	0x4d, 0x68, 0x96, 0xc1, 0x2c, 0xe6, 0x09, 0x59, 0x35, 0x67, 0xbb, 0x25, 0x72, 0x94, 0xe0, 0xff,
+	0xd1, 0xda, 0x31, 0x9f, 0x29, 0x28, 0xe2, 0x12, 0x23, 0x2d, 0xcf, 0xf1, 0xdb, 0x51, 0xcf, 0xa2,
+	0x87, 0x16, 0x1c, 0xbe, 0x76, 0xd0, 0xe6, 0x2f, 0x1a, 0x97, 0xb9, 0xc8, 0x24, 0xe0, 0x87, 0xa8,
+	0x65, 0x2f, 0x2c, 0x89, 0xe3, 0x35, 0xfd, 0xce, 0xfe, 0x9d, 0xe0, 0x77, 0x06, 0x07, 0xd7, 0x55,
+	0xa2, 0xaa, 0x54, 0xdf, 0x34, 0x83, 0xb9, 0x2a, 0xfd, 0x6b, 0x58, 0xff, 0x34, 0x62, 0xfc, 0x1b,
+	0x7e, 0x5b, 0x46, 0xfd, 0xeb, 0xc5, 0xda, 0xd4, 0x45, 0xbb, 0x6d, 0x80, 0xff, 0x41, 0xab, 0xa5,
+	0x1d, 0x0d, 0x63, 0x47, 0x19, 0xe1, 0x4d, 0xd4, 0x56, 0xf3, 0x52, 0xbf, 0xe9, 0x39, 0xfe, 0x4a,
+	0xd4, 0x52, 0x73, 0x3b, 0x9d, 0xff, 0x50, 0x67, 0x61, 0x0e, 0xc6, 0x65, 0x37, 0x42, 0xf5, 0x18,
+	0xf0, 0x36, 0x72, 0x13, 0x5e, 0x00, 0xd3, 0xeb, 0x60, 0x5c, 0x76, 0xa3, 0x1a, 0xc0, 0x1e, 0xea,
+	0xca, 0x82, 0xc5, 0x66, 0xc7, 0x6a, 0x9f, 0x91, 0x2c, 0x98, 0xb9, 0xed, 0x51, 0x82, 0x87, 0xa8,
+	0x97, 0x80, 0x54, 0x35, 0xa5, 0x65, 0x28, 0x1d, 0x0d, 0x56, 0x9c, 0xab, 0xb3, 0x6a, 0x5f, 0x9f,
+	0xd5, 0x16, 0x6a, 0x4b, 0xbd, 0x6b, 0x19, 0x03, 0xe2, 0x9a, 0x7e, 0x2f, 0x63, 0xbd, 0x47, 0x39,
+	0x65, 0x53, 0x3a, 0x06, 0xdb, 0x00, 0x32, 0x37, 0xec, 0x94, 0x58, 0xd5, 0x81, 0xe2, 0x29, 0x48,
+	0x45, 0xd3, 0x9c, 0x74, 0xac, 0xbd, 0x97, 0x00, 0x26, 0xa8, 0x95, 0xd3, 0xd3, 0x99, 0xa0, 0x09,
+	0xe9, 0x9a, 0xda, 0x2a, 0xb4, 0xd6, 0xcc, 0xe8, 0x29, 0x14, 0xf1, 0x31, 0x00, 0xe9, 0x55, 0xd6,
+	0x18, 0xe8, 0x11, 0x00, 0xbe, 0x85, 0xd6, 0x29, 0x9b, 0xc6, 0x8b, 0xa4, 0x35, 0x43, 0xea, 0x51,
+	0x36, 0x8d, 0x6a, 0x1e, 0x41, 0x2d, 0x71, 0xa2, 0x98, 0x48, 0x81, 0xac, 0xdb, 0x23, 0xca, 0x50,
+	0x8f, 0x11, 0x8a, 0x42, 0x14, 0xa4, 0x6f, 0x70, 0x1b, 0x60, 0x1f, 0xf5, 0xb5, 0xee, 0x95, 0xbe,
+	0x36, 0x0c, 0x61, 0x8d, 0xb2, 0xe9, 0xd3, 0x85, 0xd6, 0x76, 0x50, 0x57, 0x33, 0x2f, 0xdd, 0xc1,
+	0xa6, 0xbb, 0x0e, 0x65, 0xd3, 0x67, 0x95, 0x41, 0x77, 0xd1, 0x06, 0xcc, 0x73, 0x60, 0x0a, 0x92,
+	0x9a, 0xf7, 0x97, 0xe1, 0xf5, 0xab, 0x44, 0x45, 0xde, 0xff, 0xe0, 0xa0, 0x8d, 0x9f, 0xd6, 0x1d,
+	0xbf, 0x77, 0x50, 0xab, 0xfa, 0xbf, 0x7f, 0xf3, 0x0d, 0xaf, 0x1e, 0x88, 0xad, 0x83, 0x3f, 0xaa,
+	0xb1, 0xdf, 0xd6, 0xf0, 0xfe, 0x9b, 0x4f, 0x5f, 0xdf, 0x35, 0x02, 0x7c, 0x2f, 0xbc, 0xc1, 0xf3,
+	0x16, 0x97, 0xdf, 0xd2, 0x83, 0xc7, 0x1f, 0xcf, 0x07, 0xce, 0xd9, 0xf9, 0xc0, 0xf9, 0x72, 0x3e,
+	0x70, 0xde, 0x5e, 0x0c, 0x96, 0xce, 0x2e, 0x06, 0x4b, 0x9f, 0x2f, 0x06, 0x4b, 0x2f, 0x76, 0xc7,
+	0x5c, 0x4d, 0x4e, 0x46, 0x01, 0x13, 0x69, 0xa5, 0x68, 0x7f, 0x76, 0x65, 0x32, 0x0d, 0xd9, 0x8c,
+	0x43, 0xa6, 0xc2, 0x71, 0x91, 0x33, 0x73, 0xc6, 0x68, 0xd5, 0x3c, 0x7c, 0x07, 0x3f, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xaa, 0x02, 0x81, 0x67, 0x5d, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CrossChainRecordsClient is the client API for CrossChainRecords service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CrossChainRecordsClient interface {
+	// Records queries for the records written by the crosschain streaming service which match the filters, in the
+	// order of the stream.
+	Records(ctx context.Context, in *CrossChainRecordsRequest, opts ...grpc.CallOption) (*CrossChainRecordsResponse, error)
+}
+
+type crossChainRecordsClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewCrossChainRecordsClient(cc grpc1.ClientConn) CrossChainRecordsClient {
+	return &crossChainRecordsClient{cc}
+}
+
+func (c *crossChainRecordsClient) Records(ctx context.Context, in *CrossChainRecordsRequest, opts ...grpc.CallOption) (*CrossChainRecordsResponse, error) {
+	out := new(CrossChainRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.CrossChainRecords/Records", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CrossChainRecordsServer is the server API for CrossChainRecords service.
+type CrossChainRecordsServer interface {
+	// Records queries for the records written by the crosschain streaming service which match the filters, in the
+	// order of the stream.
+	Records(context.Context, *CrossChainRecordsRequest) (*CrossChainRecordsResponse, error)
+}
+
+// UnimplementedCrossChainRecordsServer can be embedded to have forward compatible implementations.
+type UnimplementedCrossChainRecordsServer struct {
+}
+
+func (*UnimplementedCrossChainRecordsServer) Records(ctx context.Context, req *CrossChainRecordsRequest) (*CrossChainRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+}
+
+func RegisterCrossChainRecordsServer(s grpc1.Server, srv CrossChainRecordsServer) {
+	s.RegisterService(&_CrossChainRecords_serviceDesc, srv)
+}
+
+func _CrossChainRecords_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrossChainRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrossChainRecordsServer).Records(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.CrossChainRecords/Records",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrossChainRecordsServer).Records(ctx, req.(*CrossChainRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CrossChainRecords_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.node.v1beta1.CrossChainRecords",
+	HandlerType: (*CrossChainRecordsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Records",
+			Handler:    _CrossChainRecords_Records_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/node/v1beta1/crosschain.proto",
+}
+
+func (m *CrossChainRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FilterChannel {
+		i--
+		if m.FilterChannel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RecordTypes) > 0 {
+		for iNdEx := len(m.RecordTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecordTypes[iNdEx])
+			copy(dAtA[i:], m.RecordTypes[iNdEx])
+			i = encodeVarintCrosschain(dAtA, i, uint64(len(m.RecordTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Limit != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromIndex != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.FromIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossChainRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextIndex != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.NextIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrosschain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossChainRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpectedSequence != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.ExpectedSequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.AckSequence != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.AckSequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.AckPackageType) > 0 {
+		i -= len(m.AckPackageType)
+		copy(dAtA[i:], m.AckPackageType)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.AckPackageType)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Outcome) > 0 {
+		i -= len(m.Outcome)
+		copy(dAtA[i:], m.Outcome)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.Outcome)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.AckRelayerFee) > 0 {
+		i -= len(m.AckRelayerFee)
+		copy(dAtA[i:], m.AckRelayerFee)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.AckRelayerFee)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RelayerFee) > 0 {
+		i -= len(m.RelayerFee)
+		copy(dAtA[i:], m.RelayerFee)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.RelayerFee)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PackageType) > 0 {
+		i -= len(m.PackageType)
+		copy(dAtA[i:], m.PackageType)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.PackageType)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SrcChainId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.SrcChainId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RecordType) > 0 {
+		i -= len(m.RecordType)
+		copy(dAtA[i:], m.RecordType)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.RecordType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCrosschain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCrosschain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CrossChainRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromIndex != 0 {
+		n += 1 + sovCrosschain(uint64(m.FromIndex))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovCrosschain(uint64(m.Limit))
+	}
+	if len(m.RecordTypes) > 0 {
+		for _, s := range m.RecordTypes {
+			l = len(s)
+			n += 1 + l + sovCrosschain(uint64(l))
+		}
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovCrosschain(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovCrosschain(uint64(m.MaxHeight))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovCrosschain(uint64(m.ChannelId))
+	}
+	if m.FilterChannel {
+		n += 2
+	}
+	return n
+}
+
+func (m *CrossChainRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovCrosschain(uint64(l))
+		}
+	}
+	if m.NextIndex != 0 {
+		n += 1 + sovCrosschain(uint64(m.NextIndex))
+	}
+	return n
+}
+
+func (m *CrossChainRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovCrosschain(uint64(m.Index))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCrosschain(uint64(m.Height))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovCrosschain(uint64(m.TxIndex))
+	}
+	l = len(m.RecordType)
+	if l > 0 {
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	if m.SrcChainId != 0 {
+		n += 1 + sovCrosschain(uint64(m.SrcChainId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovCrosschain(uint64(m.DestChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovCrosschain(uint64(m.ChannelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCrosschain(uint64(m.Sequence))
+	}
+	l = len(m.PackageType)
+	if l > 0 {
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovCrosschain(uint64(m.Timestamp))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	l = len(m.RelayerFee)
+	if l > 0 {
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	l = len(m.AckRelayerFee)
+	if l > 0 {
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	l = len(m.Outcome)
+	if l > 0 {
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovCrosschain(uint64(l))
+	}
+	l = len(m.AckPackageType)
+	if l > 0 {
+		n += 2 + l + sovCrosschain(uint64(l))
+	}
+	if m.AckSequence != 0 {
+		n += 2 + sovCrosschain(uint64(m.AckSequence))
+	}
+	if m.ExpectedSequence != 0 {
+		n += 2 + sovCrosschain(uint64(m.ExpectedSequence))
+	}
+	return n
+}
+
+func sovCrosschain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCrosschain(x uint64) (n int) {
+	return sovCrosschain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CrossChainRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromIndex", wireType)
+			}
+			m.FromIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordTypes = append(m.RecordTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterChannel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FilterChannel = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossChainRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &CrossChainRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIndex", wireType)
+			}
+			m.NextIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossChainRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+			}
+			m.SrcChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PackageType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckRelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckRelayerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outcome = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPackageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckPackageType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckSequence", wireType)
+			}
+			m.AckSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedSequence", wireType)
+			}
+			m.ExpectedSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCrosschain(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCrosschain
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCrosschain
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCrosschain
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCrosschain
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCrosschain        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCrosschain          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCrosschain = fmt.Errorf("proto: unexpected end of group")
+)
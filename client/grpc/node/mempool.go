@@ -0,0 +1,138 @@
+package node
+
+import (
+	context "context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ MempoolServer = mempoolServer{}
+
+type mempoolServer struct {
+	clientCtx client.Context
+}
+
+func NewMempoolServer(clientCtx client.Context) MempoolServer {
+	return mempoolServer{
+		clientCtx: clientCtx,
+	}
+}
+
+func (s mempoolServer) PendingTxs(_ context.Context, req *PendingTxsRequest) (*PendingTxsResponse, error) {
+	return QueryPendingTxs(s.clientCtx, req.Sender)
+}
+
+func (s mempoolServer) PendingTx(_ context.Context, req *PendingTxRequest) (*PendingTxResponse, error) {
+	return QueryPendingTx(s.clientCtx, req.Hash)
+}
+
+func (s mempoolServer) NonceGaps(_ context.Context, _ *NonceGapsRequest) (*NonceGapsResponse, error) {
+	return QueryNonceGaps(s.clientCtx)
+}
+
+var _ MempoolAdminServer = mempoolAdminServer{}
+
+type mempoolAdminServer struct {
+	clientCtx client.Context
+	token     []byte
+}
+
+// NewMempoolAdminServer returns the MempoolAdmin service of the node, which prefixes the eviction queries with
+// the token of the app. It's only meant to be served by the admin listener of the node, see
+// servergrpc.StartMempoolAdminServer.
+func NewMempoolAdminServer(clientCtx client.Context, token []byte) MempoolAdminServer {
+	return mempoolAdminServer{
+		clientCtx: clientCtx,
+		token:     token,
+	}
+}
+
+func (s mempoolAdminServer) EvictTx(_ context.Context, req *EvictTxRequest) (*EvictTxResponse, error) {
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx hash: %w", err)
+	}
+	data := append(append([]byte{}, s.token...), hash...)
+	if _, err := s.clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/mempool/evict", Data: data}); err != nil {
+		return nil, err
+	}
+	return &EvictTxResponse{}, nil
+}
+
+// QueryPendingTxs queries the node for the txs of its app-side mempool, only the ones of a sender if it isn't
+// empty.
+func QueryPendingTxs(clientCtx client.Context, sender string) (*PendingTxsResponse, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/mempool/txs", Data: []byte(sender)})
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []mempool.PendingTx
+	if err := json.Unmarshal(res.Value, &pending); err != nil {
+		return nil, err
+	}
+
+	resp := &PendingTxsResponse{Height: res.Height, Txs: make([]*PendingTx, len(pending))}
+	for i, desc := range pending {
+		resp.Txs[i] = pendingTxFromDesc(desc)
+	}
+	return resp, nil
+}
+
+// QueryPendingTx queries the node for the tx of its app-side mempool with a hash, as hex.
+func QueryPendingTx(clientCtx client.Context, hash string) (*PendingTxResponse, error) {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx hash: %w", err)
+	}
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/mempool/tx", Data: bz})
+	if err != nil {
+		return nil, err
+	}
+
+	var desc mempool.PendingTx
+	if err := json.Unmarshal(res.Value, &desc); err != nil {
+		return nil, err
+	}
+	return &PendingTxResponse{Tx: pendingTxFromDesc(desc)}, nil
+}
+
+// QueryNonceGaps queries the node for the first nonce and the nonce gaps of each sender of its app-side
+// mempool.
+func QueryNonceGaps(clientCtx client.Context) (*NonceGapsResponse, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/mempool/gaps"})
+	if err != nil {
+		return nil, err
+	}
+
+	var gaps []mempool.NonceGaps
+	if err := json.Unmarshal(res.Value, &gaps); err != nil {
+		return nil, err
+	}
+
+	resp := &NonceGapsResponse{Height: res.Height, Senders: make([]*NonceGaps, len(gaps))}
+	for i, senderGaps := range gaps {
+		resp.Senders[i] = &NonceGaps{Sender: senderGaps.Sender, FirstNonce: senderGaps.FirstNonce}
+		for _, missing := range senderGaps.Missing {
+			resp.Senders[i].Missing = append(resp.Senders[i].Missing, &NonceRange{Start: missing.Start, End: missing.End})
+		}
+	}
+	return resp, nil
+}
+
+func pendingTxFromDesc(desc mempool.PendingTx) *PendingTx {
+	return &PendingTx{
+		Hash:     fmt.Sprintf("%X", desc.Hash),
+		Sender:   desc.Sender,
+		Nonce:    desc.Nonce,
+		Priority: desc.Priority,
+		Size_:    uint64(desc.Size),
+		Tx:       desc.Tx,
+	}
+}
@@ -0,0 +1,68 @@
+package node
+
+import (
+	context "context"
+	"encoding/json"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+var _ ProfilerServer = profilerServer{}
+
+type profilerServer struct {
+	clientCtx client.Context
+}
+
+func NewProfilerServer(clientCtx client.Context) ProfilerServer {
+	return profilerServer{
+		clientCtx: clientCtx,
+	}
+}
+
+func (s profilerServer) MsgProfiles(_ context.Context, req *MsgProfilesRequest) (*MsgProfilesResponse, error) {
+	return QueryMsgProfiles(s.clientCtx, req.TypeUrls...)
+}
+
+// QueryMsgProfiles queries the node for the execution profiles of the msgs it delivered, only the ones of some
+// type URLs if any.
+func QueryMsgProfiles(clientCtx client.Context, typeURLs ...string) (*MsgProfilesResponse, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/msgprofiles"})
+	if err != nil {
+		return nil, err
+	}
+
+	var report baseapp.MsgProfileReport
+	if err := json.Unmarshal(res.Value, &report); err != nil {
+		return nil, err
+	}
+
+	filter := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		filter[typeURL] = true
+	}
+	resp := &MsgProfilesResponse{Height: res.Height, SinceHeight: report.SinceHeight}
+	for _, profile := range report.Profiles {
+		if len(filter) > 0 && !filter[profile.TypeURL] {
+			continue
+		}
+		resp.Profiles = append(resp.Profiles, &MsgProfile{
+			TypeUrl:       profile.TypeURL,
+			Count:         profile.Count,
+			Failed:        profile.Failed,
+			WallTimeNs:    uint64(profile.WallTime),
+			MaxWallTimeNs: uint64(profile.MaxWallTime),
+			Reads:         profile.Reads,
+			Iterated:      profile.Iterated,
+			Writes:        profile.Writes,
+			ReadBytes:     profile.ReadBytes,
+			WrittenBytes:  profile.WrittenBytes,
+			MeteredGas:    profile.MeteredGas,
+			ConsumedGas:   profile.ConsumedGas,
+			ChargedGas:    profile.ChargedGas,
+		})
+	}
+	return resp, nil
+}
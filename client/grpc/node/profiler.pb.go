@@ -0,0 +1,1296 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/node/v1beta1/profiler.proto
+
+package node
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgProfilesRequest defines the request structure for the MsgProfiles gRPC query.
+type MsgProfilesRequest struct {
+	// type_urls filters the profiles of the msgs of these type URLs if it isn't empty.
+	TypeUrls []string `protobuf:"bytes,1,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty"`
+}
+
+func (m *MsgProfilesRequest) Reset()         { *m = MsgProfilesRequest{} }
+func (m *MsgProfilesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgProfilesRequest) ProtoMessage()    {}
+func (*MsgProfilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83d99913c18223ea, []int{0}
+}
+func (m *MsgProfilesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProfilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProfilesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProfilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProfilesRequest.Merge(m, src)
+}
+func (m *MsgProfilesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProfilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProfilesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProfilesRequest proto.InternalMessageInfo
+
+func (m *MsgProfilesRequest) GetTypeUrls() []string {
+	if m != nil {
+		return m.TypeUrls
+	}
+	return nil
+}
+
+// MsgProfilesResponse defines the response structure for the MsgProfiles gRPC query.
+type MsgProfilesResponse struct {
+	// height is the height at which the profiles were queried.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// since_height is the height of the first msg profiled.
+	SinceHeight int64 `protobuf:"varint,2,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+	// profiles are the profiles of the msg types sorted by type URL.
+	Profiles []*MsgProfile `protobuf:"bytes,3,rep,name=profiles,proto3" json:"profiles,omitempty"`
+}
+
+func (m *MsgProfilesResponse) Reset()         { *m = MsgProfilesResponse{} }
+func (m *MsgProfilesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProfilesResponse) ProtoMessage()    {}
+func (*MsgProfilesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83d99913c18223ea, []int{1}
+}
+func (m *MsgProfilesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProfilesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProfilesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProfilesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProfilesResponse.Merge(m, src)
+}
+func (m *MsgProfilesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProfilesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProfilesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProfilesResponse proto.InternalMessageInfo
+
+func (m *MsgProfilesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgProfilesResponse) GetSinceHeight() int64 {
+	if m != nil {
+		return m.SinceHeight
+	}
+	return 0
+}
+
+func (m *MsgProfilesResponse) GetProfiles() []*MsgProfile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+// MsgProfile aggregates the executions of the delivered msgs of a type.
+type MsgProfile struct {
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// count is the number of msgs executed.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// failed is the number of msgs whose handler returned an error.
+	Failed uint64 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// wall_time_ns is the total duration of the executions in nanoseconds.
+	WallTimeNs uint64 `protobuf:"varint,4,opt,name=wall_time_ns,json=wallTimeNs,proto3" json:"wall_time_ns,omitempty"`
+	// max_wall_time_ns is the duration of the longest execution in nanoseconds.
+	MaxWallTimeNs uint64 `protobuf:"varint,5,opt,name=max_wall_time_ns,json=maxWallTimeNs,proto3" json:"max_wall_time_ns,omitempty"`
+	// reads counts the Get and Has calls on the KV stores.
+	Reads uint64 `protobuf:"varint,6,opt,name=reads,proto3" json:"reads,omitempty"`
+	// iterated counts the entries of the KV stores iterated over.
+	Iterated uint64 `protobuf:"varint,7,opt,name=iterated,proto3" json:"iterated,omitempty"`
+	// writes counts the Set and Delete calls on the KV stores.
+	Writes uint64 `protobuf:"varint,8,opt,name=writes,proto3" json:"writes,omitempty"`
+	// read_bytes is the size of the keys and values read.
+	ReadBytes uint64 `protobuf:"varint,9,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	// written_bytes is the size of the keys and values written.
+	WrittenBytes uint64 `protobuf:"varint,10,opt,name=written_bytes,json=writtenBytes,proto3" json:"written_bytes,omitempty"`
+	// metered_gas is the gas the gaskv stores metered for the store accesses, which is not consumed.
+	MeteredGas uint64 `protobuf:"varint,11,opt,name=metered_gas,json=meteredGas,proto3" json:"metered_gas,omitempty"`
+	// consumed_gas is the gas the executions consumed explicitly.
+	ConsumedGas uint64 `protobuf:"varint,12,opt,name=consumed_gas,json=consumedGas,proto3" json:"consumed_gas,omitempty"`
+	// charged_gas is the gas charged for the msgs by their type, by the gashub module.
+	ChargedGas uint64 `protobuf:"varint,13,opt,name=charged_gas,json=chargedGas,proto3" json:"charged_gas,omitempty"`
+}
+
+func (m *MsgProfile) Reset()         { *m = MsgProfile{} }
+func (m *MsgProfile) String() string { return proto.CompactTextString(m) }
+func (*MsgProfile) ProtoMessage()    {}
+func (*MsgProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83d99913c18223ea, []int{2}
+}
+func (m *MsgProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProfile.Merge(m, src)
+}
+func (m *MsgProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProfile proto.InternalMessageInfo
+
+func (m *MsgProfile) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MsgProfile) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetFailed() uint64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetWallTimeNs() uint64 {
+	if m != nil {
+		return m.WallTimeNs
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetMaxWallTimeNs() uint64 {
+	if m != nil {
+		return m.MaxWallTimeNs
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetReads() uint64 {
+	if m != nil {
+		return m.Reads
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetIterated() uint64 {
+	if m != nil {
+		return m.Iterated
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetWrites() uint64 {
+	if m != nil {
+		return m.Writes
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetReadBytes() uint64 {
+	if m != nil {
+		return m.ReadBytes
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetWrittenBytes() uint64 {
+	if m != nil {
+		return m.WrittenBytes
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetMeteredGas() uint64 {
+	if m != nil {
+		return m.MeteredGas
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetConsumedGas() uint64 {
+	if m != nil {
+		return m.ConsumedGas
+	}
+	return 0
+}
+
+func (m *MsgProfile) GetChargedGas() uint64 {
+	if m != nil {
+		return m.ChargedGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgProfilesRequest)(nil), "cosmos.base.node.v1beta1.MsgProfilesRequest")
+	proto.RegisterType((*MsgProfilesResponse)(nil), "cosmos.base.node.v1beta1.MsgProfilesResponse")
+	proto.RegisterType((*MsgProfile)(nil), "cosmos.base.node.v1beta1.MsgProfile")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/node/v1beta1/profiler.proto", fileDescriptor_83d99913c18223ea)
+}
+
+var fileDescriptor_83d99913c18223ea = []byte{
+	// 538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0xba, 0x49, 0x9d, 0x71, 0x22, 0xfd, 0xb4, 0xbf, 0x0a, 0x99, 0x00, 0x6e, 0x08,
+	0x88, 0xe6, 0x40, 0x6c, 0xa5, 0xbc, 0x00, 0xea, 0x25, 0x5c, 0x40, 0xc8, 0x02, 0x21, 0x71, 0xb1,
+	0x36, 0xf6, 0xd6, 0x59, 0x61, 0x7b, 0xcd, 0xee, 0x86, 0xb6, 0x57, 0x9e, 0x00, 0xd1, 0x1b, 0x2f,
+	0xc0, 0xab, 0x70, 0xac, 0xc4, 0x85, 0x23, 0x4a, 0x78, 0x10, 0xb4, 0x7f, 0xd2, 0xd0, 0x43, 0xa5,
+	0x9e, 0xac, 0xf9, 0x7e, 0x3f, 0x33, 0x9e, 0xd1, 0xcc, 0xc2, 0x61, 0xc6, 0x44, 0xc5, 0x44, 0x3c,
+	0xc7, 0x82, 0xc4, 0x35, 0xcb, 0x49, 0xfc, 0x69, 0x3a, 0x27, 0x12, 0x4f, 0xe3, 0x86, 0xb3, 0x13,
+	0x5a, 0x12, 0x1e, 0x35, 0x9c, 0x49, 0x86, 0x02, 0x03, 0x46, 0x0a, 0x8c, 0x14, 0x18, 0x59, 0x70,
+	0x70, 0xbf, 0x60, 0xac, 0x28, 0x49, 0x8c, 0x1b, 0x1a, 0xe3, 0xba, 0x66, 0x12, 0x4b, 0xca, 0x6a,
+	0x61, 0xf2, 0x46, 0x53, 0x40, 0x2f, 0x45, 0xf1, 0xda, 0x14, 0x13, 0x09, 0xf9, 0xb8, 0x24, 0x42,
+	0xa2, 0x7b, 0xd0, 0x95, 0xe7, 0x0d, 0x49, 0x97, 0xbc, 0x14, 0x81, 0x33, 0x74, 0xc7, 0xdd, 0xc4,
+	0x53, 0xc2, 0x5b, 0x5e, 0x8a, 0xd1, 0x57, 0x07, 0xfe, 0xbf, 0x96, 0x23, 0x1a, 0x56, 0x0b, 0x82,
+	0xee, 0x40, 0x67, 0x41, 0x68, 0xb1, 0x90, 0x81, 0x33, 0x74, 0xc6, 0x6e, 0x62, 0x23, 0xf4, 0x10,
+	0x7a, 0x82, 0xd6, 0x19, 0x49, 0xad, 0xbb, 0xa3, 0x5d, 0x5f, 0x6b, 0x2f, 0x0c, 0xf2, 0x1c, 0x3c,
+	0x3b, 0x8f, 0x08, 0xdc, 0xa1, 0x3b, 0xf6, 0x8f, 0x1e, 0x47, 0x37, 0x0d, 0x14, 0x6d, 0xff, 0x9d,
+	0x5c, 0x65, 0x8d, 0x2e, 0x5c, 0x80, 0xad, 0x81, 0xee, 0x82, 0xb7, 0x19, 0x40, 0x77, 0xd3, 0x4d,
+	0xf6, 0x6c, 0xff, 0x68, 0x1f, 0xda, 0x19, 0x5b, 0xd6, 0xa6, 0x8f, 0xdd, 0xc4, 0x04, 0xaa, 0xf9,
+	0x13, 0x4c, 0x4b, 0x92, 0x07, 0xae, 0x96, 0x6d, 0x84, 0x86, 0xd0, 0x3b, 0xc5, 0x65, 0x99, 0x4a,
+	0x5a, 0x91, 0xb4, 0x16, 0xc1, 0xae, 0x76, 0x41, 0x69, 0x6f, 0x68, 0x45, 0x5e, 0x09, 0x74, 0x08,
+	0xff, 0x55, 0xf8, 0x2c, 0xbd, 0x46, 0xb5, 0x35, 0xd5, 0xaf, 0xf0, 0xd9, 0xbb, 0x2d, 0xb8, 0x0f,
+	0x6d, 0x4e, 0x70, 0x2e, 0x82, 0x8e, 0xf9, 0xb1, 0x0e, 0xd0, 0x00, 0x3c, 0x2a, 0x09, 0xc7, 0x92,
+	0xe4, 0xc1, 0x9e, 0x36, 0xae, 0x62, 0xd5, 0xd4, 0x29, 0xa7, 0x92, 0x88, 0xc0, 0x33, 0x4d, 0x99,
+	0x08, 0x3d, 0x00, 0x50, 0xc9, 0xe9, 0xfc, 0x5c, 0x79, 0x5d, 0xed, 0x75, 0x95, 0x72, 0xac, 0x04,
+	0xf4, 0x08, 0xfa, 0x0a, 0x94, 0xa4, 0xb6, 0x04, 0x68, 0xa2, 0x67, 0x45, 0x03, 0x1d, 0x80, 0x5f,
+	0x11, 0x49, 0x38, 0xc9, 0xd3, 0x02, 0x8b, 0xc0, 0x37, 0x73, 0x59, 0x69, 0x86, 0x85, 0x5a, 0x5b,
+	0xc6, 0x6a, 0xb1, 0xac, 0x2c, 0xd1, 0xd3, 0x84, 0xbf, 0xd1, 0x14, 0x72, 0x00, 0x7e, 0xb6, 0xc0,
+	0xbc, 0xb0, 0x44, 0xdf, 0xd4, 0xb0, 0xd2, 0x0c, 0x8b, 0xa3, 0xef, 0x0e, 0x78, 0x76, 0x25, 0x1c,
+	0x7d, 0x73, 0xc0, 0xff, 0xe7, 0x6e, 0xd0, 0xd3, 0xdb, 0xac, 0x78, 0x73, 0x92, 0x83, 0xc9, 0x2d,
+	0x69, 0x73, 0x8c, 0xa3, 0xe8, 0xf3, 0xcf, 0x3f, 0x17, 0x3b, 0x63, 0xf4, 0x24, 0xbe, 0xf1, 0x05,
+	0x55, 0xa2, 0x48, 0x37, 0xf7, 0x73, 0x3c, 0xfb, 0xb1, 0x0a, 0x9d, 0xcb, 0x55, 0xe8, 0xfc, 0x5e,
+	0x85, 0xce, 0x97, 0x75, 0xd8, 0xba, 0x5c, 0x87, 0xad, 0x5f, 0xeb, 0xb0, 0xf5, 0x7e, 0x52, 0x50,
+	0xb9, 0x58, 0xce, 0xa3, 0x8c, 0x55, 0x9b, 0x5a, 0xe6, 0x33, 0x11, 0xf9, 0x87, 0x38, 0x2b, 0x29,
+	0xa9, 0x65, 0x5c, 0xf0, 0x26, 0xd3, 0xd5, 0xe7, 0x1d, 0xfd, 0xae, 0x9e, 0xfd, 0x0d, 0x00, 0x00,
+	0xff, 0xff, 0xb4, 0xd0, 0x4e, 0x33, 0xba, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProfilerClient is the client API for Profiler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProfilerClient interface {
+	// MsgProfiles queries for the execution profiles of the msgs delivered since the node started by type URL, with
+	// the gas charged for them by their type next to the gas their execution consumed, which requires msg-profiling
+	// to be set in app.toml.
+	MsgProfiles(ctx context.Context, in *MsgProfilesRequest, opts ...grpc.CallOption) (*MsgProfilesResponse, error)
+}
+
+type profilerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewProfilerClient(cc grpc1.ClientConn) ProfilerClient {
+	return &profilerClient{cc}
+}
+
+func (c *profilerClient) MsgProfiles(ctx context.Context, in *MsgProfilesRequest, opts ...grpc.CallOption) (*MsgProfilesResponse, error) {
+	out := new(MsgProfilesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.node.v1beta1.Profiler/MsgProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfilerServer is the server API for Profiler service.
+type ProfilerServer interface {
+	// MsgProfiles queries for the execution profiles of the msgs delivered since the node started by type URL, with
+	// the gas charged for them by their type next to the gas their execution consumed, which requires msg-profiling
+	// to be set in app.toml.
+	MsgProfiles(context.Context, *MsgProfilesRequest) (*MsgProfilesResponse, error)
+}
+
+// UnimplementedProfilerServer can be embedded to have forward compatible implementations.
+type UnimplementedProfilerServer struct {
+}
+
+func (*UnimplementedProfilerServer) MsgProfiles(ctx context.Context, req *MsgProfilesRequest) (*MsgProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgProfiles not implemented")
+}
+
+func RegisterProfilerServer(s grpc1.Server, srv ProfilerServer) {
+	s.RegisterService(&_Profiler_serviceDesc, srv)
+}
+
+func _Profiler_MsgProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilerServer).MsgProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.node.v1beta1.Profiler/MsgProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilerServer).MsgProfiles(ctx, req.(*MsgProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Profiler_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.node.v1beta1.Profiler",
+	HandlerType: (*ProfilerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MsgProfiles",
+			Handler:    _Profiler_MsgProfiles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/node/v1beta1/profiler.proto",
+}
+
+func (m *MsgProfilesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProfilesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProfilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeUrls) > 0 {
+		for iNdEx := len(m.TypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeUrls[iNdEx])
+			copy(dAtA[i:], m.TypeUrls[iNdEx])
+			i = encodeVarintProfiler(dAtA, i, uint64(len(m.TypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProfilesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProfilesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProfilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Profiles) > 0 {
+		for iNdEx := len(m.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfiler(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SinceHeight != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChargedGas != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.ChargedGas))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ConsumedGas != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.ConsumedGas))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MeteredGas != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.MeteredGas))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.WrittenBytes != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.WrittenBytes))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ReadBytes != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.ReadBytes))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Writes != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.Writes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Iterated != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.Iterated))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Reads != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.Reads))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxWallTimeNs != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.MaxWallTimeNs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WallTimeNs != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.WallTimeNs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Failed != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintProfiler(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintProfiler(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProfiler(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProfiler(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgProfilesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TypeUrls) > 0 {
+		for _, s := range m.TypeUrls {
+			l = len(s)
+			n += 1 + l + sovProfiler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProfilesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProfiler(uint64(m.Height))
+	}
+	if m.SinceHeight != 0 {
+		n += 1 + sovProfiler(uint64(m.SinceHeight))
+	}
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovProfiler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovProfiler(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovProfiler(uint64(m.Count))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovProfiler(uint64(m.Failed))
+	}
+	if m.WallTimeNs != 0 {
+		n += 1 + sovProfiler(uint64(m.WallTimeNs))
+	}
+	if m.MaxWallTimeNs != 0 {
+		n += 1 + sovProfiler(uint64(m.MaxWallTimeNs))
+	}
+	if m.Reads != 0 {
+		n += 1 + sovProfiler(uint64(m.Reads))
+	}
+	if m.Iterated != 0 {
+		n += 1 + sovProfiler(uint64(m.Iterated))
+	}
+	if m.Writes != 0 {
+		n += 1 + sovProfiler(uint64(m.Writes))
+	}
+	if m.ReadBytes != 0 {
+		n += 1 + sovProfiler(uint64(m.ReadBytes))
+	}
+	if m.WrittenBytes != 0 {
+		n += 1 + sovProfiler(uint64(m.WrittenBytes))
+	}
+	if m.MeteredGas != 0 {
+		n += 1 + sovProfiler(uint64(m.MeteredGas))
+	}
+	if m.ConsumedGas != 0 {
+		n += 1 + sovProfiler(uint64(m.ConsumedGas))
+	}
+	if m.ChargedGas != 0 {
+		n += 1 + sovProfiler(uint64(m.ChargedGas))
+	}
+	return n
+}
+
+func sovProfiler(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProfiler(x uint64) (n int) {
+	return sovProfiler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgProfilesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfiler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProfilesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProfilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfiler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfiler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrls = append(m.TypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfiler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfiler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProfilesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfiler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProfilesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProfilesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+			}
+			m.SinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfiler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfiler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profiles = append(m.Profiles, &MsgProfile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfiler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfiler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProfiler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfiler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfiler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WallTimeNs", wireType)
+			}
+			m.WallTimeNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WallTimeNs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWallTimeNs", wireType)
+			}
+			m.MaxWallTimeNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWallTimeNs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			m.Reads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reads |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iterated", wireType)
+			}
+			m.Iterated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iterated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+			}
+			m.Writes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Writes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytes", wireType)
+			}
+			m.ReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrittenBytes", wireType)
+			}
+			m.WrittenBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WrittenBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeteredGas", wireType)
+			}
+			m.MeteredGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MeteredGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumedGas", wireType)
+			}
+			m.ConsumedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsumedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargedGas", wireType)
+			}
+			m.ChargedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProfiler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProfiler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProfiler(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProfiler
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProfiler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProfiler
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProfiler
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProfiler
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProfiler        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProfiler          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProfiler = fmt.Errorf("proto: unexpected end of group")
+)
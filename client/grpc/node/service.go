@@ -2,23 +2,34 @@ package node
 
 import (
 	context "context"
+	"encoding/json"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// RegisterNodeService registers the node gRPC service on the provided gRPC router.
+// RegisterNodeService registers the node gRPC service, the mempool gRPC service and the profiler gRPC service on
+// the provided gRPC router.
 func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server) {
 	RegisterServiceServer(server, NewQueryServer(clientCtx))
+	RegisterMempoolServer(server, NewMempoolServer(clientCtx))
+	RegisterProfilerServer(server, NewProfilerServer(clientCtx))
 }
 
-// RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
-// on the given mux object.
+// RegisterGRPCGatewayRoutes mounts the node, mempool, profiler and crosschain records gRPC services' GRPC-gateway
+// routes on the given mux object. The crosschain records service is registered by the crosschain streaming
+// service, if enabled.
 func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
 	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+	_ = RegisterMempoolHandlerClient(context.Background(), mux, NewMempoolClient(clientConn))
+	_ = RegisterProfilerHandlerClient(context.Background(), mux, NewProfilerClient(clientConn))
+	_ = RegisterCrossChainRecordsHandlerClient(context.Background(), mux, NewCrossChainRecordsClient(clientConn))
 }
 
 var _ ServiceServer = queryServer{}
@@ -33,6 +44,14 @@ func NewQueryServer(clientCtx client.Context) ServiceServer {
 	}
 }
 
+func (s queryServer) StateSize(_ context.Context, req *StateSizeRequest) (*StateSizeResponse, error) {
+	return QueryStateSize(s.clientCtx, req.Height)
+}
+
+func (s queryServer) PinnedHeights(_ context.Context, _ *PinnedHeightsRequest) (*PinnedHeightsResponse, error) {
+	return QueryPinnedHeights(s.clientCtx)
+}
+
 func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -40,3 +59,92 @@ func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigRespo
 		MinimumGasPrice: sdkCtx.MinGasPrices().String(),
 	}, nil
 }
+
+// QueryStateSize queries the node for the state size at a height, the latest one if it is 0.
+func QueryStateSize(clientCtx client.Context, height int64) (*StateSizeResponse, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/statesize", Height: height})
+	if err != nil {
+		return nil, err
+	}
+
+	var size rootmulti.StateSize
+	if err := json.Unmarshal(res.Value, &size); err != nil {
+		return nil, err
+	}
+
+	resp := &StateSizeResponse{Height: size.Version, Stores: make([]*StoreStateSize, len(size.Stores))}
+	for i, store := range size.Stores {
+		resp.Stores[i] = &StoreStateSize{
+			Name:       store.Name,
+			Keys:       store.Keys,
+			Bytes:      store.Bytes,
+			KeysDelta:  store.KeysDelta,
+			BytesDelta: store.BytesDelta,
+		}
+		for _, prefix := range store.Prefixes {
+			resp.Stores[i].Prefixes = append(resp.Stores[i].Prefixes, &PrefixStateSize{
+				Name:       prefix.Name,
+				Prefix:     prefix.Prefix,
+				Keys:       prefix.Keys,
+				Bytes:      prefix.Bytes,
+				KeysDelta:  prefix.KeysDelta,
+				BytesDelta: prefix.BytesDelta,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// QueryPinnedHeights queries the node for the heights pinned by the retention constraints of the modules.
+func QueryPinnedHeights(clientCtx client.Context) (*PinnedHeightsResponse, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/pinnedheights"})
+	if err != nil {
+		return nil, err
+	}
+
+	var pins []pruningtypes.PinnedHeights
+	if err := json.Unmarshal(res.Value, &pins); err != nil {
+		return nil, err
+	}
+
+	resp := &PinnedHeightsResponse{Height: res.Height, Pins: make([]*PinnedHeights, len(pins))}
+	for i, pin := range pins {
+		resp.Pins[i] = &PinnedHeights{Start: pin.Start, End: pin.End, Reason: pin.Reason}
+	}
+	return resp, nil
+}
+
+// Growth returns the state size at the height of the response with the deltas counted since another state
+// size. The stores and prefixes missing from the other one are counted from zero.
+func (r *StateSizeResponse) Growth(since *StateSizeResponse) *StateSizeResponse {
+	prev := make(map[string]*StoreStateSize, len(since.Stores))
+	for _, store := range since.Stores {
+		prev[store.Name] = store
+	}
+
+	growth := &StateSizeResponse{Height: r.Height, Stores: make([]*StoreStateSize, len(r.Stores))}
+	for i, store := range r.Stores {
+		s := &StoreStateSize{Name: store.Name, Keys: store.Keys, Bytes: store.Bytes, KeysDelta: int64(store.Keys), BytesDelta: int64(store.Bytes)}
+		prevPrefixes := make(map[string]*PrefixStateSize)
+		if p, ok := prev[store.Name]; ok {
+			s.KeysDelta -= int64(p.Keys)
+			s.BytesDelta -= int64(p.Bytes)
+			for _, prefix := range p.Prefixes {
+				prevPrefixes[prefix.Name] = prefix
+			}
+		}
+		for _, prefix := range store.Prefixes {
+			ps := &PrefixStateSize{
+				Name: prefix.Name, Prefix: prefix.Prefix, Keys: prefix.Keys, Bytes: prefix.Bytes,
+				KeysDelta: int64(prefix.Keys), BytesDelta: int64(prefix.Bytes),
+			}
+			if p, ok := prevPrefixes[prefix.Name]; ok {
+				ps.KeysDelta -= int64(p.Keys)
+				ps.BytesDelta -= int64(p.Bytes)
+			}
+			s.Prefixes = append(s.Prefixes, ps)
+		}
+		growth.Stores[i] = s
+	}
+	return growth
+}
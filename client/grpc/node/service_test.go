@@ -19,3 +19,18 @@ func TestServiceServer_Config(t *testing.T) {
 	require.NotNil(t, resp)
 	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
 }
+
+func TestStateSizeResponse_Growth(t *testing.T) {
+	since := &StateSizeResponse{Height: 1, Stores: []*StoreStateSize{
+		{Name: "a", Keys: 2, Bytes: 20, Prefixes: []*PrefixStateSize{{Name: "p", Keys: 1, Bytes: 10}}},
+	}}
+	size := &StateSizeResponse{Height: 3, Stores: []*StoreStateSize{
+		{Name: "a", Keys: 1, Bytes: 30, KeysDelta: 1, BytesDelta: 1, Prefixes: []*PrefixStateSize{{Name: "p", Keys: 1, Bytes: 15}}},
+		{Name: "b", Keys: 1, Bytes: 5},
+	}}
+
+	require.Equal(t, &StateSizeResponse{Height: 3, Stores: []*StoreStateSize{
+		{Name: "a", Keys: 1, Bytes: 30, KeysDelta: -1, BytesDelta: 10, Prefixes: []*PrefixStateSize{{Name: "p", Keys: 1, Bytes: 15, BytesDelta: 5}}},
+		{Name: "b", Keys: 1, Bytes: 5, KeysDelta: 1, BytesDelta: 5},
+	}}, size.Growth(since))
+}
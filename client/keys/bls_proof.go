@@ -0,0 +1,69 @@
+package keys
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/cli"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// BLSProofOutput is the output of the bls-proof command.
+type BLSProofOutput struct {
+	PubKeyHex string `json:"pubkey_hex" yaml:"pubkey_hex"`
+	Proof     string `json:"bls_proof" yaml:"bls_proof"`
+}
+
+// BLSProofCmd returns the Cobra Command for signing the proof of possession of a BLS key.
+func BLSProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-proof <name>",
+		Short: "Sign the proof of possession of a BLS key",
+		Long: `Sign the proof of possession of a BLS key of the keyring, as expected in the bls_proof
+field of the create and edit validator messages along with the BLS public key.
+
+Example:
+	$ gnfd keys add bls --keyring-backend test --algo eth_bls
+	$ gnfd keys bls-proof bls --keyring-backend test
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pubKey, proof, err := keyring.SignBLSProof(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			out := BLSProofOutput{
+				PubKeyHex: hex.EncodeToString(pubKey),
+				Proof:     hex.EncodeToString(proof),
+			}
+
+			outputFormat, _ := cmd.Flags().GetString(cli.OutputFlag)
+			switch outputFormat {
+			case OutputFormatText:
+				cmd.Println(out.Proof)
+			case OutputFormatJSON:
+				jsonString, err := json.Marshal(out)
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(jsonString))
+			default:
+				return fmt.Errorf("invalid output format %s", outputFormat)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
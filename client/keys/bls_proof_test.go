@@ -0,0 +1,69 @@
+package keys
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttestutil "github.com/cosmos/cosmos-sdk/client/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runBLSProofCmd(t *testing.T) {
+	cdc := clienttestutil.MakeTestCodec(t)
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	path := sdk.GetConfig().GetFullBIP44Path()
+	k, err := kb.NewAccount("bls", testdata.TestMnemonic, "", path, hd.EthBLS)
+	require.NoError(t, err)
+	_, err = kb.NewAccount("eth", testdata.TestMnemonic, "", path, hd.EthSecp256k1)
+	require.NoError(t, err)
+	pk, err := k.GetPubKey()
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithKeyring(kb).
+		WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	run := func(args ...string) (string, error) {
+		cmd := BLSProofCmd()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		cmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		))
+		_, mockOut := testutil.ApplyMockIO(cmd)
+		err := cmd.ExecuteContext(ctx)
+		return mockOut.String(), err
+	}
+
+	_, err = run("eth")
+	require.ErrorIs(t, err, keyring.ErrNotBLSKey)
+
+	out, err := run("bls", fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatJSON))
+	require.NoError(t, err)
+	var proof BLSProofOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &proof))
+	require.Equal(t, hex.EncodeToString(pk.Bytes()), proof.PubKeyHex)
+	require.Len(t, proof.Proof, 2*sdk.BLSSignatureLength)
+
+	// BLS signatures are deterministic
+	out, err = run("bls", fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatText))
+	require.NoError(t, err)
+	require.Equal(t, proof.Proof+"\n", out)
+}
@@ -30,7 +30,10 @@ private key material is exported in an INSECURE fashion that is designed to
 allow users to import their keys in hot wallets. This feature is for advanced
 users only that are confident about how to handle private keys work and are
 FULLY AWARE OF THE RISKS. If you are unsure, you may want to do some research
-and export your keys in ASCII-armored encrypted format.`,
+and export your keys in ASCII-armored encrypted format.
+
+When the --bls-keystore flag is selected, a BLS key is exported as an EIP-2335
+keystore encrypted with the given passphrase.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -40,6 +43,11 @@ and export your keys in ASCII-armored encrypted format.`,
 			buf := bufio.NewReader(clientCtx.Input)
 			unarmored, _ := cmd.Flags().GetBool(flagUnarmoredHex)
 			unsafe, _ := cmd.Flags().GetBool(flagUnsafe)
+			blsKeystore, _ := cmd.Flags().GetBool(flagBLSKeystore)
+
+			if blsKeystore && (unarmored || unsafe) {
+				return fmt.Errorf("the flag %s can't be used with %s and %s", flagBLSKeystore, flagUnsafe, flagUnarmoredHex)
+			}
 
 			if unarmored && unsafe {
 				return exportUnsafeUnarmored(cmd, args[0], buf, clientCtx.Keyring)
@@ -52,6 +60,17 @@ and export your keys in ASCII-armored encrypted format.`,
 				return err
 			}
 
+			if blsKeystore {
+				keystore, err := keyring.ExportBLSKeystore(clientCtx.Keyring, args[0], encryptPassword)
+				if err != nil {
+					return err
+				}
+
+				cmd.Println(string(keystore))
+
+				return nil
+			}
+
 			armored, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
 			if err != nil {
 				return err
@@ -65,6 +84,7 @@ and export your keys in ASCII-armored encrypted format.`,
 
 	cmd.Flags().Bool(flagUnarmoredHex, false, "Export unarmored hex privkey. Requires --unsafe.")
 	cmd.Flags().Bool(flagUnsafe, false, "Enable unsafe operations. This flag must be switched on along with all unsafe operation-specific options.")
+	cmd.Flags().Bool(flagBLSKeystore, false, "Export a BLS key as an EIP-2335 keystore.")
 
 	return cmd
 }
@@ -11,11 +11,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
 )
 
 const (
 	flagSecp256k1PrivateKey = "secp256k1-private-key"
+	flagBLSKeystore         = "bls-keystore"
 )
 
 // ImportKeyCommand imports private keys from a keyfile.
@@ -23,7 +25,7 @@ func ImportKeyCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "import <name> <keyfile>/<privateKey>",
 		Short: "Import private keys into the local keybase",
-		Long:  "Import a ASCII armored/Secp256k1 private key into the local keybase.",
+		Long:  "Import a ASCII armored/Secp256k1 private key or an EIP-2335 BLS keystore into the local keybase.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -32,16 +34,23 @@ func ImportKeyCommand() *cobra.Command {
 			}
 
 			isSecp256k1, _ := cmd.Flags().GetBool(flagSecp256k1PrivateKey)
-
-			if !isSecp256k1 {
+			isBLSKeystore, _ := cmd.Flags().GetBool(flagBLSKeystore)
+
+			switch {
+			case isSecp256k1 && isBLSKeystore:
+				return fmt.Errorf("the flags %s and %s are mutually exclusive", flagSecp256k1PrivateKey, flagBLSKeystore)
+			case isSecp256k1:
+				return importSecp256k1(clientCtx, args)
+			case isBLSKeystore:
+				return importBLSKeystore(clientCtx, args)
+			default:
 				return importASCIIArmored(clientCtx, args)
 			}
-
-			return importSecp256k1(clientCtx, args)
 		},
 	}
 
 	cmd.Flags().Bool(flagSecp256k1PrivateKey, false, "import Secp256k1 format private key")
+	cmd.Flags().Bool(flagBLSKeystore, false, "import a BLS private key from an EIP-2335 keystore file")
 
 	return cmd
 }
@@ -81,3 +90,20 @@ func importSecp256k1(clientCtx client.Context, args []string) error {
 	}
 	return nil
 }
+
+func importBLSKeystore(clientCtx client.Context, args []string) error {
+	buf := bufio.NewReader(clientCtx.Input)
+
+	bz, err := os.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := input.GetPassword("Enter passphrase to decrypt your keystore:", buf)
+	if err != nil {
+		return err
+	}
+
+	_, err = keyring.ImportBLSKeystore(clientCtx.Keyring, args[0], bz, passphrase)
+	return err
+}
@@ -0,0 +1,94 @@
+package keys
+
+import (
+	"errors"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+)
+
+const (
+	flagListen  = "listen"
+	flagTLSCA   = "tls-ca"
+	flagTLSCert = "tls-cert"
+	flagTLSKey  = "tls-key"
+
+	defaultRemoteSignerListenAddr = "127.0.0.1:9095"
+)
+
+// RemoteSignerCmd returns the Cobra Command serving the keys of the keyring to remote keyring backends.
+func RemoteSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve-remote-signer",
+		Short: "Serve the keys of the keyring to the remote keyring backends over mutual TLS",
+		Long: `Run a remote signer holding the eth_secp256k1 and eth_bls keys of the keyring, so that they
+can be kept off the disk of the node. Clients select it with the remote keyring backend and sign
+EIP-712 digests with the eth_secp256k1 keys and BLS messages with the eth_bls keys, for instance
+with the tx sign and keys sign commands. The signer only accepts the clients presenting a
+certificate signed by the --tls-ca certificate.
+
+Example:
+	$ gnfd keys serve-remote-signer --keyring-backend file --listen 127.0.0.1:9095 \
+		--tls-ca ca.pem --tls-cert signer.pem --tls-key signer-key.pem
+	$ gnfd tx sign tx.json --from validator --keyring-backend remote \
+		--keyring-remote-addr 127.0.0.1:9095 --keyring-remote-ca ca.pem \
+		--keyring-remote-cert client.pem --keyring-remote-key client-key.pem
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.Keyring == nil {
+				return errors.New("no keyring to serve")
+			}
+			if clientCtx.Keyring.Backend() == keyring.BackendRemote {
+				return errors.New("a remote keyring cannot be served")
+			}
+
+			listenAddr, _ := cmd.Flags().GetString(flagListen)
+			caFile, _ := cmd.Flags().GetString(flagTLSCA)
+			certFile, _ := cmd.Flags().GetString(flagTLSCert)
+			keyFile, _ := cmd.Flags().GetString(flagTLSKey)
+			tlsConfig, err := keyring.NewRemoteSignerServerTLSConfig(caFile, certFile, keyFile)
+			if err != nil {
+				return err
+			}
+
+			lis, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				return err
+			}
+
+			srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+			remotesigner.RegisterRemoteSignerServer(srv, keyring.NewRemoteSignerServer(clientCtx.Keyring))
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				srv.GracefulStop()
+			}()
+
+			cmd.PrintErrf("remote signer listening on %s\n", lis.Addr())
+			return srv.Serve(lis)
+		},
+	}
+
+	cmd.Flags().String(flagListen, defaultRemoteSignerListenAddr, "The address the remote signer listens on")
+	cmd.Flags().String(flagTLSCA, "", "The CA certificate file verifying the client certificates")
+	cmd.Flags().String(flagTLSCert, "", "The certificate file of the remote signer")
+	cmd.Flags().String(flagTLSKey, "", "The key file of the remote signer")
+
+	return cmd
+}
@@ -27,6 +27,8 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Delegates the signatures to a remote signer over mutual TLS gRPC, see the
+                serve-remote-signer command. The keys can only be listed and used to sign.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -51,6 +53,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		MigrateCommand(),
 		SignMsgKeysCmd(),
 		VerifySignatureCmd(),
+		BLSProofCmd(),
+		RemoteSignerCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// PinnedHeightsCommand returns the heights kept from pruning by the retention constraints of the modules
+func PinnedHeightsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pinned-heights",
+		Short: "Query the heights kept from pruning by the retention constraints of the modules",
+		Long: `Query the heights of the state kept from pruning by the retention constraints of the modules, as
+evaluated at the last commit of the node, with the reason of each. The blocks from the lowest pinned height on
+are kept too.`,
+		Example: fmt.Sprintf("%s query pinned-heights", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pins, err := node.QueryPinnedHeights(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(pins)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
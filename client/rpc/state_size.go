@@ -0,0 +1,58 @@
+package rpc
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagSince = "since"
+
+// StateSizeCommand returns the key count and byte size of the persisted stores of the node
+func StateSizeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-size",
+		Short: "Query the key count and byte size of the persisted stores, and their growth",
+		Long: `Query the key count and byte size of the persisted stores at a height, the latest one by default, with
+their growth at that height. With --since, the growth is counted from another height instead.
+The node must have state-size-tracking enabled, and keeps the sizes of the last 1000 heights and of every
+1000th height.`,
+		Example: fmt.Sprintf("%s query state-size --height 2000 --since 1000", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			size, err := node.QueryStateSize(clientCtx, clientCtx.Height)
+			if err != nil {
+				return err
+			}
+
+			since, _ := cmd.Flags().GetInt64(flagSince)
+			if since > 0 {
+				if since >= size.Height {
+					return fmt.Errorf("--%s %d must be lower than the queried height %d", flagSince, since, size.Height)
+				}
+				prev, err := node.QueryStateSize(clientCtx, since)
+				if err != nil {
+					return err
+				}
+				size = size.Growth(prev)
+			}
+
+			return clientCtx.PrintProto(size)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Int64(flagSince, 0, "Count the growth since this height instead of the previous one")
+
+	return cmd
+}
@@ -17,6 +17,7 @@ func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
 		ExportSnapshotCmd(appCreator),
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
+		VerifyArchiveCmd(appCreator),
 		DeleteSnapshotCmd(),
 	)
 	return cmd
@@ -31,36 +31,11 @@ func LoadArchiveCmd() *cobra.Command {
 				return err
 			}
 
-			path := args[0]
-			fp, err := os.Open(path)
+			snapshot, tr, closer, err := openArchive(args[0])
 			if err != nil {
-				return fmt.Errorf("failed to open archive file: %w", err)
-			}
-			reader, err := gzip.NewReader(fp)
-			if err != nil {
-				return fmt.Errorf("failed to create gzip reader: %w", err)
-			}
-
-			var snapshot snapshottypes.Snapshot
-			tr := tar.NewReader(reader)
-			if err != nil {
-				return fmt.Errorf("failed to create tar reader: %w", err)
-			}
-
-			hdr, err := tr.Next()
-			if err != nil {
-				return fmt.Errorf("failed to read snapshot file header: %w", err)
-			}
-			if hdr.Name != SnapshotFileName {
-				return fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
-			}
-			bz, err := io.ReadAll(tr)
-			if err != nil {
-				return fmt.Errorf("failed to read snapshot file: %w", err)
-			}
-			if err := snapshot.Unmarshal(bz); err != nil {
-				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
+				return err
 			}
+			defer closer.Close()
 
 			// make sure the channel is unbuffered, because the tar reader can't do concurrency
 			chunks := make(chan io.ReadCloser)
@@ -68,7 +43,7 @@ func LoadArchiveCmd() *cobra.Command {
 			go func() {
 				defer close(quitChan)
 
-				savedSnapshot, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+				savedSnapshot, err := snapshotStore.SaveStreams(snapshot.Height, snapshot.Format, snapshot.Metadata.Streams, chunks)
 				if err != nil {
 					cmd.Println("failed to save snapshot", err)
 					return
@@ -76,24 +51,8 @@ func LoadArchiveCmd() *cobra.Command {
 				quitChan <- savedSnapshot
 			}()
 
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				hdr, err = tr.Next()
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					return err
-				}
-
-				if hdr.Name != strconv.FormatInt(int64(i), 10) {
-					return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
-				}
-
-				bz, err := io.ReadAll(tr)
-				if err != nil {
-					return fmt.Errorf("failed to read chunk file: %w", err)
-				}
-				chunks <- io.NopCloser(bytes.NewReader(bz))
+			if err := readArchiveChunks(tr, snapshot, chunks); err != nil {
+				return err
 			}
 			close(chunks)
 
@@ -102,7 +61,7 @@ func LoadArchiveCmd() *cobra.Command {
 				return fmt.Errorf("failed to save snapshot")
 			}
 
-			if !reflect.DeepEqual(&snapshot, savedSnapshot) {
+			if !reflect.DeepEqual(snapshot, savedSnapshot) {
 				_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
 				return fmt.Errorf("invalid archive, the saved snapshot is not equal to the original one")
 			}
@@ -111,3 +70,63 @@ func LoadArchiveCmd() *cobra.Command {
 		},
 	}
 }
+
+// openArchive opens a portable archive format snapshot, returning its snapshot and the reader of its chunks.
+func openArchive(path string) (*snapshottypes.Snapshot, *tar.Reader, io.Closer, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to open archive file: %w", err)
+	}
+	reader, err := gzip.NewReader(fp)
+	if err != nil {
+		fp.Close()
+		return nil, nil, nil, fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+
+	tr := tar.NewReader(reader)
+	hdr, err := tr.Next()
+	if err != nil {
+		fp.Close()
+		return nil, nil, nil, fmt.Errorf("failed to read snapshot file header: %w", err)
+	}
+	if hdr.Name != SnapshotFileName {
+		fp.Close()
+		return nil, nil, nil, fmt.Errorf("invalid archive, expect file: snapshot, got: %s", hdr.Name)
+	}
+	bz, err := io.ReadAll(tr)
+	if err != nil {
+		fp.Close()
+		return nil, nil, nil, fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+	var snapshot snapshottypes.Snapshot
+	if err := snapshot.Unmarshal(bz); err != nil {
+		fp.Close()
+		return nil, nil, nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	return &snapshot, tr, fp, nil
+}
+
+// readArchiveChunks sends the chunks of an archive in order into the channel, the tar reader can't do
+// concurrency so the channel is expected to be unbuffered.
+func readArchiveChunks(tr *tar.Reader, snapshot *snapshottypes.Snapshot, chunks chan<- io.ReadCloser) error {
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+
+		if hdr.Name != strconv.FormatInt(int64(i), 10) {
+			return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+		}
+
+		bz, err := io.ReadAll(tr)
+		if err != nil {
+			return fmt.Errorf("failed to read chunk file: %w", err)
+		}
+		chunks <- io.NopCloser(bytes.NewReader(bz))
+	}
+	return nil
+}
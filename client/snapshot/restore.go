@@ -1,6 +1,7 @@
 package snapshot
 
 import (
+	"fmt"
 	"path/filepath"
 	"strconv"
 
@@ -13,13 +14,19 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
+const flagStores = "stores"
+
 // RestoreSnapshotCmd returns a command to restore a snapshot
 func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "restore <height> <format>",
 		Short: "Restore app state from local snapshot",
-		Long:  "Restore app state from local snapshot",
-		Args:  cobra.ExactArgs(2),
+		Long: `Restore app state from local snapshot.
+
+With --stores, only the named module stores are restored and the others are left empty, e.g. for an analytics
+node that only needs the bank and staking state. Such a node no longer matches the app hash of the chain, so it
+can serve queries of the restored stores but can't follow the chain.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := server.GetServerContextFromCmd(cmd)
 
@@ -32,6 +39,11 @@ func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 				return err
 			}
 
+			stores, err := cmd.Flags().GetStringSlice(flagStores)
+			if err != nil {
+				return err
+			}
+
 			home := ctx.Config.RootDir
 			db, err := openDB(home, server.GetAppDBBackend(ctx.Viper))
 			if err != nil {
@@ -50,11 +62,23 @@ func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			}
 
 			app := appCreator(ctx.Logger, db, nil, genDoc.ChainID, &config, ctx.Viper)
+			if len(stores) > 0 {
+				multistore, ok := app.CommitMultiStore().(interface{ SetRestoreStores(...string) error })
+				if !ok {
+					return fmt.Errorf("the multistore %T doesn't support restoring selected stores", app.CommitMultiStore())
+				}
+				if err := multistore.SetRestoreStores(stores...); err != nil {
+					return err
+				}
+			}
 
 			sm := app.SnapshotManager()
 			return sm.RestoreLocalSnapshot(height, uint32(format))
 		},
 	}
+
+	cmd.Flags().StringSlice(flagStores, nil, "Restore only the named stores, e.g. bank,staking")
+
 	return cmd
 }
 
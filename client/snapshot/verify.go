@@ -0,0 +1,157 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/node"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/statesync"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+const flagAppHash = "app-hash"
+
+// VerifyArchiveCmd returns a command to verify a portable archive format snapshot against a trusted app hash
+func VerifyArchiveCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <archive-file>",
+		Short: "Verify a snapshot archive file (.tar.gz) against the app hash of a trusted header",
+		Long: `Verify a snapshot archive file (.tar.gz) by rebuilding the stores it holds into a temporary database
+under the data directory, and comparing the resulting app hash to the trusted one of the snapshot height.
+
+The trusted app hash is either given with --app-hash, or taken from the header of the next height, verified
+by a light client against the rpc_servers of the [statesync] section of config.toml, from its trust_height
+and trust_hash.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			appHashHex, err := cmd.Flags().GetString(flagAppHash)
+			if err != nil {
+				return err
+			}
+
+			snapshot, tr, closer, err := openArchive(args[0])
+			if err != nil {
+				return err
+			}
+			defer closer.Close()
+
+			genDocProvider := node.DefaultGenesisDocProviderFunc(ctx.Config)
+			genDoc, err := genDocProvider()
+			if err != nil {
+				return err
+			}
+
+			// the trusted app hash is fetched first, not to rebuild the stores for nothing
+			var trustedAppHash []byte
+			if appHashHex != "" {
+				trustedAppHash, err = hex.DecodeString(appHashHex)
+				if err != nil {
+					return fmt.Errorf("invalid app hash: %w", err)
+				}
+			} else {
+				trustedAppHash, err = lightClientAppHash(cmd.Context(), ctx, genDoc, snapshot.Height)
+				if err != nil {
+					return err
+				}
+			}
+
+			dir, err := os.MkdirTemp(filepath.Join(ctx.Config.RootDir, "data"), "snapshot-verify-")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(dir)
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(ctx.Viper), dir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			config, err := serverconfig.GetConfig(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			app := appCreator(ctx.Logger, db, nil, genDoc.ChainID, &config, ctx.Viper)
+			multistore, ok := app.CommitMultiStore().(snapshottypes.Snapshotter)
+			if !ok {
+				return fmt.Errorf("the multistore %T doesn't support snapshots", app.CommitMultiStore())
+			}
+
+			// make sure the channel is unbuffered, because the tar reader can't do concurrency
+			chunks := make(chan io.ReadCloser)
+			rebuildErr := make(chan error, 1)
+			go func() {
+				rebuildErr <- snapshots.RebuildSnapshot(multistore, *snapshot, chunks)
+			}()
+			readErr := readArchiveChunks(tr, snapshot, chunks)
+			close(chunks)
+			err = <-rebuildErr
+			if readErr != nil {
+				return readErr
+			}
+			if err != nil {
+				return fmt.Errorf("failed to rebuild snapshot: %w", err)
+			}
+
+			commitID := app.CommitMultiStore().LastCommitID()
+			if commitID.Version != int64(snapshot.Height) {
+				return fmt.Errorf("rebuilt version %d, expected %d", commitID.Version, snapshot.Height)
+			}
+			if !bytes.Equal(commitID.Hash, trustedAppHash) {
+				return fmt.Errorf("app hash mismatch at height %d: snapshot %X, trusted %X", snapshot.Height, commitID.Hash, trustedAppHash)
+			}
+
+			cmd.Printf("snapshot at height %d matches the trusted app hash %X\n", snapshot.Height, trustedAppHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAppHash, "", "Trusted app hash (hex) of the snapshot height, instead of verifying it with a light client")
+
+	return cmd
+}
+
+// lightClientAppHash returns the app hash of a height, verified by a light client configured by the state sync
+// settings of the node, like the state sync of CometBFT does.
+func lightClientAppHash(ctx context.Context, serverCtx *server.Context, genDoc *cmttypes.GenesisDoc, height uint64) ([]byte, error) {
+	cfg := serverCtx.Config.StateSync
+	genState, err := sm.MakeGenesisState(genDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	stateProvider, err := statesync.NewLightClientStateProvider(
+		ctx,
+		genDoc.ChainID, genState.Version, genState.InitialHeight,
+		cfg.RPCServers, light.TrustOptions{
+			Period: cfg.TrustPeriod,
+			Height: cfg.TrustHeight,
+			Hash:   cfg.TrustHashBytes(),
+		}, serverCtx.Logger.With("module", "light"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up light client state provider, set up the statesync section of config.toml or pass --%s: %w", flagAppHash, err)
+	}
+
+	appHash, err := stateProvider.AppHash(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify the app hash of height %d: %w", height, err)
+	}
+	return appHash, nil
+}
@@ -0,0 +1,175 @@
+package streaming
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
+	"github.com/cosmos/cosmos-sdk/store/streaming/crosschain"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+)
+
+const (
+	flagDir       = "dir"
+	flagFromIndex = "from-index"
+	flagLimit     = "limit"
+	flagType      = "type"
+	flagMinHeight = "min-height"
+	flagMaxHeight = "max-height"
+	flagChannel   = "channel"
+)
+
+// Cmd returns the streaming group command
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "streaming",
+		Short: "Read and acknowledge the local output of the state streaming services",
+	}
+	cmd.AddCommand(
+		FileCursorCmd(),
+		FileAckCmd(),
+		CrossChainRecordsCmd(),
+	)
+	return cmd
+}
+
+// fileStreamerDir returns the write directory and file prefix of the file streaming service of app.toml.
+func fileStreamerDir(cmd *cobra.Command) (string, string) {
+	ctx := server.GetServerContextFromCmd(cmd)
+	dir := cast.ToString(ctx.Viper.Get(streaming.OptStreamersFileWriteDir))
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(ctx.Config.RootDir, dir)
+	}
+	return dir, cast.ToString(ctx.Viper.Get(streaming.OptStreamersFilePrefix))
+}
+
+// FileCursorCmd returns the command to print the cursor of the file streaming service
+func FileCursorCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "file-cursor",
+		Short: "Print the height up to which the file streaming service wrote the blocks, and the last acknowledged one",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dir, prefix := fileStreamerDir(cmd)
+			cursor, err := file.ReadCursor(dir, prefix)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(cursor)
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+}
+
+// FileAckCmd returns the command to acknowledge the blocks of the file streaming service
+func FileAckCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "file-ack <height>",
+		Short: "Acknowledge that the blocks of the file streaming service up to the height were processed",
+		Long: `Acknowledge that the blocks of the file streaming service up to the height were processed, so that the
+consumer resumes after it. The height must be written already, and not before the last acknowledged one. The
+service deletes the files of the acknowledged blocks if delete-acked is set in [streamers.file] of app.toml.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height: %w", err)
+			}
+
+			dir, prefix := fileStreamerDir(cmd)
+			return file.Ack(dir, prefix, height)
+		},
+	}
+}
+
+// CrossChainRecordsCmd returns the command to read the records of the crosschain streaming service
+func CrossChainRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "crosschain-records",
+		Short: "Print the records of the crosschain streaming service, one JSON record per line",
+		Long: `Print the records of the crosschain streaming service: the cross-chain packages sent and received,
+the outcomes of the received packages and the acks sent back for them, the relayer fees, and the sequence gaps
+of the channels. The records are read from the write_dir of [streamers.crosschain] in app.toml, or --dir.`,
+		Example: "crosschain-records --type package_received --channel 1 --min-height 100 --limit 10",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			dir, err := cmd.Flags().GetString(flagDir)
+			if err != nil {
+				return err
+			}
+			if dir == "" {
+				dir = cast.ToString(ctx.Viper.Get(streaming.OptStreamersCrossChainWriteDir))
+			}
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(ctx.Config.RootDir, dir)
+			}
+
+			var filter crosschain.Filter
+			if filter.FromIndex, err = cmd.Flags().GetUint64(flagFromIndex); err != nil {
+				return err
+			}
+			if filter.Limit, err = cmd.Flags().GetInt(flagLimit); err != nil {
+				return err
+			}
+			if filter.MinHeight, err = cmd.Flags().GetInt64(flagMinHeight); err != nil {
+				return err
+			}
+			if filter.MaxHeight, err = cmd.Flags().GetInt64(flagMaxHeight); err != nil {
+				return err
+			}
+			types, err := cmd.Flags().GetStringSlice(flagType)
+			if err != nil {
+				return err
+			}
+			for _, t := range types {
+				if err := crosschain.ValidateRecordType(crosschain.RecordType(t)); err != nil {
+					return err
+				}
+				filter.Types = append(filter.Types, crosschain.RecordType(t))
+			}
+			if cmd.Flags().Changed(flagChannel) {
+				channel, err := cmd.Flags().GetUint32(flagChannel)
+				if err != nil {
+					return err
+				}
+				filter.ChannelID = &channel
+			}
+
+			records, err := crosschain.ReadRecords(dir, filter)
+			if err != nil {
+				return err
+			}
+			for _, record := range records {
+				bz, err := json.Marshal(record)
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDir, "", "Directory of the records, the write_dir of [streamers.crosschain] if empty")
+	cmd.Flags().Uint64(flagFromIndex, 0, "Index of the first record")
+	cmd.Flags().Int(flagLimit, 0, "Maximum number of records, 0 for all of them")
+	cmd.Flags().StringSlice(flagType, nil, "Types of the records (package_sent|package_received|relayer_fee|sequence_gap)")
+	cmd.Flags().Int64(flagMinHeight, 0, "Minimum height of the records")
+	cmd.Flags().Int64(flagMaxHeight, 0, "Maximum height of the records")
+	cmd.Flags().Uint32(flagChannel, 0, "Channel of the records")
+
+	return cmd
+}
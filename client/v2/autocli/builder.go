@@ -3,7 +3,9 @@ package autocli
 import (
 	"context"
 
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/client/v2/autocli/flag"
 )
@@ -16,4 +18,12 @@ type Builder struct {
 	// GetClientConn specifies how CLI commands will resolve a grpc.ClientConnInterface
 	// from a given context.
 	GetClientConn func(context.Context) grpc.ClientConnInterface
+
+	// HandleMsg specifies how tx commands handle the msg they build from their
+	// arguments and flags, typically by signing and broadcasting it in a tx.
+	HandleMsg func(cmd *cobra.Command, msg protoreflect.ProtoMessage) error
+
+	// AddTxConnFlags adds to tx commands the flags read by HandleMsg, such as the
+	// signer and the fees of the tx, if it is not nil.
+	AddTxConnFlags func(cmd *cobra.Command)
 }
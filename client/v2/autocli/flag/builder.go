@@ -33,6 +33,8 @@ func (b *Builder) init() {
 	if b.scalarFlagTypes == nil {
 		b.scalarFlagTypes = map[string]Type{}
 		b.scalarFlagTypes["cosmos.AddressString"] = addressStringType{}
+		b.scalarFlagTypes["cosmos.HexBytes"] = hexBytesType{}
+		b.scalarFlagTypes["cosmos.BLSSignature"] = hexBytesType{size: 96}
 	}
 }
 
@@ -0,0 +1,57 @@
+package flag
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// hexBytesType is the flag type of the bytes fields which are given as hex instead of base64, such as the
+// payloads with the cosmos.HexBytes scalar. If size isn't 0, the bytes must have that size, such as the BLS
+// signatures with the cosmos.BLSSignature scalar.
+type hexBytesType struct {
+	size int
+}
+
+func (h hexBytesType) NewValue(_ context.Context, _ *Builder) Value {
+	return &hexBytesValue{size: h.size}
+}
+
+func (h hexBytesType) DefaultValue() string {
+	return ""
+}
+
+type hexBytesValue struct {
+	size  int
+	value []byte
+}
+
+func (h hexBytesValue) Get(protoreflect.Value) (protoreflect.Value, error) {
+	return protoreflect.ValueOfBytes(h.value), nil
+}
+
+func (h hexBytesValue) String() string {
+	return hex.EncodeToString(h.value)
+}
+
+func (h *hexBytesValue) Set(s string) error {
+	value, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return err
+	}
+	if h.size != 0 && len(value) != h.size {
+		return fmt.Errorf("expected %d bytes, got %d", h.size, len(value))
+	}
+	h.value = value
+	return nil
+}
+
+func (h hexBytesValue) Type() string {
+	if h.size != 0 {
+		return fmt.Sprintf("%d hex bytes", h.size)
+	}
+	return "hex bytes"
+}
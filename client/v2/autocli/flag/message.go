@@ -88,9 +88,16 @@ func (f fieldBinding) bind(msg protoreflect.Message) error {
 	if err != nil {
 		return err
 	}
+	// the values of a list are appended to a new list, which is set only if not empty to leave
+	// the field unpopulated otherwise
+	if field.IsList() {
+		if val.List().Len() > 0 {
+			msg.Set(field, val)
+		}
+		return nil
+	}
 	kind := f.field.Kind()
-	if !(field.IsList() ||
-		field.IsMap() ||
+	if !(field.IsMap() ||
 		kind == protoreflect.MessageKind ||
 		kind == protoreflect.GroupKind) {
 		msg.Set(f.field, val)
@@ -0,0 +1,77 @@
+package autocli
+
+import (
+	"errors"
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// BuildMsgCommand builds the tx commands for all the provided modules. If a custom command is provided for a
+// module, this is used instead of any automatically generated CLI commands.
+func (b *Builder) BuildMsgCommand(moduleOptions map[string]*autocliv1.ModuleOptions, customCmds map[string]*cobra.Command) (*cobra.Command, error) {
+	msgCmd := topLevelCmd("tx", "Transaction subcommands")
+	for moduleName, modOpts := range moduleOptions {
+		if customCmds[moduleName] != nil {
+			// custom commands get added lower down
+			continue
+		}
+
+		msgCmdDesc := modOpts.Tx
+		if msgCmdDesc != nil {
+			cmd, err := b.BuildModuleMsgCommand(moduleName, msgCmdDesc)
+			if err != nil {
+				return nil, err
+			}
+
+			msgCmd.AddCommand(cmd)
+		}
+	}
+
+	for _, cmd := range customCmds {
+		msgCmd.AddCommand(cmd)
+	}
+
+	return msgCmd, nil
+}
+
+// BuildModuleMsgCommand builds the tx command for a single module.
+func (b *Builder) BuildModuleMsgCommand(moduleName string, cmdDescriptor *autocliv1.ServiceCommandDescriptor) (*cobra.Command, error) {
+	cmd := topLevelCmd(moduleName, fmt.Sprintf("Transactions commands for the %s module", moduleName))
+
+	err := b.AddMsgServiceCommands(cmd, cmdDescriptor)
+
+	return cmd, err
+}
+
+// AddMsgServiceCommands adds a sub-command to the provided command for each
+// method in the specified msg service and returns the command. This can be used in
+// order to add auto-generated commands to an existing command.
+func (b *Builder) AddMsgServiceCommands(cmd *cobra.Command, cmdDescriptor *autocliv1.ServiceCommandDescriptor) error {
+	return b.addServiceCommands(cmd, cmdDescriptor, "Tx commands for the %s service", b.AddMsgServiceCommands, b.BuildMsgMethodCommand)
+}
+
+// BuildMsgMethodCommand creates a tx command for the given msg service method. The command builds the msg from its
+// arguments and flags, and hands it to HandleMsg which signs and broadcasts it.
+func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions) (*cobra.Command, error) {
+	handleMsg := b.HandleMsg
+
+	cmd, err := b.buildMethodCommandCommon(descriptor, options, func(cmd *cobra.Command, input protoreflect.Message) error {
+		if handleMsg == nil {
+			return errors.New("no msg handler to sign and broadcast the msg")
+		}
+
+		return handleMsg(cmd, input.Interface())
+	})
+	if err != nil || cmd == nil {
+		return cmd, err
+	}
+
+	if b.AddTxConnFlags != nil {
+		b.AddTxConnFlags(cmd)
+	}
+
+	return cmd, nil
+}
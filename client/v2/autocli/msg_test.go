@@ -0,0 +1,92 @@
+package autocli
+
+import (
+	"bytes"
+	"testing"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gotest.tools/v3/assert"
+)
+
+var testMsgCmdDesc = &autocliv1.ServiceCommandDescriptor{
+	Service: bankv1beta1.Msg_ServiceDesc.ServiceName,
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod: "Send",
+			Use:       "send [from_key_or_address] [to_address]",
+			Short:     "Send coins from one account to another",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "from_address"},
+				{ProtoField: "to_address"},
+			},
+		},
+		{
+			RpcMethod: "UpdateParams",
+			Skip:      true,
+		},
+	},
+}
+
+func TestMsgCommand(t *testing.T) {
+	var msgs []protoreflect.ProtoMessage
+	b := &Builder{
+		HandleMsg: func(cmd *cobra.Command, msg protoreflect.ProtoMessage) error {
+			msgs = append(msgs, msg)
+			return nil
+		},
+		AddTxConnFlags: func(cmd *cobra.Command) {
+			cmd.Flags().String("fees", "", "fees to pay along with the tx")
+		},
+	}
+	cmd, err := b.BuildModuleMsgCommand("bank", testMsgCmdDesc)
+	assert.NilError(t, err)
+
+	names := map[string]bool{}
+	for _, c := range cmd.Commands() {
+		names[c.Name()] = true
+	}
+	assert.Assert(t, names["send"])
+	assert.Assert(t, names["multi-send"])
+	assert.Assert(t, !names["update-params"])
+
+	cmd.SetArgs([]string{"send", "alice", "bob", "--fees", "10stake"})
+	assert.NilError(t, cmd.Execute())
+	assert.Equal(t, 1, len(msgs))
+	assert.DeepEqual(t, &bankv1beta1.MsgSend{FromAddress: "alice", ToAddress: "bob"}, msgs[0], protocmp.Transform())
+
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"send", "--help"})
+	assert.NilError(t, cmd.Execute())
+	assert.Assert(t, bytes.Contains(out.Bytes(), []byte("--fees")))
+}
+
+func TestMsgCommandWithoutHandler(t *testing.T) {
+	b := &Builder{}
+	cmd, err := b.BuildModuleMsgCommand("bank", testMsgCmdDesc)
+	assert.NilError(t, err)
+	cmd.SetArgs([]string{"send", "alice", "bob"})
+	assert.ErrorContains(t, cmd.Execute(), "no msg handler")
+}
+
+func TestBuildCustomMsgCommand(t *testing.T) {
+	b := &Builder{}
+	customCommandCalled := false
+	cmd, err := b.BuildMsgCommand(map[string]*autocliv1.ModuleOptions{
+		"bank": {
+			Tx: testMsgCmdDesc,
+		},
+	}, map[string]*cobra.Command{
+		"bank": {Use: "bank", Run: func(cmd *cobra.Command, args []string) {
+			customCommandCalled = true
+		}},
+	})
+	assert.NilError(t, err)
+	cmd.SetArgs([]string{"bank", "send"})
+	assert.NilError(t, cmd.Execute())
+	assert.Assert(t, customCommandCalled)
+}
@@ -56,6 +56,18 @@ func (b *Builder) BuildModuleQueryCommand(moduleName string, cmdDescriptor *auto
 // method in the specified service and returns the command. This can be used in
 // order to add auto-generated commands to an existing command.
 func (b *Builder) AddQueryServiceCommands(cmd *cobra.Command, cmdDescriptor *autocliv1.ServiceCommandDescriptor) error {
+	return b.addServiceCommands(cmd, cmdDescriptor, "Querying commands for the %s service", b.AddQueryServiceCommands, b.BuildQueryMethodCommand)
+}
+
+// addServiceCommands adds a sub-command to the provided command for each method in the specified service built
+// with buildMethod, and a sub-command for each sub-service added with addServiceCommands.
+func (b *Builder) addServiceCommands(
+	cmd *cobra.Command,
+	cmdDescriptor *autocliv1.ServiceCommandDescriptor,
+	subCmdShort string,
+	addServiceCommands func(*cobra.Command, *autocliv1.ServiceCommandDescriptor) error,
+	buildMethod func(protoreflect.MethodDescriptor, *autocliv1.RpcCommandOptions) (*cobra.Command, error),
+) error {
 	resolver := b.FileResolver
 	if resolver == nil {
 		resolver = protoregistry.GlobalFiles
@@ -82,7 +94,7 @@ func (b *Builder) AddQueryServiceCommands(cmd *cobra.Command, cmdDescriptor *aut
 	for i := 0; i < n; i++ {
 		methodDescriptor := methods.Get(i)
 		methodOpts := rpcOptMap[methodDescriptor.Name()]
-		methodCmd, err := b.BuildQueryMethodCommand(methodDescriptor, methodOpts)
+		methodCmd, err := buildMethod(methodDescriptor, methodOpts)
 		if err != nil {
 			return err
 		}
@@ -93,8 +105,8 @@ func (b *Builder) AddQueryServiceCommands(cmd *cobra.Command, cmdDescriptor *aut
 	}
 
 	for cmdName, subCmdDesc := range cmdDescriptor.SubCommands {
-		subCmd := topLevelCmd(cmdName, fmt.Sprintf("Querying commands for the %s service", subCmdDesc.Service))
-		err = b.AddQueryServiceCommands(subCmd, subCmdDesc)
+		subCmd := topLevelCmd(cmdName, fmt.Sprintf(subCmdShort, subCmdDesc.Service))
+		err = addServiceCommands(subCmd, subCmdDesc)
 		if err != nil {
 			return err
 		}
@@ -108,6 +120,42 @@ func (b *Builder) AddQueryServiceCommands(cmd *cobra.Command, cmdDescriptor *aut
 // BuildQueryMethodCommand creates a gRPC query command for the given service method. This can be used to auto-generate
 // just a single command for a single service rpc method.
 func (b *Builder) BuildQueryMethodCommand(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions) (*cobra.Command, error) {
+	getClientConn := b.GetClientConn
+	serviceDescriptor := descriptor.Parent().(protoreflect.ServiceDescriptor)
+	methodName := fmt.Sprintf("/%s/%s", serviceDescriptor.FullName(), descriptor.Name())
+	outputType := util.ResolveMessageType(b.TypeResolver, descriptor.Output())
+
+	jsonMarshalOptions := protojson.MarshalOptions{
+		Indent:          "  ",
+		UseProtoNames:   true,
+		UseEnumNumbers:  false,
+		EmitUnpopulated: true,
+		Resolver:        b.TypeResolver,
+	}
+
+	return b.buildMethodCommandCommon(descriptor, options, func(cmd *cobra.Command, input protoreflect.Message) error {
+		ctx := cmd.Context()
+		clientConn := getClientConn(ctx)
+
+		output := outputType.New()
+		err := clientConn.Invoke(ctx, methodName, input.Interface(), output.Interface())
+		if err != nil {
+			return err
+		}
+
+		bz, err := jsonMarshalOptions.Marshal(output.Interface())
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+		return err
+	})
+}
+
+// buildMethodCommandCommon creates the command of a service method, whose flags and positional args are bound to
+// the fields of the method input. The command runs exec with the input built from its arguments.
+func (b *Builder) buildMethodCommandCommon(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions, exec func(cmd *cobra.Command, input protoreflect.Message) error) (*cobra.Command, error) {
 	if options == nil {
 		// use the defaults
 		options = &autocliv1.RpcCommandOptions{}
@@ -117,19 +165,12 @@ func (b *Builder) BuildQueryMethodCommand(descriptor protoreflect.MethodDescript
 		return nil, nil
 	}
 
-	serviceDescriptor := descriptor.Parent().(protoreflect.ServiceDescriptor)
-
 	long := options.Long
 	if long == "" {
 		long = util.DescriptorDocs(descriptor)
 	}
 
-	getClientConn := b.GetClientConn
-	methodName := fmt.Sprintf("/%s/%s", serviceDescriptor.FullName(), descriptor.Name())
-
-	inputDesc := descriptor.Input()
-	inputType := util.ResolveMessageType(b.TypeResolver, inputDesc)
-	outputType := util.ResolveMessageType(b.TypeResolver, descriptor.Output())
+	inputType := util.ResolveMessageType(b.TypeResolver, descriptor.Input())
 
 	use := options.Use
 	if use == "" {
@@ -154,35 +195,13 @@ func (b *Builder) BuildQueryMethodCommand(descriptor protoreflect.MethodDescript
 
 	cmd.Args = binder.CobraArgs
 
-	jsonMarshalOptions := protojson.MarshalOptions{
-		Indent:          "  ",
-		UseProtoNames:   true,
-		UseEnumNumbers:  false,
-		EmitUnpopulated: true,
-		Resolver:        b.TypeResolver,
-	}
-
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
-		ctx := cmd.Context()
-		clientConn := getClientConn(ctx)
 		input, err := binder.BuildMessage(args)
 		if err != nil {
 			return err
 		}
 
-		output := outputType.New()
-		err = clientConn.Invoke(ctx, methodName, input.Interface(), output.Interface())
-		if err != nil {
-			return err
-		}
-
-		bz, err := jsonMarshalOptions.Marshal(output.Interface())
-		if err != nil {
-			return err
-		}
-
-		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
-		return err
+		return exec(cmd, input)
 	}
 
 	return cmd, nil
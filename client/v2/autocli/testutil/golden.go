@@ -0,0 +1,50 @@
+// Package testutil provides helpers to test the autocli commands of modules.
+package testutil
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+
+	"cosmossdk.io/client/v2/autocli"
+)
+
+// AssertGolden builds the query and tx commands of the autocli options of a module with the builder, and asserts
+// that their help matches the golden files autocli-query.golden and autocli-tx.golden of the testdata directory
+// of the test. The golden files are regenerated by running the test with -update.
+func AssertGolden(t *testing.T, builder *autocli.Builder, moduleName string, options *autocliv1.ModuleOptions) {
+	t.Helper()
+
+	if options.Query != nil {
+		cmd, err := builder.BuildModuleQueryCommand(moduleName, options.Query)
+		assert.NilError(t, err)
+		golden.Assert(t, help(t, cmd), "autocli-query.golden")
+	}
+	if options.Tx != nil {
+		cmd, err := builder.BuildModuleMsgCommand(moduleName, options.Tx)
+		assert.NilError(t, err)
+		golden.Assert(t, help(t, cmd), "autocli-tx.golden")
+	}
+}
+
+// help returns the help of a command and of its sub-commands.
+func help(t *testing.T, cmd *cobra.Command) string {
+	t.Helper()
+
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	for _, c := range append([]*cobra.Command{cmd}, cmd.Commands()...) {
+		if c.Name() == "help" || c.Name() == "completion" {
+			continue
+		}
+		fmt.Fprintf(&out, "$ %s --help\n", c.CommandPath())
+		assert.NilError(t, c.Help())
+		out.WriteString("\n")
+	}
+	return out.String()
+}
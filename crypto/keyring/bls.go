@@ -0,0 +1,53 @@
+package keyring
+
+import (
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+)
+
+// SignBLSProof signs the proof of possession of the BLS key uid, which is the signature of the hash of its public
+// key expected in the bls_proof field of MsgCreateValidator and MsgEditValidator. It returns the public key
+// bytes and the proof.
+func SignBLSProof(kr Keyring, uid string) (pubKey, proof []byte, err error) {
+	k, err := kr.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	pk, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	if pk.Type() != bls.KeyType {
+		return nil, nil, errors.Wrapf(ErrNotBLSKey, "key %s is of type %s", uid, pk.Type())
+	}
+
+	pubKey = pk.Bytes()
+	proof, _, err = kr.Sign(uid, tmhash.Sum(pubKey))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pubKey, proof, nil
+}
+
+// ImportBLSKeystore imports a BLS private key from an EIP-2335 keystore into a keyring, which must implement
+// BLSKeystore.
+func ImportBLSKeystore(kr Keyring, uid string, keystoreJSON []byte, passphrase string) (*Record, error) {
+	ks, ok := kr.(BLSKeystore)
+	if !ok {
+		return nil, ErrBLSKeystoreUnsupported
+	}
+	return ks.ImportBLSKeystore(uid, keystoreJSON, passphrase)
+}
+
+// ExportBLSKeystore returns the BLS private key uid of a keyring, which must implement BLSKeystore, as an
+// EIP-2335 keystore encrypted with the passphrase.
+func ExportBLSKeystore(kr Keyring, uid, encryptPassphrase string) ([]byte, error) {
+	ks, ok := kr.(BLSKeystore)
+	if !ok {
+		return nil, ErrBLSKeystoreUnsupported
+	}
+	return ks.ExportBLSKeystore(uid, encryptPassphrase)
+}
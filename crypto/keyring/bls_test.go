@@ -0,0 +1,75 @@
+package keyring
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBLSKeystoreExportImport(t *testing.T) {
+	kb, err := New("keybasename", "test", t.TempDir(), nil, getCodec())
+	require.NoError(t, err)
+
+	k, _, err := kb.NewMnemonic("bls", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthBLS)
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("eth", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+
+	_, err = ExportBLSKeystore(kb, "eth", "password")
+	require.ErrorIs(t, err, ErrNotBLSKey)
+
+	keystore, err := ExportBLSKeystore(kb, "bls", "password")
+	require.NoError(t, err)
+	require.NoError(t, kb.Delete("bls"))
+
+	_, err = ImportBLSKeystore(kb, "bls2", keystore, "wrong")
+	require.Error(t, err)
+
+	imported, err := ImportBLSKeystore(kb, "bls2", keystore, "password")
+	require.NoError(t, err)
+	pk, err := k.GetPubKey()
+	require.NoError(t, err)
+	importedPk, err := imported.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pk.Equals(importedPk))
+
+	_, err = ImportBLSKeystore(kb, "bls2", keystore, "password")
+	require.EqualError(t, err, "cannot overwrite key: bls2")
+
+	// the keyrings which don't implement BLSKeystore are reported as such
+	wrapped := struct{ Keyring }{kb}
+	_, err = ExportBLSKeystore(wrapped, "bls2", "password")
+	require.ErrorIs(t, err, ErrBLSKeystoreUnsupported)
+	_, err = ImportBLSKeystore(wrapped, "bls3", keystore, "password")
+	require.ErrorIs(t, err, ErrBLSKeystoreUnsupported)
+}
+
+func TestSignBLSProof(t *testing.T) {
+	kb, err := New("keybasename", "test", t.TempDir(), nil, getCodec())
+	require.NoError(t, err)
+
+	_, _, err = kb.NewMnemonic("bls", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthBLS)
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("eth", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+
+	_, _, err = SignBLSProof(kb, "eth")
+	require.ErrorIs(t, err, ErrNotBLSKey)
+
+	pubKey, proof, err := SignBLSProof(kb, "bls")
+	require.NoError(t, err)
+	require.Len(t, pubKey, sdk.BLSPubKeyLength)
+	require.Len(t, proof, sdk.BLSSignatureLength)
+
+	// the proof is verified like the staking module does
+	blsPubKey, err := bls.PublicKeyFromBytes(pubKey)
+	require.NoError(t, err)
+	sig, err := bls.SignatureFromBytes(proof)
+	require.NoError(t, err)
+	require.True(t, sig.Verify(blsPubKey, tmhash.Sum(pubKey)))
+}
@@ -37,4 +37,8 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	This backend delegates the signatures to a remote signer over gRPC with mutual TLS,
+//		configured with the WithRemoteSigner option. The signer holds the eth_secp256k1 and
+//		eth_bls keys, which can only be listed and used to sign. NewRemoteSignerServer serves
+//		the keys of a local keyring as a remote signer.
 package keyring
@@ -10,4 +10,11 @@ var (
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
 	ErrUnsupportedLanguage = errors.New("unsupported language: only english is supported")
+
+	// ErrNotBLSKey is raised when a BLS operation is requested on a key of another type.
+	ErrNotBLSKey = errors.New("not a BLS key")
+
+	// ErrBLSKeystoreUnsupported is raised when a BLS keystore is imported into or exported from a keyring which
+	// doesn't implement BLSKeystore.
+	ErrBLSKeystoreUnsupported = errors.New("the keyring doesn't support BLS keystores")
 )
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,6 +35,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -138,6 +140,17 @@ type Exporter interface {
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
 }
 
+// BLSKeystore is implemented by the key stores that support the import and export of BLS private keys as
+// EIP-2335 keystores. It's an optional interface of Keyring, see ImportBLSKeystore and ExportBLSKeystore.
+type BLSKeystore interface {
+	// ImportBLSKeystore imports a BLS private key from an EIP-2335 keystore.
+	ImportBLSKeystore(uid string, keystoreJSON []byte, passphrase string) (*Record, error)
+
+	// ExportBLSKeystore returns a BLS private key as an EIP-2335 keystore encrypted with the passphrase.
+	// It returns an error if the key does not exist or is not a BLS key.
+	ExportBLSKeystore(uid, encryptPassphrase string) ([]byte, error)
+}
+
 // Option overrides keyring configuration options.
 type Option func(options *Options)
 
@@ -156,6 +169,8 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// the remote signer of the remote backend
+	RemoteSigner *RemoteSignerConfig
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -173,7 +188,8 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
+// The remote backend requires the WithRemoteSigner option.
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -185,6 +201,8 @@ func New(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(cdc, opts...), err
+	case BackendRemote:
+		return newRemoteKeystore(cdc, opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -206,6 +224,8 @@ func New(
 	return newKeystore(db, cdc, backend, opts...), nil
 }
 
+var _ BLSKeystore = keystore{}
+
 type keystore struct {
 	db      keyring.Keyring
 	cdc     codec.Codec
@@ -336,6 +356,33 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	return nil
 }
 
+func (ks keystore) ImportBLSKeystore(uid string, keystoreJSON []byte, passphrase string) (*Record, error) {
+	if _, err := ks.Key(uid); err == nil {
+		return nil, fmt.Errorf("cannot overwrite key: %s", uid)
+	}
+
+	privKey, err := bls.DecryptKeystore(keystoreJSON, passphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt BLS keystore")
+	}
+
+	return ks.writeLocalKey(uid, privKey)
+}
+
+func (ks keystore) ExportBLSKeystore(uid, encryptPassphrase string) ([]byte, error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	blsPriv, ok := priv.(*bls.PrivKey)
+	if !ok {
+		return nil, errors.Wrapf(ErrNotBLSKey, "key %s is of type %s", uid, priv.Type())
+	}
+
+	return bls.EncryptKeystore(blsPriv, encryptPassphrase)
+}
+
 func (ks keystore) ImportPubKey(uid string, armor string) error {
 	if _, err := ks.Key(uid); err == nil {
 		return fmt.Errorf("cannot overwrite key: %s", uid)
@@ -0,0 +1,366 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/99designs/keyring"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultRemoteSignerTimeout is the default timeout of the requests to a remote signer.
+const DefaultRemoteSignerTimeout = 10 * time.Second
+
+// ErrRemoteKeyring is raised when a key of a remote keyring is modified or exported, as the remote
+// signer holds the private keys.
+var ErrRemoteKeyring = errors.New("keys of a remote keyring can only be used to sign")
+
+// RemoteSignerConfig is the configuration of the connection of the remote keyring backend to its signer.
+type RemoteSignerConfig struct {
+	// Addr is the address of the gRPC server of the remote signer.
+	Addr string
+	// CAFile is the certificate of the authority which signed the certificate of the remote signer.
+	CAFile string
+	// CertFile and KeyFile are the client certificate and key presented to the remote signer.
+	CertFile string
+	KeyFile  string
+	// Timeout is the timeout of every request to the remote signer, DefaultRemoteSignerTimeout if zero.
+	Timeout time.Duration
+}
+
+// WithRemoteSigner configures the remote signer of the remote keyring backend.
+func WithRemoteSigner(cfg RemoteSignerConfig) Option {
+	return func(options *Options) {
+		options.RemoteSigner = &cfg
+	}
+}
+
+// NewRemoteSignerClientTLSConfig returns the TLS configuration of a remote signer client, which verifies the
+// signer against the CA certificate and authenticates itself with its certificate.
+func NewRemoteSignerClientTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	pool, cert, err := loadRemoteSignerCerts(caFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// NewRemoteSignerServerTLSConfig returns the TLS configuration of a remote signer, which only accepts the
+// clients presenting a certificate signed by the CA certificate.
+func NewRemoteSignerServerTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	pool, cert, err := loadRemoteSignerCerts(caFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+func loadRemoteSignerCerts(caFile, certFile, keyFile string) (*x509.CertPool, tls.Certificate, error) {
+	if caFile == "" || certFile == "" || keyFile == "" {
+		return nil, tls.Certificate{}, errors.New("the CA certificate, certificate and key files are required for mutual TLS")
+	}
+
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, tls.Certificate{}, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, tls.Certificate{}, fmt.Errorf("no certificate found in %s", caFile)
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, tls.Certificate{}, err
+	}
+
+	return pool, cert, nil
+}
+
+// remoteKeystore is a keyring whose keys are held by a remote signer. The public keys of the signer are
+// stored as offline records in memory, and the signatures are requested to the signer.
+type remoteKeystore struct {
+	keystore
+
+	client  remotesigner.RemoteSignerClient
+	timeout time.Duration
+}
+
+var (
+	_ Keyring     = remoteKeystore{}
+	_ BLSKeystore = remoteKeystore{}
+)
+
+func newRemoteKeystore(cdc codec.Codec, opts ...Option) (Keyring, error) {
+	ks := newKeystore(keyring.NewArrayKeyring(nil), cdc, BackendRemote, opts...)
+	cfg := ks.options.RemoteSigner
+	if cfg == nil || cfg.Addr == "" {
+		return nil, errors.New("the address of the remote signer is not configured")
+	}
+
+	tlsConfig, err := NewRemoteSignerClientTLSConfig(cfg.CAFile, cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(cfg.Addr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, err
+	}
+
+	return newRemoteKeystoreWithClient(ks, remotesigner.NewRemoteSignerClient(conn), cfg.Timeout)
+}
+
+func newRemoteKeystoreWithClient(ks keystore, client remotesigner.RemoteSignerClient, timeout time.Duration) (Keyring, error) {
+	if timeout == 0 {
+		timeout = DefaultRemoteSignerTimeout
+	}
+	rks := remoteKeystore{keystore: ks, client: client, timeout: timeout}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	res, err := client.Keys(ctx, &remotesigner.KeysRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the keys of the remote signer")
+	}
+
+	for _, key := range res.Keys {
+		var pubKey types.PubKey
+		if err := ks.cdc.UnpackAny(key.PubKey, &pubKey); err != nil {
+			return nil, errors.Wrapf(err, "invalid public key of remote key %s", key.Name)
+		}
+		if _, err := ks.writeOfflineKey(key.Name, pubKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return rks, nil
+}
+
+// Sign requests the signature of the message to the remote signer. An eth_secp256k1 key signs the message
+// if it is a 32 bytes digest, or its keccak256 hash otherwise, like a local key.
+func (ks remoteKeystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signType := remoteSignType(k)
+	switch signType {
+	case remotesigner.SIGN_TYPE_EIP712_DIGEST:
+		if len(msg) != ethcrypto.DigestLength {
+			msg = ethcrypto.Keccak256(msg)
+		}
+	case remotesigner.SIGN_TYPE_UNSPECIFIED:
+		return nil, pubKey, errors.Wrapf(ErrUnsupportedSigningAlgo, "remote key %s is of type %s", uid, pubKey.Type())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ks.timeout)
+	defer cancel()
+	res, err := ks.client.Sign(ctx, &remotesigner.SignRequest{Name: uid, SignType: signType, Msg: msg})
+	if err != nil {
+		return nil, pubKey, errors.Wrapf(err, "remote signer failed to sign with key %s", uid)
+	}
+	if !verifyRemoteSignature(pubKey, msg, res.Signature) {
+		return nil, pubKey, fmt.Errorf("invalid signature of the remote signer for key %s", uid)
+	}
+
+	return res.Signature, pubKey, nil
+}
+
+// verifyRemoteSignature verifies a signature of the remote signer. The eth_secp256k1 signatures are made on
+// the digest itself, which the public key doesn't verify as it hashes the message.
+func verifyRemoteSignature(pubKey types.PubKey, msg, sig []byte) bool {
+	if pk, ok := pubKey.(*ethsecp256k1.PubKey); ok {
+		return len(sig) == ethcrypto.SignatureLength &&
+			ethcrypto.VerifySignature(pk.Key, msg, sig[:ethcrypto.SignatureLength-1])
+	}
+
+	return pubKey.VerifySignature(msg, sig)
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.Sign(k.Name, msg)
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) Rename(string, string) error {
+	return ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) ImportBLSKeystore(string, []byte, string) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) WriteLocalKey(string, types.PrivKey) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) ExportPrivateKeyObject(string) (types.PrivKey, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (ks remoteKeystore) ExportBLSKeystore(string, string) ([]byte, error) {
+	return nil, ErrRemoteKeyring
+}
+
+// remoteSignerServer serves the eth_secp256k1 and eth_bls local keys of a keyring to remote keyrings.
+type remoteSignerServer struct {
+	remotesigner.UnimplementedRemoteSignerServer
+
+	kr Keyring
+}
+
+// NewRemoteSignerServer returns a remote signer signing with the eth_secp256k1 and eth_bls local keys of
+// the keyring. It is meant to be served over mutual TLS, see NewRemoteSignerServerTLSConfig.
+func NewRemoteSignerServer(kr Keyring) remotesigner.RemoteSignerServer {
+	return remoteSignerServer{kr: kr}
+}
+
+// Keys implements remotesigner.RemoteSignerServer.
+func (s remoteSignerServer) Keys(_ context.Context, _ *remotesigner.KeysRequest) (*remotesigner.KeysResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &remotesigner.KeysResponse{}
+	for _, k := range records {
+		if k.GetLocal() == nil || remoteSignType(k) == remotesigner.SIGN_TYPE_UNSPECIFIED {
+			continue
+		}
+		pubKey, err := k.GetPubKey()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		pkAny, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Keys = append(res.Keys, &remotesigner.Key{Name: k.Name, PubKey: pkAny})
+	}
+
+	return res, nil
+}
+
+// Sign implements remotesigner.RemoteSignerServer.
+func (s remoteSignerServer) Sign(_ context.Context, req *remotesigner.SignRequest) (*remotesigner.SignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	k, err := s.kr.Key(req.Name)
+	if err != nil || k.GetLocal() == nil {
+		return nil, status.Errorf(codes.NotFound, "key %s not found", req.Name)
+	}
+
+	switch signType := remoteSignType(k); {
+	case signType == remotesigner.SIGN_TYPE_UNSPECIFIED || signType != req.SignType:
+		return nil, status.Errorf(codes.InvalidArgument, "key %s cannot sign messages of type %s", req.Name, req.SignType)
+	case signType == remotesigner.SIGN_TYPE_EIP712_DIGEST && len(req.Msg) != ethcrypto.DigestLength:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid EIP-712 digest length %d", len(req.Msg))
+	}
+
+	sig, _, err := s.kr.Sign(req.Name, req.Msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &remotesigner.SignResponse{Signature: sig}, nil
+}
+
+// remoteSignType returns the type of the messages signed remotely by the key.
+func remoteSignType(k *Record) remotesigner.SignType {
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return remotesigner.SIGN_TYPE_UNSPECIFIED
+	}
+
+	switch pubKey.(type) {
+	case *ethsecp256k1.PubKey:
+		return remotesigner.SIGN_TYPE_EIP712_DIGEST
+	case *bls.PubKey:
+		return remotesigner.SIGN_TYPE_BLS_MESSAGE
+	default:
+		return remotesigner.SIGN_TYPE_UNSPECIFIED
+	}
+}
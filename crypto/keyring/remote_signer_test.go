@@ -0,0 +1,193 @@
+package keyring
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// remoteSignerCerts writes a CA certificate and the server and client certificates it signs into dir.
+func remoteSignerCerts(t *testing.T, dir, prefix string) (caFile string) {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: prefix + "-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+	caFile = filepath.Join(dir, prefix+"-ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), 0o600))
+
+	for i, name := range []string{"server", "client"} {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(int64(i + 2)),
+			Subject:      pkix.Name{CommonName: prefix + "-" + name},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, prefix+"-"+name+".pem"),
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, prefix+"-"+name+"-key.pem"),
+			pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	}
+
+	return caFile
+}
+
+func startRemoteSigner(t *testing.T, kr Keyring, dir string) string {
+	t.Helper()
+
+	tlsConfig, err := NewRemoteSignerServerTLSConfig(filepath.Join(dir, "test-ca.pem"),
+		filepath.Join(dir, "test-server.pem"), filepath.Join(dir, "test-server-key.pem"))
+	require.NoError(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	remotesigner.RegisterRemoteSignerServer(srv, NewRemoteSignerServer(kr))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	dir := t.TempDir()
+	caFile := remoteSignerCerts(t, dir, "test")
+
+	signerKr := NewInMemory(getCodec())
+	eth, _, err := signerKr.NewMnemonic("eth", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	blsKey, _, err := signerKr.NewMnemonic("bls", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthBLS)
+	require.NoError(t, err)
+	_, _, err = signerKr.NewMnemonic("legacy", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr := startRemoteSigner(t, signerKr, dir)
+
+	kr, err := New("keybasename", BackendRemote, "", nil, getCodec(), WithRemoteSigner(RemoteSignerConfig{
+		Addr:     addr,
+		CAFile:   caFile,
+		CertFile: filepath.Join(dir, "test-client.pem"),
+		KeyFile:  filepath.Join(dir, "test-client-key.pem"),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	// only the eth_secp256k1 and eth_bls keys are served
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	// EIP-712 digests are signed as they are, other messages are hashed like a local key does
+	digest := ethcrypto.Keccak256([]byte("eip712"))
+	sig, pubKey, err := kr.Sign("eth", digest)
+	require.NoError(t, err)
+	localSig, _, err := signerKr.Sign("eth", digest)
+	require.NoError(t, err)
+	require.Equal(t, localSig, sig)
+	ethPubKey, err := eth.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, ethPubKey.Equals(pubKey))
+
+	ethAddr, err := eth.GetAddress()
+	require.NoError(t, err)
+	sig, _, err = kr.SignByAddress(ethAddr, []byte("msg"))
+	require.NoError(t, err)
+	require.True(t, ethPubKey.VerifySignature([]byte("msg"), sig))
+
+	sig, pubKey, err = kr.Sign("bls", digest)
+	require.NoError(t, err)
+	blsPubKey, err := blsKey.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, blsPubKey.Equals(pubKey))
+	require.True(t, blsPubKey.VerifySignature(digest, sig))
+
+	_, _, err = SignBLSProof(kr, "bls")
+	require.NoError(t, err)
+
+	_, _, err = kr.Sign("legacy", digest)
+	require.Error(t, err)
+
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.ErrorIs(t, err, ErrRemoteKeyring)
+	require.ErrorIs(t, kr.Delete("eth"), ErrRemoteKeyring)
+	_, err = kr.ExportPrivKeyArmor("eth", "password")
+	require.ErrorIs(t, err, ErrRemoteKeyring)
+	_, err = ExportBLSKeystore(kr, "bls", "password")
+	require.ErrorIs(t, err, ErrRemoteKeyring)
+}
+
+func TestRemoteKeyringMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	remoteSignerCerts(t, dir, "test")
+	otherCA := remoteSignerCerts(t, dir, "other")
+	addr := startRemoteSigner(t, NewInMemory(getCodec()), dir)
+
+	_, err := New("keybasename", BackendRemote, "", nil, getCodec())
+	require.Error(t, err)
+
+	// the client certificate is not signed by the CA of the signer, nor the signer by the CA of the client
+	_, err = New("keybasename", BackendRemote, "", nil, getCodec(), WithRemoteSigner(RemoteSignerConfig{
+		Addr:     addr,
+		CAFile:   otherCA,
+		CertFile: filepath.Join(dir, "other-client.pem"),
+		KeyFile:  filepath.Join(dir, "other-client-key.pem"),
+		Timeout:  time.Second,
+	}))
+	require.Error(t, err)
+}
+
+func TestRemoteSignerServer(t *testing.T) {
+	kr := NewInMemory(getCodec())
+	_, _, err := kr.NewMnemonic("eth", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	srv := NewRemoteSignerServer(kr)
+
+	_, err = srv.Sign(context.Background(), &remotesigner.SignRequest{Name: "eth", SignType: remotesigner.SIGN_TYPE_EIP712_DIGEST, Msg: []byte("msg")})
+	require.Error(t, err)
+	_, err = srv.Sign(context.Background(), &remotesigner.SignRequest{Name: "eth", SignType: remotesigner.SIGN_TYPE_BLS_MESSAGE, Msg: make([]byte, 32)})
+	require.Error(t, err)
+	_, err = srv.Sign(context.Background(), &remotesigner.SignRequest{Name: "missing", SignType: remotesigner.SIGN_TYPE_BLS_MESSAGE, Msg: make([]byte, 32)})
+	require.Error(t, err)
+	res, err := srv.Sign(context.Background(), &remotesigner.SignRequest{Name: "eth", SignType: remotesigner.SIGN_TYPE_EIP712_DIGEST, Msg: make([]byte, 32)})
+	require.NoError(t, err)
+	require.Len(t, res.Signature, ethcrypto.SignatureLength)
+}
@@ -0,0 +1,255 @@
+package bls
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+// EIP-2335 keystore parameters, see https://eips.ethereum.org/EIPS/eip-2335.
+const (
+	keystoreVersion = 4
+
+	keystoreScryptN     = 262144
+	keystoreScryptR     = 8
+	keystoreScryptP     = 1
+	keystoreDKLen       = 32
+	keystoreSaltLen     = 32
+	keystoreChecksumFn  = "sha256"
+	keystoreCipherFn    = "aes-128-ctr"
+	keystoreKdfScrypt   = "scrypt"
+	keystoreKdfPBKDF2   = "pbkdf2"
+	keystorePBKDF2PRF   = "hmac-sha256"
+	keystoreDescription = "BLS key exported from the keyring"
+)
+
+// ErrKeystorePassword is returned when a keystore is decrypted with a wrong password.
+var ErrKeystorePassword = errors.New("invalid keystore password")
+
+// Keystore is an EIP-2335 keystore holding an encrypted BLS private key.
+type Keystore struct {
+	Crypto      KeystoreCrypto `json:"crypto"`
+	Description string         `json:"description"`
+	PubKey      string         `json:"pubkey"`
+	Path        string         `json:"path"`
+	UUID        string         `json:"uuid"`
+	Version     uint           `json:"version"`
+}
+
+// KeystoreCrypto holds the key derivation, checksum and cipher modules of a keystore.
+type KeystoreCrypto struct {
+	Kdf      KeystoreModule `json:"kdf"`
+	Checksum KeystoreModule `json:"checksum"`
+	Cipher   KeystoreModule `json:"cipher"`
+}
+
+// KeystoreModule is a keystore module made of a function, its parameters and a message.
+type KeystoreModule struct {
+	Function string                 `json:"function"`
+	Params   map[string]interface{} `json:"params"`
+	Message  string                 `json:"message"`
+}
+
+// EncryptKeystore encrypts the private key into an EIP-2335 keystore with the scrypt key derivation function.
+func EncryptKeystore(privKey *PrivKey, password string) ([]byte, error) {
+	return encryptKeystore(privKey, password, keystoreScryptN)
+}
+
+func encryptKeystore(privKey *PrivKey, password string, scryptN int) ([]byte, error) {
+	pubKey := privKey.PubKey()
+	if pubKey == nil {
+		return nil, errors.New("invalid BLS private key")
+	}
+
+	salt := make([]byte, keystoreSaltLen)
+	iv := make([]byte, aes.BlockSize)
+	id := make([]byte, 16)
+	for _, bz := range [][]byte{salt, iv, id} {
+		if _, err := rand.Read(bz); err != nil {
+			return nil, err
+		}
+	}
+	// uuid version 4, variant RFC 4122
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+
+	dk, err := scrypt.Key(normalizeKeystorePassword(password), salt, scryptN, keystoreScryptR, keystoreScryptP, keystoreDKLen)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := aes128CTR(dk[:16], iv, privKey.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	ks := Keystore{
+		Crypto: KeystoreCrypto{
+			Kdf: KeystoreModule{
+				Function: keystoreKdfScrypt,
+				Params: map[string]interface{}{
+					"dklen": keystoreDKLen,
+					"n":     scryptN,
+					"r":     keystoreScryptR,
+					"p":     keystoreScryptP,
+					"salt":  hex.EncodeToString(salt),
+				},
+			},
+			Checksum: KeystoreModule{
+				Function: keystoreChecksumFn,
+				Params:   map[string]interface{}{},
+				Message:  hex.EncodeToString(keystoreChecksum(dk, cipherText)),
+			},
+			Cipher: KeystoreModule{
+				Function: keystoreCipherFn,
+				Params:   map[string]interface{}{"iv": hex.EncodeToString(iv)},
+				Message:  hex.EncodeToString(cipherText),
+			},
+		},
+		Description: keystoreDescription,
+		PubKey:      hex.EncodeToString(pubKey.Bytes()),
+		UUID:        fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]),
+		Version:     keystoreVersion,
+	}
+
+	return json.MarshalIndent(ks, "", "  ")
+}
+
+// DecryptKeystore decrypts the BLS private key of an EIP-2335 keystore. Both the scrypt and pbkdf2 key
+// derivation functions are supported.
+func DecryptKeystore(keystoreJSON []byte, password string) (*PrivKey, error) {
+	var ks Keystore
+	if err := json.Unmarshal(keystoreJSON, &ks); err != nil {
+		return nil, err
+	}
+	if ks.Version != keystoreVersion {
+		return nil, fmt.Errorf("unsupported keystore version %d", ks.Version)
+	}
+	if ks.Crypto.Checksum.Function != keystoreChecksumFn {
+		return nil, fmt.Errorf("unsupported checksum function %s", ks.Crypto.Checksum.Function)
+	}
+	if ks.Crypto.Cipher.Function != keystoreCipherFn {
+		return nil, fmt.Errorf("unsupported cipher function %s", ks.Crypto.Cipher.Function)
+	}
+
+	dk, err := deriveKeystoreKey(ks.Crypto.Kdf, normalizeKeystorePassword(password))
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText, err := hex.DecodeString(ks.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, err
+	}
+	checksum, err := hex.DecodeString(ks.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(checksum, keystoreChecksum(dk, cipherText)) != 1 {
+		return nil, ErrKeystorePassword
+	}
+
+	ivHex, _ := ks.Crypto.Cipher.Params["iv"].(string)
+	iv, err := hex.DecodeString(ivHex)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid keystore cipher iv")
+	}
+	secret, err := aes128CTR(dk[:16], iv, cipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey := &PrivKey{Key: secret}
+	pubKey := privKey.PubKey()
+	if pubKey == nil {
+		return nil, errors.New("invalid BLS private key in keystore")
+	}
+	if ks.PubKey != "" && !strings.EqualFold(strings.TrimPrefix(ks.PubKey, "0x"), hex.EncodeToString(pubKey.Bytes())) {
+		return nil, errors.New("keystore public key doesn't match its private key")
+	}
+
+	return privKey, nil
+}
+
+func deriveKeystoreKey(kdf KeystoreModule, password []byte) ([]byte, error) {
+	saltHex, _ := kdf.Params["salt"].(string)
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return nil, err
+	}
+	dkLen := keystoreIntParam(kdf.Params, "dklen")
+	if dkLen < keystoreDKLen {
+		return nil, fmt.Errorf("invalid keystore dklen %d", dkLen)
+	}
+
+	switch kdf.Function {
+	case keystoreKdfScrypt:
+		return scrypt.Key(password, salt, keystoreIntParam(kdf.Params, "n"), keystoreIntParam(kdf.Params, "r"),
+			keystoreIntParam(kdf.Params, "p"), dkLen)
+	case keystoreKdfPBKDF2:
+		if prf, _ := kdf.Params["prf"].(string); prf != keystorePBKDF2PRF {
+			return nil, fmt.Errorf("unsupported pbkdf2 prf %s", prf)
+		}
+		c := keystoreIntParam(kdf.Params, "c")
+		if c <= 0 {
+			return nil, fmt.Errorf("invalid pbkdf2 iteration count %d", c)
+		}
+		return pbkdf2.Key(password, salt, c, dkLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("unsupported key derivation function %s", kdf.Function)
+	}
+}
+
+// keystoreIntParam returns an integer parameter of a keystore module, which is a float64 once decoded from JSON.
+func keystoreIntParam(params map[string]interface{}, name string) int {
+	switch v := params[name].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
+
+func keystoreChecksum(dk, cipherText []byte) []byte {
+	h := sha256.New()
+	h.Write(dk[16:32])
+	h.Write(cipherText)
+	return h.Sum(nil)
+}
+
+func aes128CTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
+
+// normalizeKeystorePassword applies the NFKD normalization to the password and strips the control codes.
+func normalizeKeystorePassword(password string) []byte {
+	var sb strings.Builder
+	for _, r := range norm.NFKD.String(password) {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return []byte(sb.String())
+}
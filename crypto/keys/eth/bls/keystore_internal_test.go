@@ -0,0 +1,72 @@
+package bls
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pbkdf2 test vector of EIP-2335
+const eip2335PBKDF2Keystore = `{
+	"crypto": {
+		"kdf": {
+			"function": "pbkdf2",
+			"params": {
+				"dklen": 32,
+				"c": 262144,
+				"prf": "hmac-sha256",
+				"salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+			},
+			"message": ""
+		},
+		"checksum": {
+			"function": "sha256",
+			"params": {},
+			"message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+		},
+		"cipher": {
+			"function": "aes-128-ctr",
+			"params": {
+				"iv": "264daa3f303d7259501c93d997d84fe6"
+			},
+			"message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+		}
+	},
+	"description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+	"pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+	"path": "m/12381/60/0/0",
+	"uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+	"version": 4
+}`
+
+func TestDecryptKeystoreVector(t *testing.T) {
+	privKey, err := DecryptKeystore([]byte(eip2335PBKDF2Keystore), "\U0001d531\U0001d522\U0001d530\U0001d531\U0001d52d\U0001d51e\U0001d530\U0001d530\U0001d534\U0001d52c\U0001d52f\U0001d521\U0001f511")
+	require.NoError(t, err)
+	require.Equal(t, "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f", hex.EncodeToString(privKey.Bytes()))
+
+	_, err = DecryptKeystore([]byte(eip2335PBKDF2Keystore), "wrong")
+	require.ErrorIs(t, err, ErrKeystorePassword)
+}
+
+func TestEncryptDecryptKeystore(t *testing.T) {
+	privKey, err := GenPrivKey()
+	require.NoError(t, err)
+
+	bz, err := encryptKeystore(privKey, "password\x7f", 1<<10)
+	require.NoError(t, err)
+
+	var ks Keystore
+	require.NoError(t, json.Unmarshal(bz, &ks))
+	require.Equal(t, uint(keystoreVersion), ks.Version)
+	require.Equal(t, hex.EncodeToString(privKey.PubKey().Bytes()), ks.PubKey)
+
+	// control codes are stripped from the password
+	decrypted, err := DecryptKeystore(bz, "password")
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(decrypted))
+
+	_, err = DecryptKeystore(bz, "other")
+	require.ErrorIs(t, err, ErrKeystorePassword)
+}
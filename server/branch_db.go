@@ -0,0 +1,195 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+)
+
+var (
+	errBranchKeyEmpty    = errors.New("key cannot be empty")
+	errBranchValueNil    = errors.New("value cannot be nil")
+	errBranchBatchClosed = errors.New("batch has been written or closed")
+)
+
+var _ dbm.DB = (*branchDB)(nil)
+
+// branchDB is a database which reads through to a parent database and keeps all writes in memory, so that an
+// application can be loaded and executed on top of a data directory without modifying it.
+type branchDB struct {
+	parent dbm.DB
+	cache  *cachekv.Store
+}
+
+func newBranchDB(parent dbm.DB) *branchDB {
+	return &branchDB{
+		parent: parent,
+		cache:  cachekv.NewStore(dbadapter.Store{DB: parent}),
+	}
+}
+
+// Get implements DB.
+func (db *branchDB) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errBranchKeyEmpty
+	}
+	return db.cache.Get(key), nil
+}
+
+// Has implements DB.
+func (db *branchDB) Has(key []byte) (bool, error) {
+	if len(key) == 0 {
+		return false, errBranchKeyEmpty
+	}
+	return db.cache.Has(key), nil
+}
+
+// Set implements DB.
+func (db *branchDB) Set(key []byte, value []byte) error {
+	if len(key) == 0 {
+		return errBranchKeyEmpty
+	}
+	if value == nil {
+		return errBranchValueNil
+	}
+	db.cache.Set(key, value)
+	return nil
+}
+
+// SetSync implements DB.
+func (db *branchDB) SetSync(key []byte, value []byte) error {
+	return db.Set(key, value)
+}
+
+// Delete implements DB.
+func (db *branchDB) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errBranchKeyEmpty
+	}
+	db.cache.Delete(key)
+	return nil
+}
+
+// DeleteSync implements DB.
+func (db *branchDB) DeleteSync(key []byte) error {
+	return db.Delete(key)
+}
+
+// Iterator implements DB.
+func (db *branchDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errBranchKeyEmpty
+	}
+	return db.cache.Iterator(start, end), nil
+}
+
+// ReverseIterator implements DB.
+func (db *branchDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errBranchKeyEmpty
+	}
+	return db.cache.ReverseIterator(start, end), nil
+}
+
+// Close implements DB, the in-memory writes are dropped.
+func (db *branchDB) Close() error {
+	return db.parent.Close()
+}
+
+// NewBatch implements DB.
+func (db *branchDB) NewBatch() dbm.Batch {
+	return &branchBatch{db: db}
+}
+
+// Print implements DB.
+func (db *branchDB) Print() error {
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", itr.Key(), itr.Value())
+	}
+	return nil
+}
+
+// Stats implements DB.
+func (db *branchDB) Stats() map[string]string {
+	stats := db.parent.Stats()
+	stats["database.type"] = "branchDB"
+	return stats
+}
+
+type branchOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// branchBatch collects the writes of a batch and applies them to the branchDB on Write.
+type branchBatch struct {
+	db     *branchDB
+	ops    []branchOp
+	closed bool
+}
+
+var _ dbm.Batch = (*branchBatch)(nil)
+
+// Set implements Batch.
+func (b *branchBatch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errBranchKeyEmpty
+	}
+	if value == nil {
+		return errBranchValueNil
+	}
+	if b.closed {
+		return errBranchBatchClosed
+	}
+	b.ops = append(b.ops, branchOp{key: key, value: value})
+	return nil
+}
+
+// Delete implements Batch.
+func (b *branchBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errBranchKeyEmpty
+	}
+	if b.closed {
+		return errBranchBatchClosed
+	}
+	b.ops = append(b.ops, branchOp{key: key, delete: true})
+	return nil
+}
+
+// Write implements Batch.
+func (b *branchBatch) Write() error {
+	if b.closed {
+		return errBranchBatchClosed
+	}
+	for _, op := range b.ops {
+		if op.delete {
+			b.db.cache.Delete(op.key)
+		} else {
+			b.db.cache.Set(op.key, op.value)
+		}
+	}
+	return b.Close()
+}
+
+// WriteSync implements Batch.
+func (b *branchBatch) WriteSync() error {
+	return b.Write()
+}
+
+// Close implements Batch.
+func (b *branchBatch) Close() error {
+	b.ops = nil
+	b.closed = true
+	return nil
+}
@@ -3,14 +3,19 @@ package config
 import (
 	"fmt"
 	"math"
+	"net"
 	"strings"
 
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 const (
@@ -25,6 +30,9 @@ const (
 	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
 	DefaultGRPCWebAddress = "localhost:9091"
 
+	// DefaultMempoolEvictAddress defines the default address to bind the admin gRPC listener of the mempool to.
+	DefaultMempoolEvictAddress = "localhost:9095"
+
 	// DefaultGRPCMaxRecvMsgSize defines the default gRPC max message size in
 	// bytes the server can receive.
 	DefaultGRPCMaxRecvMsgSize = 1024 * 1024 * 10
@@ -33,8 +41,17 @@ const (
 	// bytes the server can send.
 	DefaultGRPCMaxSendMsgSize = math.MaxInt32
 
+	// MempoolTypeSenderNonce defines the mempool type of mempool.SenderNonceMempool.
+	MempoolTypeSenderNonce = "sender-nonce"
+
+	// MempoolTypeLane defines the mempool type of mempool.LaneMempool.
+	MempoolTypeLane = "lane"
+
 	// FileStreamer defines the store streaming type for file streaming.
 	FileStreamer = "file"
+
+	// CrossChainStreamer defines the store streaming type for cross-chain record streaming.
+	CrossChainStreamer = "crosschain"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -104,6 +121,33 @@ type BaseConfig struct {
 
 	// EnablePlainStore enable/disable plain db store without iavl.
 	EnablePlainStore bool `mapstructure:"enable-plain-store"`
+
+	// PlainStores are the names of the stores using plain db stores while the others use IAVL stores.
+	PlainStores []string `mapstructure:"plain-stores"`
+
+	// ChangeSetCommitment enables the commitment to the change sets of the persisted stores at every block.
+	ChangeSetCommitment bool `mapstructure:"changeset-commitment"`
+
+	// ChangeSetTrustedRPC is the RPC address of the trusted node the change set commitments are checked against.
+	ChangeSetTrustedRPC string `mapstructure:"changeset-trusted-rpc"`
+
+	// PrefetchMode sets how the txs of the blocks are prefetched: on, off or adaptive.
+	PrefetchMode string `mapstructure:"prefetch-mode"`
+
+	// ParallelExecution enables the parallel execution of the txs of the proposed blocks.
+	ParallelExecution bool `mapstructure:"parallel-execution"`
+
+	// ParallelExecutionWorkers is the number of workers executing the txs in parallel, zero for the number of CPUs.
+	ParallelExecutionWorkers int `mapstructure:"parallel-execution-workers"`
+
+	// StateSizeTracking enables the tracking of the key count and byte size of the persisted stores.
+	StateSizeTracking bool `mapstructure:"state-size-tracking"`
+
+	// StateSizePrefixes are the key prefixes whose sizes are tracked apart, as <store>/<name>=<hex prefix>.
+	StateSizePrefixes []string `mapstructure:"state-size-prefixes"`
+
+	// MsgProfiling enables the profiling of the execution of the msgs delivered by msg type.
+	MsgProfiling bool `mapstructure:"msg-profiling"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -175,6 +219,10 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotFormat sets the format of the state sync snapshots taken, 3 or 4 for the parallel format.
+	// 0 uses the current format.
+	SnapshotFormat uint32 `mapstructure:"snapshot-format"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -185,6 +233,43 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int
+
+	// Type is the mempool implementation, "sender-nonce" or "lane".
+	Type string `mapstructure:"type"`
+
+	// FeeDenom is the denom of the fees the lane mempool computes the fee density on, all the denoms if empty.
+	FeeDenom string `mapstructure:"fee-denom"`
+
+	// Lanes are the names of the lanes of the lane mempool, in their order.
+	Lanes []string `mapstructure:"lanes"`
+
+	// Lane holds the settings of the lanes, by name.
+	Lane map[string]MempoolLaneConfig `mapstructure:"lane"`
+
+	// EnableEvict enables the eviction of the txs of the mempool by the MempoolAdmin gRPC service of the node.
+	EnableEvict bool `mapstructure:"enable-evict"`
+
+	// EvictAddress is the loopback address the admin gRPC listener serving the MempoolAdmin service is bound to.
+	EvictAddress string `mapstructure:"evict-address"`
+}
+
+// MempoolLaneConfig defines the settings of a lane of the lane mempool.
+type MempoolLaneConfig struct {
+	// MsgTypeURLs are the type URLs of the msgs of the lane.
+	MsgTypeURLs []string `mapstructure:"msg-type-urls"`
+
+	// MaxTxs bounds the number of txs in the lane, 0 for no bound other than max-txs.
+	MaxTxs int `mapstructure:"max-txs"`
+}
+
+// MempoolLanes returns the lanes of the lane mempool set by the config.
+func (c MempoolConfig) MempoolLanes() []mempool.Lane {
+	lanes := make([]mempool.Lane, 0, len(c.Lanes))
+	for _, name := range c.Lanes {
+		lane := c.Lane[name]
+		lanes = append(lanes, mempool.Lane{Name: name, MsgTypeURLs: lane.MsgTypeURLs, MaxTxs: lane.MaxTxs})
+	}
+	return lanes
 }
 
 type (
@@ -198,7 +283,8 @@ type (
 	// fields are required to be set when state streaming is enabled via a non-empty
 	// list defined by 'StoreConfig.Streamers'.
 	StreamersConfig struct {
-		File FileStreamerConfig `mapstructure:"file"`
+		File       FileStreamerConfig       `mapstructure:"file"`
+		CrossChain CrossChainStreamerConfig `mapstructure:"crosschain"`
 	}
 
 	// FileStreamerConfig defines the file streaming configuration options.
@@ -215,6 +301,22 @@ type (
 		// Fsync specifies if calling fsync after writing the files, it slows down
 		// the commit, but don't lose data in face of system crash.
 		Fsync bool `mapstructure:"fsync"`
+		// DeleteAcked specifies if deleting the files of the blocks acknowledged
+		// by the consumer.
+		DeleteAcked bool `mapstructure:"delete-acked"`
+	}
+
+	// CrossChainStreamerConfig defines the cross-chain record streaming configuration
+	// options, the keys must include the crosschain store.
+	CrossChainStreamerConfig struct {
+		Keys     []string `mapstructure:"keys"`
+		WriteDir string   `mapstructure:"write_dir"`
+		// StopNodeOnError specifies if propagate the streamer errors to the consensus
+		// state machine, it's nesserary for data integrity of output.
+		StopNodeOnError bool `mapstructure:"stop-node-on-error"`
+		// Fsync specifies if calling fsync after writing the records, it slows down
+		// the commit, but don't lose data in face of system crash.
+		Fsync bool `mapstructure:"fsync"`
 	}
 )
 
@@ -231,6 +333,12 @@ type Config struct {
 
 	Upgrade []UpgradeConfig `mapstructure:"upgrade"`
 
+	// UpgradeReadinessThreshold is the minimal ratio of voting power that must have
+	// signalled readiness for an upgrade plan before it is applied. If it is not
+	// reached at the block before the plan height, the node halts. An empty value
+	// disables the check.
+	UpgradeReadinessThreshold string `mapstructure:"upgrade-readiness-threshold"`
+
 	// Telemetry defines the application telemetry configuration
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 	API       APIConfig        `mapstructure:"api"`
@@ -287,10 +395,16 @@ func DefaultConfig() *Config {
 			AppDBBackend:        "",
 			EnableUnsafeQuery:   false,
 			EnablePlainStore:    false,
+			PlainStores:         make([]string, 0),
+			PrefetchMode:        baseapp.PrefetchModeOn,
+			StateSizePrefixes:   make([]string, 0),
 		},
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:           false,
+			GlobalLabels:      [][]string{},
+			TracingExporter:   telemetry.TracingExporterOTLP,
+			TracingEndpoint:   telemetry.DefaultTracingEndpoint,
+			TracingSampleRate: 1,
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -327,13 +441,31 @@ func DefaultConfig() *Config {
 				// in face of system crash.
 				Fsync: false,
 			},
+			CrossChain: CrossChainStreamerConfig{
+				Keys:            []string{"crosschain"},
+				WriteDir:        "data/crosschain-records",
+				StopNodeOnError: true,
+				Fsync:           false,
+			},
 		},
-		Mempool: MempoolConfig{
-			MaxTxs: 5_000,
-		},
+		Mempool: defaultMempoolConfig(),
 	}
 }
 
+func defaultMempoolConfig() MempoolConfig {
+	c := MempoolConfig{
+		MaxTxs:       5_000,
+		Type:         MempoolTypeSenderNonce,
+		Lane:         make(map[string]MempoolLaneConfig),
+		EvictAddress: DefaultMempoolEvictAddress,
+	}
+	for _, lane := range mempool.DefaultLanes() {
+		c.Lanes = append(c.Lanes, lane.Name)
+		c.Lane[lane.Name] = MempoolLaneConfig{MsgTypeURLs: lane.MsgTypeURLs, MaxTxs: lane.MaxTxs}
+	}
+	return c
+}
+
 // GetConfig returns a fully parsed Config object.
 func GetConfig(v *viper.Viper) (Config, error) {
 	conf := DefaultConfig()
@@ -348,6 +480,50 @@ func (c Config) ValidateBasic() error {
 	if c.BaseConfig.MinGasPrices == "" {
 		return sdkerrors.ErrAppConfig.Wrap("set min gas price in app.toml or flag or env variable")
 	}
+	if c.UpgradeReadinessThreshold != "" {
+		threshold, err := sdk.NewDecFromStr(c.UpgradeReadinessThreshold)
+		if err != nil || threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
+			return sdkerrors.ErrAppConfig.Wrapf("invalid upgrade-readiness-threshold %q, must be between 0 and 1", c.UpgradeReadinessThreshold)
+		}
+	}
+	if c.PrefetchMode != "" {
+		if err := baseapp.ValidatePrefetchMode(c.PrefetchMode); err != nil {
+			return sdkerrors.ErrAppConfig.Wrap(err.Error())
+		}
+	}
+	switch c.Mempool.Type {
+	case MempoolTypeSenderNonce, MempoolTypeLane:
+	default:
+		return sdkerrors.ErrAppConfig.Wrapf("invalid mempool type %q, must be %s or %s", c.Mempool.Type, MempoolTypeSenderNonce, MempoolTypeLane)
+	}
+	for _, name := range c.Mempool.Lanes {
+		if _, ok := c.Mempool.Lane[name]; !ok {
+			return sdkerrors.ErrAppConfig.Wrapf("mempool lane %s has no [mempool.lane.%s] settings", name, name)
+		}
+	}
+	if err := mempool.ValidateLanes(c.Mempool.MempoolLanes()); err != nil {
+		return sdkerrors.ErrAppConfig.Wrapf("invalid mempool lanes: %s", err)
+	}
+	if c.Mempool.EnableEvict && !isLoopbackAddress(c.Mempool.EvictAddress) {
+		return sdkerrors.ErrAppConfig.Wrapf("invalid mempool evict-address %q, must be a loopback address", c.Mempool.EvictAddress)
+	}
+	if err := telemetry.ValidateTracing(c.Telemetry); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
+	if c.ParallelExecutionWorkers < 0 {
+		return sdkerrors.ErrAppConfig.Wrapf("invalid parallel-execution-workers %d, must not be negative", c.ParallelExecutionWorkers)
+	}
+	for _, prefix := range c.StateSizePrefixes {
+		if _, err := rootmulti.ParseStateSizePrefix(prefix); err != nil {
+			return sdkerrors.ErrAppConfig.Wrap(err.Error())
+		}
+	}
+	if c.ChangeSetTrustedRPC != "" && !c.ChangeSetCommitment {
+		return sdkerrors.ErrAppConfig.Wrap("changeset-trusted-rpc requires changeset-commitment to be enabled")
+	}
+	if f := c.StateSync.SnapshotFormat; f != 0 && !snapshottypes.IsSupportedFormat(f) {
+		return sdkerrors.ErrAppConfig.Wrapf("unsupported snapshot-format %d", f)
+	}
 	if c.Pruning == pruningtypes.PruningOptionEverything && c.StateSync.SnapshotInterval > 0 {
 		return sdkerrors.ErrAppConfig.Wrapf(
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
@@ -356,3 +532,16 @@ func (c Config) ValidateBasic() error {
 
 	return nil
 }
+
+// isLoopbackAddress returns whether an address is a host:port one of a loopback host.
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
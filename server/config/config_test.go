@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -165,3 +166,43 @@ func TestSetConfigTemplate(t *testing.T) {
 	actual := setBuffer.String()
 	require.Equal(t, expected, actual, "resulting config strings")
 }
+
+func TestMempoolLanesWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Mempool.Type = MempoolTypeLane
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, conf.Mempool, cfg.Mempool)
+	require.Equal(t, mempool.DefaultLanes(), cfg.Mempool.MempoolLanes())
+
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.Mempool.Lanes = append(cfg.Mempool.Lanes, "unknown")
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestMempoolEvictAddress(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	cfg.Mempool.EnableEvict = true
+	require.NoError(t, cfg.ValidateBasic())
+
+	for _, address := range []string{"127.0.0.1:9095", "[::1]:9095"} {
+		cfg.Mempool.EvictAddress = address
+		require.NoError(t, cfg.ValidateBasic(), address)
+	}
+	for _, address := range []string{"0.0.0.0:9095", "192.168.1.1:9095", "example.com:9095", "localhost"} {
+		cfg.Mempool.EvictAddress = address
+		require.Error(t, cfg.ValidateBasic(), address)
+	}
+
+	// the address isn't used while the eviction is disabled
+	cfg.Mempool.EnableEvict = false
+	require.NoError(t, cfg.ValidateBasic())
+}
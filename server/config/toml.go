@@ -101,6 +101,55 @@ enable-unsafe-query = "{{ .BaseConfig.EnableUnsafeQuery }}"
 # Default is false.
 enable-plain-store = "{{ .BaseConfig.EnablePlainStore }}"
 
+# PlainStores are the names of the stores using plain stores while the others use IAVL stores, the hybrid
+# layout the migrate-store command produces with its --stores flag. It is ignored if enable-plain-store is true.
+# The same security considerations as for enable-plain-store apply.
+plain-stores = [{{ range .BaseConfig.PlainStores }}{{ printf "%q, " . }}{{end}}]
+
+# ChangeSetCommitment enables the commitment to the writes of every block into the persisted stores, a hash
+# of the change set of the block chained across heights. It gives the plain stores of a fast node a state
+# commitment, which a full node with the same setting commits to as well.
+# Default is false.
+changeset-commitment = {{ .BaseConfig.ChangeSetCommitment }}
+
+# ChangeSetTrustedRPC is the RPC address of a trusted node, with changeset-commitment enabled, the change set
+# commitments of the blocks are checked against. A divergence is logged as an error.
+changeset-trusted-rpc = "{{ .BaseConfig.ChangeSetTrustedRPC }}"
+
+# PrefetchMode sets how the txs of a block are pre-executed to warm the caches of the stores before DeliverTx:
+# "on" prefetches every block, "off" disables the prefetch, and "adaptive" pauses the prefetch of the blocks
+# and tx types whose reads in DeliverTx are not covered by the prefetch.
+# The plain stores are prefetched into a read cache cleared at every commit. The prefetch coverage is only
+# measured in the adaptive mode or if the telemetry is enabled.
+# Default is "on".
+prefetch-mode = "{{ .BaseConfig.PrefetchMode }}"
+
+# ParallelExecution enables the parallel execution of the txs of the blocks received in ProcessProposal: the
+# txs are executed speculatively in parallel, then checked in block order in DeliverTx, where the txs which
+# read keys written by previous txs, or used the block gas meter, are executed again. The results are
+# identical to the serial execution. The blocks received by block sync are executed serially.
+# Default is false.
+parallel-execution = {{ .BaseConfig.ParallelExecution }}
+
+# ParallelExecutionWorkers is the number of workers executing the txs in parallel, 0 for the number of CPUs.
+parallel-execution-workers = {{ .BaseConfig.ParallelExecutionWorkers }}
+
+# StateSizeTracking enables the tracking of the key count and byte size of every persisted store, updated at
+# every commit and exported to telemetry. The sizes are computed by iterating the stores the first time.
+# Default is false.
+state-size-tracking = {{ .BaseConfig.StateSizeTracking }}
+
+# StateSizePrefixes are key prefixes whose sizes are tracked apart from the rest of their stores, formatted as
+# <store>/<name>=<hex prefix>, for example "crosschain/packages=0x00".
+state-size-prefixes = [{{ range .BaseConfig.StateSizePrefixes }}{{ printf "%q, " . }}{{end}}]
+
+# MsgProfiling enables the profiling of the msgs delivered: the wall time, store accesses, bytes read and written
+# and gas of their execution are aggregated by msg type, next to the gas the gashub module charges for them, and
+# served by the MsgProfiles query of the node gRPC service. With parallel-execution, the msgs of the txs whose
+# speculative execution is accepted are profiled from it, and the wall times include the contention of the workers.
+# Default is false.
+msg-profiling = {{ .BaseConfig.MsgProfiling }}
+
 ###############################################################################
 ###                           Upgrade Configuration                         ###
 ###############################################################################
@@ -114,6 +163,11 @@ enable-plain-store = "{{ .BaseConfig.EnablePlainStore }}"
 # height = 120
 # info = "https://github.com/bnb-chain/BEPs/pull/112"
 
+# UpgradeReadinessThreshold is the minimal ratio of voting power that must have signalled
+# readiness for an upgrade plan, e.g. "0.67". If it is not reached at the block before the
+# plan height, the node halts instead of applying the upgrade. Empty disables the check.
+upgrade-readiness-threshold = "{{ .UpgradeReadinessThreshold }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -149,6 +203,22 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+# TracingEnabled enables the OpenTelemetry spans of the ABCI calls, ante
+# decorators, message handlers and gRPC queries.
+tracing-enabled = {{ .Telemetry.TracingEnabled }}
+
+# TracingExporter defines where the spans are exported: "otlp" to export them
+# to an OTLP collector over gRPC, or "file".
+tracing-exporter = "{{ .Telemetry.TracingExporter }}"
+
+# TracingEndpoint is the endpoint of the OTLP collector, or the path of the
+# file the spans are appended to.
+tracing-endpoint = "{{ .Telemetry.TracingEndpoint }}"
+
+# TracingSampleRate defines the share of the blocks and queries whose spans
+# are exported, between 0 and 1.
+tracing-sample-rate = {{ .Telemetry.TracingSampleRate }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -230,6 +300,11 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-format specifies the format of the snapshots taken: 3 exports the stores one after another into a
+# single stream, 4 exports them concurrently into independent streams which are also restored in parallel.
+# Nodes must support format 4 to restore its snapshots. 0 uses format 3.
+snapshot-format = {{ .StateSync.SnapshotFormat }}
+
 ###############################################################################
 ###                         Store / State Streaming                         ###
 ###############################################################################
@@ -253,6 +328,27 @@ stop-node-on-error = "{{ .Streamers.File.StopNodeOnError }}"
 # fsync specifies if call fsync after writing the files.
 fsync = "{{ .Streamers.File.Fsync }}"
 
+# The file streamer keeps a cursor file, the height up to which all the blocks are written. The blocks it
+# missed, because of a write error or a restart, are regenerated from the application DB with their net
+# changes and marked with a block-N-regenerated file, as the writes overwritten in the block are lost, or
+# marked with a block-N-missing file if their state is pruned. Consumers acknowledge the blocks
+# they processed with the "streaming file-ack" command or file.Ack, and resume after the acknowledged block.
+# delete-acked specifies if delete the files of the acknowledged blocks.
+delete-acked = "{{ .Streamers.File.DeleteAcked }}"
+
+# The crosschain streamer appends normalized records of the cross-chain packages sent and received, their
+# outcomes, relayer fees and sequence gaps to write_dir/records.jsonl at every commit. The keys must include
+# the crosschain store. Read the records with the "streaming crosschain-records" command.
+[streamers.crosschain]
+keys = [{{ range .Streamers.CrossChain.Keys }}{{ printf "%q, " . }}{{end}}]
+write_dir = "{{ .Streamers.CrossChain.WriteDir }}"
+
+# stop-node-on-error specifies if propagate the crosschain streamer errors to consensus state machine.
+stop-node-on-error = "{{ .Streamers.CrossChain.StopNodeOnError }}"
+
+# fsync specifies if call fsync after writing the records.
+fsync = "{{ .Streamers.CrossChain.Fsync }}"
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -265,7 +361,35 @@ fsync = "{{ .Streamers.File.Fsync }}"
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = "{{ .Mempool.MaxTxs }}"
-`
+
+# EnableEvict enables the eviction of the txs of the mempool by the EvictTx method of the MempoolAdmin
+# gRPC service, which is only served by an admin listener bound to evict-address. The tx isn't removed
+# from the mempool of CometBFT: it's still gossiped to the peers, and may be inserted again in the mempool
+# by the recheck of the txs after a block.
+enable-evict = {{ .Mempool.EnableEvict }}
+
+# EvictAddress is the loopback address the admin listener of the MempoolAdmin gRPC service is bound to.
+evict-address = "{{ .Mempool.EvictAddress }}"
+
+# Type of the mempool: "sender-nonce" selects the senders randomly, "lane" selects the txs of the
+# system msgs (oracle claims, governance) first, then the senders round robin by fee density, and
+# evicts the txs of the lowest fee density (fee per byte) when full.
+type = "{{ .Mempool.Type }}"
+
+# Denom of the fees the lane mempool computes the fee density on, all the denoms if empty.
+fee-denom = "{{ .Mempool.FeeDenom }}"
+
+# Lanes of the lane mempool, in their order. The txs of the lanes are selected before the txs of
+# the lanes after them, and of the default lane of the other txs. A tx is in the first lane all
+# its msgs are of. Each lane is set by a [mempool.lane.<name>] table.
+lanes = [{{ range .Mempool.Lanes }}{{ printf "%q, " . }}{{end}}]
+{{ range $name, $lane := .Mempool.Lane }}
+[mempool.lane.{{ $name }}]
+# Type URLs of the msgs of the lane.
+msg-type-urls = [{{ range $lane.MsgTypeURLs }}{{ printf "%q, " . }}{{end}}]
+# Maximum number of txs in the lane, 0 for no bound other than max-txs.
+max-txs = {{ $lane.MaxTxs }}
+{{ end }}`
 
 var configTemplate *template.Template
 
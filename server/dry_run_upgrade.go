@@ -0,0 +1,307 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/node"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const flagBlockTime = "block-time"
+
+// UpgradeDryRunReport describes the state changes of an upgrade plan applied by the dry-run-upgrade command.
+type UpgradeDryRunReport struct {
+	Plan           string                `json:"plan"`
+	Height         int64                 `json:"height"`
+	ModuleVersions []ModuleVersionChange `json:"module_versions"`
+	AddedStores    []string              `json:"added_stores"`
+	DeletedStores  []string              `json:"deleted_stores"`
+	StoreDiffs     []StoreDiff           `json:"store_diffs"`
+}
+
+// ModuleVersionChange is the consensus version change of a module, a zero version means the module is absent.
+type ModuleVersionChange struct {
+	Module string `json:"module"`
+	From   uint64 `json:"from"`
+	To     uint64 `json:"to"`
+}
+
+// StoreDiff counts the keys of a store which have been added, updated or deleted.
+type StoreDiff struct {
+	Store   string `json:"store"`
+	Added   int    `json:"added"`
+	Updated int    `json:"updated"`
+	Deleted int    `json:"deleted"`
+}
+
+// NewDryRunUpgradeCmd creates a command to apply an upgrade plan on a branch of the application state without
+// modifying the data directory, and to report the changes it makes.
+func NewDryRunUpgradeCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run-upgrade [plan-name]",
+		Short: "apply an upgrade plan on an in-memory branch of the application state and report the changes",
+		Long: `
+Load the application db read-only, run the upgrade handler of the given plan on top of the latest state
+as if it was applied at the next height, and report the module version changes, the added and deleted
+stores and the number of added, updated and deleted keys of every store. All writes are kept in memory
+and dropped afterwards. The node must be stopped. The command fails if the upgrade handler fails or panics.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			cfg := ctx.Config
+			home := cfg.RootDir
+			db, err := openReadOnlyDB(home, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			config, err := serverconfig.GetConfig(ctx.Viper)
+			if err != nil {
+				return err
+			}
+			genDocProvider := node.DefaultGenesisDocProviderFunc(ctx.Config)
+			genDoc, err := genDocProvider()
+			if err != nil {
+				return err
+			}
+
+			blockTime := time.Now().UTC()
+			if s, _ := cmd.Flags().GetString(flagBlockTime); s != "" {
+				if blockTime, err = time.Parse(time.RFC3339, s); err != nil {
+					return err
+				}
+			}
+
+			app := appCreator(ctx.Logger, newBranchDB(db), nil, genDoc.ChainID, &config, ctx.Viper)
+			report, err := dryRunUpgrade(app, genDoc.ChainID, args[0], blockTime)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			return printUpgradeDryRunReport(cmd.OutOrStdout(), report, output)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagBlockTime, "", "The block time (RFC3339) of the context the upgrade is applied in, defaults to now")
+	cmd.Flags().StringP(flags.FlagOutput, "o", "text", "Output format (text|json)")
+	return cmd
+}
+
+// openReadOnlyDB opens the application db, in read-only mode if the backend supports it.
+func openReadOnlyDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	if backendType == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts("application", filepath.Join(rootDir, "data"), &opt.Options{ReadOnly: true})
+	}
+	return openDB(rootDir, backendType)
+}
+
+// dryRunUpgrade applies the named upgrade plan on a branch of the latest state of the application and reports
+// the changes. The application must be backed by a database whose writes are discarded.
+func dryRunUpgrade(app types.Application, chainID, name string, blockTime time.Time) (*UpgradeDryRunReport, error) {
+	runner, ok := app.(types.UpgradeDryRunner)
+	if !ok {
+		return nil, errors.New("application does not support upgrade dry runs")
+	}
+	ctxCreator, ok := app.(interface {
+		NewUncachedContext(isCheckTx bool, header tmproto.Header) sdk.Context
+	})
+	if !ok {
+		return nil, errors.New("application cannot create an uncached context")
+	}
+	rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return nil, errors.New("cannot convert store to root multi store")
+	}
+
+	// the application normally loads the latest version with its store loader when it is created
+	if rs.LatestVersion() == 0 {
+		if err := rs.LoadLatestVersion(); err != nil {
+			return nil, err
+		}
+	}
+	version := rs.LatestVersion()
+	if version == 0 {
+		return nil, errors.New("application state is empty")
+	}
+	cInfo, err := rs.GetCommitInfo(version)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := rs.StoreKeysByName()
+	diffs := make(map[string]*diffStore, len(keys))
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for storeName, key := range keys {
+		store := newDiffStore(rs.GetKVStore(key))
+		diffs[storeName] = store
+		stores[key] = store
+	}
+	cms := cachemulti.NewStore(dbm.NewMemDB(), stores, keys, nil, nil)
+
+	header := tmproto.Header{ChainID: chainID, Height: version + 1, Time: blockTime}
+	ctx := ctxCreator.NewUncachedContext(false, header).WithMultiStore(cms)
+	fromVM, toVM, err := runner.DryRunUpgrade(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	// flush the branch into the diff stores to classify the writes
+	cms.Write()
+
+	report := &UpgradeDryRunReport{
+		Plan:           name,
+		Height:         header.Height,
+		ModuleVersions: []ModuleVersionChange{},
+		AddedStores:    []string{},
+		DeletedStores:  []string{},
+		StoreDiffs:     []StoreDiff{},
+	}
+
+	modules := make(map[string]struct{}, len(toVM))
+	for module := range fromVM {
+		modules[module] = struct{}{}
+	}
+	for module := range toVM {
+		modules[module] = struct{}{}
+	}
+	for module := range modules {
+		if fromVM[module] != toVM[module] {
+			report.ModuleVersions = append(report.ModuleVersions, ModuleVersionChange{
+				Module: module,
+				From:   fromVM[module],
+				To:     toVM[module],
+			})
+		}
+	}
+	sort.Slice(report.ModuleVersions, func(i, j int) bool {
+		return report.ModuleVersions[i].Module < report.ModuleVersions[j].Module
+	})
+
+	committed := make(map[string]bool, len(cInfo.StoreInfos))
+	for _, storeInfo := range cInfo.StoreInfos {
+		committed[storeInfo.Name] = true
+		if _, ok := keys[storeInfo.Name]; !ok {
+			report.DeletedStores = append(report.DeletedStores, storeInfo.Name)
+		}
+	}
+	for storeName := range keys {
+		storeType := rs.GetStoreByName(storeName).GetStoreType()
+		if storeType == storetypes.StoreTypeTransient || storeType == storetypes.StoreTypeMemory {
+			continue
+		}
+		if !committed[storeName] {
+			report.AddedStores = append(report.AddedStores, storeName)
+		}
+	}
+	sort.Strings(report.AddedStores)
+	sort.Strings(report.DeletedStores)
+
+	for storeName, store := range diffs {
+		if store.added+store.updated+store.deleted == 0 {
+			continue
+		}
+		report.StoreDiffs = append(report.StoreDiffs, StoreDiff{
+			Store:   storeName,
+			Added:   store.added,
+			Updated: store.updated,
+			Deleted: store.deleted,
+		})
+	}
+	sort.Slice(report.StoreDiffs, func(i, j int) bool {
+		return report.StoreDiffs[i].Store < report.StoreDiffs[j].Store
+	})
+
+	return report, nil
+}
+
+// diffStore is a KVStore which counts the writes flushed into it against the state of its parent, without
+// applying them.
+type diffStore struct {
+	storetypes.KVStore
+
+	added   int
+	updated int
+	deleted int
+}
+
+func newDiffStore(parent storetypes.KVStore) *diffStore {
+	return &diffStore{KVStore: parent}
+}
+
+// Set implements KVStore.
+func (s *diffStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	switch old := s.KVStore.Get(key); {
+	case old == nil:
+		s.added++
+	case !bytes.Equal(old, value):
+		s.updated++
+	}
+}
+
+// Delete implements KVStore.
+func (s *diffStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+	if s.KVStore.Has(key) {
+		s.deleted++
+	}
+}
+
+func printUpgradeDryRunReport(w io.Writer, report *UpgradeDryRunReport, output string) error {
+	if output == "json" {
+		bz, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(bz))
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "upgrade %s applied at height %d\n\n", report.Plan, report.Height)
+
+	fmt.Fprintln(tw, "module versions:")
+	if len(report.ModuleVersions) == 0 {
+		fmt.Fprintln(tw, "  no changes")
+	}
+	for _, change := range report.ModuleVersions {
+		fmt.Fprintf(tw, "  %s\t%d -> %d\n", change.Module, change.From, change.To)
+	}
+
+	fmt.Fprintf(tw, "\nadded stores: %v\ndeleted stores: %v\n\n", report.AddedStores, report.DeletedStores)
+
+	fmt.Fprintln(tw, "state changes:")
+	if len(report.StoreDiffs) == 0 {
+		fmt.Fprintln(tw, "  no changes")
+	} else {
+		fmt.Fprintln(tw, "  store\tadded\tupdated\tdeleted")
+	}
+	for _, diff := range report.StoreDiffs {
+		fmt.Fprintf(tw, "  %s\t%d\t%d\t%d\n", diff.Store, diff.Added, diff.Updated, diff.Deleted)
+	}
+
+	return tw.Flush()
+}
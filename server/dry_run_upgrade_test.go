@@ -0,0 +1,99 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+)
+
+func TestBranchDB(t *testing.T) {
+	parent := dbm.NewMemDB()
+	require.NoError(t, parent.Set([]byte("a"), []byte("1")))
+	require.NoError(t, parent.Set([]byte("b"), []byte("2")))
+
+	db := newBranchDB(parent)
+	require.NoError(t, db.Set([]byte("c"), []byte("3")))
+	require.NoError(t, db.Delete([]byte("a")))
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("b"), []byte("22")))
+	require.NoError(t, batch.Write())
+	require.Error(t, batch.Set([]byte("d"), []byte("4")))
+	require.NoError(t, batch.Close())
+
+	_, err := db.Get(nil)
+	require.Error(t, err)
+
+	value, err := db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("22"), value)
+	has, err := db.Has([]byte("a"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	itr, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	var keys []string
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+	}
+	require.NoError(t, itr.Close())
+	require.Equal(t, []string{"b", "c"}, keys)
+
+	// the parent is left untouched
+	value, err = parent.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+	value, err = parent.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value)
+	has, err = parent.Has([]byte("c"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestDiffStore(t *testing.T) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("a"), []byte("1"))
+	parent.Set([]byte("b"), []byte("2"))
+	parent.Set([]byte("c"), []byte("3"))
+
+	store := newDiffStore(parent)
+	store.Set([]byte("a"), []byte("1"))  // unchanged
+	store.Set([]byte("b"), []byte("22")) // updated
+	store.Set([]byte("d"), []byte("4"))  // added
+	store.Delete([]byte("c"))            // deleted
+	store.Delete([]byte("e"))            // absent
+
+	require.Equal(t, 1, store.added)
+	require.Equal(t, 1, store.updated)
+	require.Equal(t, 1, store.deleted)
+	// writes are not applied to the parent
+	require.False(t, parent.Has([]byte("d")))
+	require.True(t, parent.Has([]byte("c")))
+}
+
+func TestPrintUpgradeDryRunReport(t *testing.T) {
+	report := &UpgradeDryRunReport{
+		Plan:           "test",
+		Height:         11,
+		ModuleVersions: []ModuleVersionChange{{Module: "bank", From: 1, To: 2}},
+		AddedStores:    []string{"new"},
+		DeletedStores:  []string{},
+		StoreDiffs:     []StoreDiff{{Store: "bank", Added: 1, Updated: 2, Deleted: 3}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, printUpgradeDryRunReport(&buf, report, "text"))
+	require.Contains(t, buf.String(), "upgrade test applied at height 11")
+	require.Contains(t, buf.String(), "bank  1 -> 2")
+	require.Contains(t, buf.String(), "added stores: [new]")
+
+	buf.Reset()
+	require.NoError(t, printUpgradeDryRunReport(&buf, report, "json"))
+	require.Contains(t, buf.String(), `"module_versions"`)
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// mempoolEvictor is implemented by the apps whose app-side mempool can be evicted, like the ones embedding
+// BaseApp.
+type mempoolEvictor interface {
+	MempoolEvictToken() []byte
+}
+
+// StartMempoolAdminServer starts the admin gRPC listener of the node on a loopback address, serving only the
+// MempoolAdmin service. The service evicts the txs through the ABCI queries of the local client with the
+// eviction token of the app, so it's serialized with the mempool connection and can't be reached through the
+// RPC of CometBFT.
+func StartMempoolAdminServer(clientCtx client.Context, app types.Application, address string) (*grpc.Server, error) {
+	evictor, ok := app.(mempoolEvictor)
+	if !ok || len(evictor.MempoolEvictToken()) == 0 {
+		return nil, fmt.Errorf("the app doesn't support the mempool eviction")
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, fmt.Errorf("the mempool admin address %s isn't a loopback address", address)
+	}
+
+	grpcSrv := grpc.NewServer(grpc.ForceServerCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()))
+	node.RegisterMempoolAdminServer(grpcSrv, node.NewMempoolAdminServer(clientCtx, evictor.MempoolEvictToken()))
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	errCh := make(chan error)
+	go func() {
+		if err := grpcSrv.Serve(listener); err != nil {
+			errCh <- fmt.Errorf("failed to serve: %w", err)
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+
+	case <-time.After(types.ServerStartTime):
+		// assume server started successfully
+		return grpcSrv, nil
+	}
+}
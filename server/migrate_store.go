@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	"github.com/cometbft/cometbft/node"
 	"github.com/spf13/cobra"
@@ -18,17 +21,48 @@ import (
 // tmpMigratingDir is a temporary directory to facilitate the migration.
 const tmpMigratingDir = "data-migrating"
 
-// NewMigrateStoreCmd creates a command to migrate multistore from IAVL stores to plain DB stores to enable fast node.
+const (
+	flagMigrateTarget    = "target"
+	flagMigrateStores    = "stores"
+	flagMigrateBatchSize = "batch-size"
+
+	migrateTargetDB   = "db"
+	migrateTargetIAVL = "iavl"
+)
+
+// NewMigrateStoreCmd creates a command to migrate multistore between IAVL stores and plain DB stores to enable or disable fast node.
 func NewMigrateStoreCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "migrate-store",
-		Short: "migrate application db to use plain db stores instead of IAVL stores",
+		Short: "migrate application db between IAVL stores and plain db stores",
 		Long: `
 To run a fast node, plain DB store type is needed. To convert a normal full node to a fast full node, 
 we need to migrate the underlying stores. With this command, the old application db will be backed up, 
 the new application db will use plain DB store types.
+
+With --target iavl, the plain DB stores of a fast node are converted back into IAVL stores. The IAVL
+trees are rebuilt in batches saved at the versions preceding the latest one, so their hashes differ from
+the ones committed by the chain.
+With --stores, only the selected stores are converted and the others are copied as they are, which
+gives a hybrid layout to configure with plain-stores in app.toml.
+
+The migration is checkpointed in the data-migrating directory, running the command again after an
+interruption resumes it. Every store is verified by counting its keys once migrated.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			target, _ := cmd.Flags().GetString(flagMigrateTarget)
+			var targetType storetypes.StoreType
+			switch target {
+			case migrateTargetDB:
+				targetType = storetypes.StoreTypeDB
+			case migrateTargetIAVL:
+				targetType = storetypes.StoreTypeIAVL
+			default:
+				return fmt.Errorf("invalid target %q, expected %s or %s", target, migrateTargetDB, migrateTargetIAVL)
+			}
+			stores, _ := cmd.Flags().GetStringSlice(flagMigrateStores)
+			batchSize, _ := cmd.Flags().GetInt(flagMigrateBatchSize)
+
 			ctx := GetServerContextFromCmd(cmd)
 			cfg := ctx.Config
 			home := cfg.RootDir
@@ -59,15 +93,31 @@ the new application db will use plain DB store types.
 				return errors.New("cannot convert store to root multi store")
 			}
 
-			if err = rs.MigrateStores(storetypes.StoreTypeDB, newDb); err != nil {
-				return err
-			}
 			version, err := rootmulti.MigrateCommitInfos(db, newDb)
 			if err != nil {
 				return err
 			}
-			fmt.Printf("Multi root store is captured at version %d \n", version)
 
+			sigCtx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			migrations, err := rs.MigrateStores(targetType, newDb, rootmulti.MigrateOptions{
+				Stores:    stores,
+				BatchSize: batchSize,
+				Progress: func(m rootmulti.StoreMigration) error {
+					fmt.Printf("Migrating store %s from %s to %s: %d keys\n", m.Name, m.From, m.To, m.Keys)
+					return sigCtx.Err()
+				},
+			})
+			if err != nil {
+				_ = db.Close()
+				_ = newDb.Close()
+				return fmt.Errorf("%w, run the command again to resume the migration", err)
+			}
+			for _, m := range migrations {
+				fmt.Printf("Store %s migrated from %s to %s: %d keys in the old db, %d keys in the new db\n",
+					m.Name, m.From, m.To, m.SourceKeys, m.TargetKeys)
+			}
+			fmt.Printf("Multi root store is captured at version %d \n", version)
 			_ = db.Close()
 			_ = newDb.Close()
 
@@ -83,12 +133,29 @@ the new application db will use plain DB store types.
 			fmt.Printf("Application db is replaced and the old one is backup %s\n", applicationBackupPath)
 
 			_ = os.Remove(applicationMigratePath)
-			fmt.Printf("Migrate application db done, please update app.toml and config.toml to use fastnode feature")
+			switch {
+			case len(stores) > 0:
+				var plainStores []string
+				for _, m := range migrations {
+					if m.To == storetypes.StoreTypeDB {
+						plainStores = append(plainStores, fmt.Sprintf("%q", m.Name))
+					}
+				}
+				fmt.Printf("Migrate application db done, please disable enable-plain-store and set plain-stores = [%s] in app.toml\n",
+					strings.Join(plainStores, ", "))
+			case targetType == storetypes.StoreTypeIAVL:
+				fmt.Printf("Migrate application db done, please disable enable-plain-store in app.toml\n")
+			default:
+				fmt.Printf("Migrate application db done, please update app.toml and config.toml to use fastnode feature")
+			}
 
 			return nil
 		},
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagMigrateTarget, migrateTargetDB, "The type the stores are migrated to (db|iavl)")
+	cmd.Flags().StringSlice(flagMigrateStores, nil, "The names of the stores to migrate, all of them if empty")
+	cmd.Flags().Int(flagMigrateBatchSize, rootmulti.DefaultMigrateBatchSize, "The number of keys written per batch and checkpoint")
 	return cmd
 }
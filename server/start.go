@@ -3,6 +3,7 @@ package server
 // DONTCOVER
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -23,6 +24,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -60,12 +62,22 @@ const (
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
 
-	FlagEnableUnsafeQuery = "enable-unsafe-query"
-	FlagEnablePlainStore  = "enable-plain-store"
+	FlagEnableUnsafeQuery   = "enable-unsafe-query"
+	FlagEnablePlainStore    = "enable-plain-store"
+	FlagPlainStores         = "plain-stores"
+	FlagChangeSetCommitment = "changeset-commitment"
+	FlagChangeSetTrustedRPC = "changeset-trusted-rpc"
+	FlagPrefetchMode        = "prefetch-mode"
+	FlagParallelExecution   = "parallel-execution"
+	FlagParallelWorkers     = "parallel-execution-workers"
+	FlagStateSizeTracking   = "state-size-tracking"
+	FlagStateSizePrefixes   = "state-size-prefixes"
+	FlagMsgProfiling        = "msg-profiling"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotFormat     = "state-sync.snapshot-format"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -85,7 +97,13 @@ const (
 	flagGRPCWebAddress = "grpc-web.address"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs   = "mempool.max-txs"
+	FlagMempoolType     = "mempool.type"
+	FlagMempoolFeeDenom = "mempool.fee-denom"
+	FlagMempoolLanes    = "mempool.lanes"
+	FlagMempoolEvict    = "mempool.enable-evict"
+
+	flagMempoolEvictAddress = "mempool.evict-address"
 
 	// db-related flags
 	FlagDBCache                 = "db.cache"
@@ -209,10 +227,19 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotFormat, 0, "State sync snapshot format, 4 for the parallel format")
 
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().String(FlagPrefetchMode, baseapp.PrefetchModeOn, "How the txs of the blocks are prefetched (on|off|adaptive)")
+	cmd.Flags().Bool(FlagParallelExecution, false, "Execute the txs of the proposed blocks in parallel")
+	cmd.Flags().Int(FlagParallelWorkers, 0, "Number of workers executing the txs in parallel, 0 for the number of CPUs")
+	cmd.Flags().Bool(FlagMsgProfiling, false, "Profile the execution of the msgs delivered by msg type")
 
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().String(FlagMempoolType, serverconfig.MempoolTypeSenderNonce, "Type of the app-side mempool (sender-nonce|lane)")
+	cmd.Flags().Bool(FlagMempoolEvict, false, "Enable the eviction of the txs of the app-side mempool by the MempoolAdmin gRPC service")
+	cmd.Flags().String(flagMempoolEvictAddress, serverconfig.DefaultMempoolEvictAddress, "The loopback address the admin listener of the MempoolAdmin gRPC service binds to")
+	cmd.Flags().String(FlagMempoolFeeDenom, "", "Denom of the fees the lane mempool computes the fee density on, all the denoms if empty")
 
 	cmd.Flags().Int(FlagDBCache, 1024, "Megabytes of memory allocated to database caching")
 	cmd.Flags().Int(FlagDBFDLimit, 500, "Raise the open file descriptor resource limit")
@@ -269,6 +296,16 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		return err
 	}
 
+	stopTracing, err := telemetry.EnableTracing(config.Telemetry)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stopTracing(context.Background()); err != nil {
+			ctx.Logger.Error("failed to stop tracing", "err", err)
+		}
+	}()
+
 	svr, err := server.NewServer(addr, transport, app)
 	if err != nil {
 		return fmt.Errorf("error creating listener: %v", err)
@@ -402,6 +439,16 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		return err
 	}
 
+	stopTracing, err := telemetry.EnableTracing(config.Telemetry)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stopTracing(context.Background()); err != nil {
+			ctx.Logger.Error("failed to stop tracing", "err", err)
+		}
+	}()
+
 	var apiSrv *api.Server
 	if config.API.Enable {
 		genDoc, err := genDocProvider()
@@ -493,6 +540,15 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
+	// The mempool admin listener evicts through the local client of the node, which the gRPC only mode hasn't.
+	if config.Mempool.EnableEvict && !gRPCOnly {
+		adminSrv, err := servergrpc.StartMempoolAdminServer(clientCtx, app, config.Mempool.EvictAddress)
+		if err != nil {
+			return err
+		}
+		defer adminSrv.Stop()
+	}
+
 	// At this point it is safe to block the process if we're in gRPC only mode as
 	// we do not need to start Rosetta or handle any Tendermint related processes.
 	if gRPCOnly {
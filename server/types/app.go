@@ -18,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 // ServerStartTime defines the time duration that the server need to stay running after startup
@@ -91,4 +93,10 @@ type (
 	// AppExporter is a function that dumps all app state to
 	// JSON-serializable structure and returns the current validator set.
 	AppExporter func(log.Logger, dbm.DB, io.Writer, int64, bool, []string, AppOptions, []string) (ExportedApp, error)
+
+	// UpgradeDryRunner is implemented by applications supporting the dry-run-upgrade command. DryRunUpgrade
+	// applies the named upgrade plan on the given context and returns the module versions before and after it.
+	UpgradeDryRunner interface {
+		DryRunUpgrade(ctx sdk.Context, name string) (fromVM, toVM module.VersionMap, err error)
+	}
 )
@@ -36,6 +36,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -324,6 +325,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
 		NewMigrateStoreCmd(appCreator, defaultNodeHome),
+		NewDryRunUpgradeCmd(appCreator, defaultNodeHome),
 	)
 }
 
@@ -449,6 +451,40 @@ func openTraceWriter(traceWriterFile string) (w io.WriteCloser, err error) {
 	)
 }
 
+// GetMempoolFromFlags returns the app-side mempool of the type set by the flags, and app.toml for the lanes of
+// the lane mempool. The default lanes are used if they aren't set.
+func GetMempoolFromFlags(appOpts types.AppOptions) (mempool.Mempool, error) {
+	maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs))
+
+	switch mempoolType := cast.ToString(appOpts.Get(FlagMempoolType)); mempoolType {
+	case "", config.MempoolTypeSenderNonce:
+		return mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(maxTxs)), nil
+	case config.MempoolTypeLane:
+		lanes := mempool.DefaultLanes()
+		if names := appOpts.Get(FlagMempoolLanes); names != nil {
+			lanes = nil
+			for _, name := range cast.ToStringSlice(names) {
+				lanes = append(lanes, mempool.Lane{
+					Name:        name,
+					MsgTypeURLs: cast.ToStringSlice(appOpts.Get(fmt.Sprintf("mempool.lane.%s.msg-type-urls", name))),
+					MaxTxs:      cast.ToInt(appOpts.Get(fmt.Sprintf("mempool.lane.%s.max-txs", name))),
+				})
+			}
+		}
+		if err := mempool.ValidateLanes(lanes); err != nil {
+			return nil, fmt.Errorf("invalid mempool lanes: %w", err)
+		}
+
+		return mempool.NewLaneMempool(
+			mempool.LaneMaxTxOpt(maxTxs),
+			mempool.LanesOpt(lanes...),
+			mempool.LaneFeeDenomOpt(cast.ToString(appOpts.Get(FlagMempoolFeeDenom))),
+		), nil
+	default:
+		return nil, fmt.Errorf("invalid mempool type %q", mempoolType)
+	}
+}
+
 // DefaultBaseappOptions returns the default baseapp options provided by the Cosmos SDK
 func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	var cache sdk.MultiStorePersistentCache
@@ -462,6 +498,11 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
+	mp, err := GetMempoolFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
 	if chainID == "" {
@@ -483,6 +524,16 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.Format = cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotFormat))
+
+	var stateSizePrefixes []rootmulti.StateSizePrefix
+	for _, s := range cast.ToStringSlice(appOpts.Get(FlagStateSizePrefixes)) {
+		prefix, err := rootmulti.ParseStateSizePrefix(s)
+		if err != nil {
+			panic(err)
+		}
+		stateSizePrefixes = append(stateSizePrefixes, prefix)
+	}
 
 	return []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
@@ -497,15 +548,20 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
-		baseapp.SetMempool(
-			mempool.NewSenderNonceMempool(
-				mempool.SenderNonceMaxTxOpt(cast.ToInt(appOpts.Get(FlagMempoolMaxTxs))),
-			),
-		),
+		baseapp.SetMempool(mp),
+		baseapp.SetEnableMempoolEvict(cast.ToBool(appOpts.Get(FlagMempoolEvict))),
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
 		baseapp.SetChainID(chainID),
 		baseapp.SetEnableUnsafeQuery(cast.ToBool(appOpts.Get(FlagEnableUnsafeQuery))),
 		baseapp.SetEnablePlainStore(cast.ToBool(appOpts.Get(FlagEnablePlainStore))),
+		baseapp.SetPlainStores(cast.ToStringSlice(appOpts.Get(FlagPlainStores))),
+		baseapp.SetChangeSetCommitment(cast.ToBool(appOpts.Get(FlagChangeSetCommitment))),
+		baseapp.SetChangeSetTrustedRPC(cast.ToString(appOpts.Get(FlagChangeSetTrustedRPC))),
+		baseapp.SetPrefetchMode(cast.ToString(appOpts.Get(FlagPrefetchMode))),
+		baseapp.SetParallelExecution(cast.ToBool(appOpts.Get(FlagParallelExecution)), cast.ToInt(appOpts.Get(FlagParallelWorkers))),
+		baseapp.SetStateSizeTracking(cast.ToBool(appOpts.Get(FlagStateSizeTracking))),
+		baseapp.SetStateSizePrefixes(stateSizePrefixes...),
+		baseapp.SetMsgProfiling(cast.ToBool(appOpts.Get(FlagMsgProfiling))),
 	}
 }
 
@@ -39,6 +39,7 @@ import (
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
@@ -164,8 +165,9 @@ var (
 )
 
 var (
-	_ runtime.AppI            = (*SimApp)(nil)
-	_ servertypes.Application = (*SimApp)(nil)
+	_ runtime.AppI                 = (*SimApp)(nil)
+	_ servertypes.Application      = (*SimApp)(nil)
+	_ servertypes.UpgradeDryRunner = (*SimApp)(nil)
 )
 
 // SimApp extends an ABCI application, but with most of its parameters exported.
@@ -268,6 +270,10 @@ func NewSimApp(
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
 	baseAppOptions = append(baseAppOptions, baseapp.SetChainID(chainID))
+	// the cross chain packages are tracked apart when state-size-tracking is enabled
+	baseAppOptions = append(baseAppOptions, baseapp.SetStateSizePrefixes(rootmulti.StateSizePrefix{
+		Store: crosschaintypes.StoreKey, Name: "packages", Prefix: crosschaintypes.PrefixForIbcPackageKey,
+	}))
 	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetVersion(version.Version)
@@ -358,11 +364,13 @@ func NewSimApp(
 	app.GroupKeeper = groupkeeper.NewKeeper(keys[group.StoreKey], appCodec, app.MsgServiceRouter(), app.AccountKeeper, groupConfig)
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
+	app.UpgradeKeeper.SetStakingKeeper(app.StakingKeeper)
 
 	app.CrossChainKeeper = crosschainkeeper.NewKeeper(appCodec, keys[crosschaintypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		app.StakingKeeper, app.BankKeeper)
 
 	app.GashubKeeper = gashubkeeper.NewKeeper(appCodec, keys[gashubtypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	bApp.SetMsgGasFunc(app.GashubKeeper.MsgGas)
 
 	// Register the proposal types
 	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
@@ -523,6 +531,8 @@ func NewSimApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	app.AddRetentionConstraint(app.UpgradeKeeper.RetentionConstraint)
+	app.AddRetentionConstraint(app.CrossChainKeeper.RetentionConstraint)
 	app.setAnteHandler(encodingConfig.TxConfig)
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
@@ -117,8 +117,9 @@ var (
 )
 
 var (
-	_ runtime.AppI            = (*SimApp)(nil)
-	_ servertypes.Application = (*SimApp)(nil)
+	_ runtime.AppI                 = (*SimApp)(nil)
+	_ servertypes.Application      = (*SimApp)(nil)
+	_ servertypes.UpgradeDryRunner = (*SimApp)(nil)
 )
 
 // SimApp extends an ABCI application, but with most of its parameters exported.
@@ -272,6 +273,9 @@ func NewSimApp(
 	baseAppOptions = append(baseAppOptions,
 		func(ba *baseapp.BaseApp) {
 			ba.SetUpgradeChecker(app.UpgradeKeeper.IsUpgraded)
+			ba.AddRetentionConstraint(app.UpgradeKeeper.RetentionConstraint)
+			ba.AddRetentionConstraint(app.OracleKeeper.RetentionConstraint)
+			ba.AddRetentionConstraint(app.CrossChainKeeper.RetentionConstraint)
 		},
 		baseapp.SetChainID(chainID),
 	)
@@ -16,6 +16,16 @@ func (app *SimApp) RegisterUpgradeHandlers(chainID string, serverCfg *serverconf
 		return err
 	}
 
+	if serverCfg.UpgradeReadinessThreshold != "" {
+		threshold, err := sdk.NewDecFromStr(serverCfg.UpgradeReadinessThreshold)
+		if err != nil {
+			return err
+		}
+		if err := app.UpgradeKeeper.SetReadinessThreshold(threshold); err != nil {
+			return err
+		}
+	}
+
 	// Register the upgrade handler
 	app.UpgradeKeeper.SetUpgradeHandler(upgradetypes.EnablePublicDelegationUpgrade,
 		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
@@ -33,3 +43,8 @@ func (app *SimApp) RegisterUpgradeHandlers(chainID string, serverCfg *serverconf
 
 	return nil
 }
+
+// DryRunUpgrade applies the named upgrade plan on the given context, see the dry-run-upgrade command.
+func (app *SimApp) DryRunUpgrade(ctx sdk.Context, name string) (module.VersionMap, module.VersionMap, error) {
+	return app.UpgradeKeeper.DryRunUpgrade(ctx, name)
+}
@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/cometbft/cometbft/libs/log"
+	protoio "github.com/cosmos/gogoproto/io"
 
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -181,6 +182,14 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	switch m.opts.Format {
+	case 0, types.CurrentFormat:
+	case types.ParallelFormat:
+		return m.createParallel(height)
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", m.opts.Format)
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
@@ -191,24 +200,20 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
 func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
-	if streamWriter == nil {
-		return
-	}
-	defer func() {
-		if err := streamWriter.Close(); err != nil {
-			streamWriter.CloseWithError(err)
+	writeStream(ch, func(protoWriter protoio.Writer) error {
+		if err := m.multistore.Snapshot(height, protoWriter); err != nil {
+			return err
 		}
-	}()
+		return m.writeExtensions(height, protoWriter)
+	})
+}
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
-		streamWriter.CloseWithError(err)
-		return
-	}
+// writeExtensions writes the metadata and the payloads of the extensions, sorted by name.
+func (m *Manager) writeExtensions(height uint64, protoWriter protoio.Writer) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
 			Item: &types.SnapshotItem_Extension{
 				Extension: &types.SnapshotExtensionMeta{
 					Name:   name,
@@ -217,17 +222,16 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
-			return types.WriteExtensionPayload(streamWriter, payload)
+			return types.WriteExtensionPayload(protoWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 	}
+	return nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -275,9 +279,14 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !types.IsSupportedFormat(snapshot.Format) {
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
+	if snapshot.Format == types.ParallelFormat {
+		if err := validateStreams(m.multistore, snapshot); err != nil {
+			return err
+		}
+	}
 	if snapshot.Height == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
 	}
@@ -300,10 +309,13 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs)
-
 	go func() {
-		err := m.doRestoreSnapshot(snapshot, chChunks)
+		var err error
+		if snapshot.Format == types.ParallelFormat {
+			err = m.doRestoreParallel(snapshot, chChunkIDs)
+		} else {
+			err = m.doRestoreSnapshot(snapshot, m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs))
+		}
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -343,17 +355,26 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	var nextItem types.SnapshotItem
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
 		return err
 	}
 	defer streamReader.Close()
 
+	nextItem, err := m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "multistore restore")
+	}
+	return m.restoreExtensions(snapshot.Height, streamReader, &nextItem)
+}
+
+// restoreExtensions restores the extensions from the protobuf stream, the next item being the first one read
+// from it.
+func (m *Manager) restoreExtensions(height uint64, protoReader protoio.Reader, nextItem *types.SnapshotItem) error {
 	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
 	payloadReader := func() ([]byte, error) {
 		nextItem.Reset()
-		if err := streamReader.ReadMsg(&nextItem); err != nil {
+		if err := protoReader.ReadMsg(nextItem); err != nil {
 			return nil, err
 		}
 		payload := nextItem.GetExtensionPayload()
@@ -363,11 +384,6 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
-	if err != nil {
-		return sdkerrors.Wrap(err, "multistore restore")
-	}
-
 	for {
 		if nextItem.Item == nil {
 			// end of stream
@@ -385,12 +401,12 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
 
-		if err := extension.RestoreExtension(snapshot.Height, metadata.Format, payloadReader); err != nil {
+		if err := extension.RestoreExtension(height, metadata.Format, payloadReader); err != nil {
 			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
 		}
 
 		if nextItem.GetExtensionPayload() != nil {
-			return sdkerrors.Wrapf(err, "extension %s don't exhausted payload stream", metadata.Name)
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "extension %s don't exhausted payload stream", metadata.Name)
 		}
 	}
 	return nil
@@ -480,6 +496,18 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	}
 	defer m.endLocked()
 
+	if snapshot.Format == types.ParallelFormat {
+		DrainChunks(ch)
+		if err := validateStreams(m.multistore, *snapshot); err != nil {
+			return err
+		}
+		chunkIDs := make(chan uint32, snapshot.Chunks)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			chunkIDs <- i
+		}
+		close(chunkIDs)
+		return m.doRestoreParallel(*snapshot, chunkIDs)
+	}
 	return m.doRestoreSnapshot(*snapshot, ch)
 }
 
@@ -0,0 +1,324 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// parallelStreamWorkers is the number of streams of a parallel snapshot written or restored at once, which
+	// bounds the memory the stores being exported or imported hold.
+	parallelStreamWorkers = 8
+
+	// extensionsStream is the name of the last stream of a parallel snapshot, holding the extensions.
+	extensionsStream = "extensions"
+)
+
+// createParallel creates a snapshot of the parallel format, exporting every store into its own stream.
+func (m *Manager) createParallel(height uint64) (*types.Snapshot, error) {
+	multistore, ok := m.multistore.(types.ParallelSnapshotter)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrUnknownFormat, "the multistore doesn't support the parallel snapshot format")
+	}
+	names, err := multistore.SnapshotStreams(height)
+	if err != nil {
+		return nil, err
+	}
+
+	streams := append(append([]string{}, names...), extensionsStream)
+	return m.store.saveParallel(height, types.ParallelFormat, streams, func(i int, ch chan<- io.ReadCloser) {
+		writeStream(ch, func(protoWriter protoio.Writer) error {
+			if i == len(names) {
+				return m.writeExtensions(height, protoWriter)
+			}
+			return multistore.SnapshotStream(height, names[i], protoWriter)
+		})
+	})
+}
+
+// writeStream writes the items of a stream into the channel of its chunks.
+func writeStream(ch chan<- io.ReadCloser, write func(protoWriter protoio.Writer) error) {
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	if err := write(streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := streamWriter.Close(); err != nil {
+		streamWriter.CloseWithError(err)
+	}
+}
+
+// validateStreams checks that the multistore can restore a parallel snapshot and that its streams cover its
+// chunks.
+func validateStreams(multistore types.Snapshotter, snapshot types.Snapshot) error {
+	if _, ok := multistore.(types.ParallelSnapshotter); !ok {
+		return sdkerrors.Wrap(types.ErrUnknownFormat, "the multistore doesn't support the parallel snapshot format")
+	}
+	streams := snapshot.Metadata.Streams
+	if len(streams) == 0 || streams[len(streams)-1].Name != extensionsStream {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "the last stream must be the %s", extensionsStream)
+	}
+	chunks := uint64(0)
+	for _, stream := range streams {
+		if stream.Chunks == 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "stream %s has no chunks", stream.Name)
+		}
+		chunks += uint64(stream.Chunks)
+	}
+	if chunks != uint64(snapshot.Chunks) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunks, but its streams %v", snapshot.Chunks, chunks)
+	}
+	return nil
+}
+
+// doRestoreParallel restores a snapshot of the parallel format, given the IDs of its chunks in order as
+// they are saved. The stores are restored as soon as all the chunks of their streams are saved, with at most
+// parallelStreamWorkers of them at once, and the extensions once the stores are committed.
+func (m *Manager) doRestoreParallel(snapshot types.Snapshot, chunkIDs <-chan uint32) error {
+	multistore := m.multistore.(types.ParallelSnapshotter)
+	streams := snapshot.Metadata.Streams
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallelStreamWorkers)
+	errs := make(chan error, len(streams))
+	fail := func(err error) error {
+		go func() {
+			for range chunkIDs { //nolint:revive
+			}
+		}()
+		wg.Wait()
+		return err
+	}
+
+	stream, first := 0, uint32(0)
+	for chunkID := range chunkIDs {
+		if stream == len(streams) {
+			return fail(sdkerrors.Wrap(sdkerrors.ErrLogic, "received unexpected chunk"))
+		}
+		if chunkID == first+streams[stream].Chunks-1 {
+			if stream < len(streams)-1 {
+				wg.Add(1)
+				go func(stream int, first uint32) {
+					defer wg.Done()
+					sem <- struct{}{}
+					defer func() { <-sem }()
+					name := streams[stream].Name
+					err := m.restoreStream(snapshot, stream, first, func(protoReader protoio.Reader) error {
+						return multistore.RestoreStream(snapshot.Height, name, protoReader)
+					})
+					if err != nil {
+						errs <- sdkerrors.Wrapf(err, "restore stream %s", name)
+					}
+				}(stream, first)
+			}
+			first += streams[stream].Chunks
+			stream++
+		}
+
+		select {
+		case err := <-errs:
+			return fail(err)
+		default:
+		}
+	}
+	wg.Wait()
+
+	select {
+	case err := <-errs:
+		return err
+	default:
+	}
+	if stream < len(streams) {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "restore ended prematurely")
+	}
+
+	if err := multistore.CommitRestore(snapshot.Height); err != nil {
+		return sdkerrors.Wrap(err, "multistore restore")
+	}
+	last := len(streams) - 1
+	return m.restoreStream(snapshot, last, first-streams[last].Chunks, func(protoReader protoio.Reader) error {
+		var nextItem types.SnapshotItem
+		if err := protoReader.ReadMsg(&nextItem); err != nil && err != io.EOF {
+			return err
+		}
+		return m.restoreExtensions(snapshot.Height, protoReader, &nextItem)
+	})
+}
+
+// restoreStream restores a stream from its saved chunks, starting at the first one, and checks its hash.
+func (m *Manager) restoreStream(snapshot types.Snapshot, stream int, first uint32, restore func(protoReader protoio.Reader) error) error {
+	hasher := sha256.New()
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chunks)
+		for i := first; i < first+snapshot.Metadata.Streams[stream].Chunks; i++ {
+			chunk, err := m.store.loadChunkFile(snapshot.Height, snapshot.Format, i)
+			if err != nil {
+				pr, pw := io.Pipe()
+				_ = pw.CloseWithError(err)
+				chunks <- pr
+				return
+			}
+			chunks <- hashedChunk{Reader: io.TeeReader(chunk, hasher), Closer: chunk}
+		}
+	}()
+
+	streamReader, err := NewStreamReader(chunks)
+	if err != nil {
+		DrainChunks(chunks)
+		return err
+	}
+	defer streamReader.Close()
+
+	if err := restore(streamReader); err != nil {
+		return err
+	}
+	// the stream is read to its end to hash all its chunks
+	if _, err := io.Copy(io.Discard, streamReader.chunkReader); err != nil {
+		return err
+	}
+	if expected := snapshot.Metadata.Streams[stream].Hash; !bytes.Equal(hasher.Sum(nil), expected) {
+		return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "stream %s: expected %x, got %x",
+			snapshot.Metadata.Streams[stream].Name, expected, hasher.Sum(nil))
+	}
+	return nil
+}
+
+// hashedChunk is a chunk whose reads are hashed.
+type hashedChunk struct {
+	io.Reader
+	io.Closer
+}
+
+// saveParallel saves a snapshot made of independent streams, whose chunks are written concurrently by the
+// write function, with at most parallelStreamWorkers streams at once. The chunks of every stream are saved
+// apart until all the streams are written, then numbered in the order of the streams.
+func (s *Store) saveParallel(
+	height uint64, format uint32, names []string, write func(i int, ch chan<- io.ReadCloser),
+) (*types.Snapshot, error) {
+	done, err := s.beginSave(height, format)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	dir := filepath.Join(s.pathSnapshot(height, format), "streams")
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to clean snapshot streams directory %q", dir)
+	}
+	defer os.RemoveAll(dir)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallelStreamWorkers)
+	counts := make([]uint32, len(names))
+	errs := make([]error, len(names))
+	for i := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			ch := make(chan io.ReadCloser)
+			go write(i, ch)
+			counts[i], errs[i] = saveStreamChunks(ch, filepath.Join(dir, strconv.Itoa(i)))
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to generate snapshot stream %s", names[i])
+		}
+	}
+
+	snapshot := &types.Snapshot{
+		Height: height,
+		Format: format,
+	}
+	snapshotHasher := sha256.New()
+	chunkHasher := sha256.New()
+	streamHasher := sha256.New()
+	for i, name := range names {
+		streamHasher.Reset()
+		for c := uint32(0); c < counts[i]; c++ {
+			path := filepath.Join(dir, strconv.Itoa(i), strconv.FormatUint(uint64(c), 10))
+			if err := s.moveChunk(path, snapshot, chunkHasher, streamHasher, snapshotHasher); err != nil {
+				return nil, err
+			}
+		}
+		snapshot.Metadata.Streams = append(snapshot.Metadata.Streams, &types.SnapshotStream{
+			Name:   name,
+			Chunks: counts[i],
+			Hash:   streamHasher.Sum(nil),
+		})
+	}
+	snapshot.Chunks = uint32(len(snapshot.Metadata.ChunkHashes))
+	snapshot.Hash = snapshotHasher.Sum(nil)
+	return snapshot, s.saveSnapshot(snapshot)
+}
+
+// saveStreamChunks saves the chunks of a stream into a directory, numbered from 0.
+func saveStreamChunks(chunks <-chan io.ReadCloser, dir string) (uint32, error) {
+	defer DrainChunks(chunks)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, sdkerrors.Wrapf(err, "failed to create snapshot stream directory %q", dir)
+	}
+
+	count := uint32(0)
+	for chunkBody := range chunks {
+		err := func() error {
+			defer chunkBody.Close()
+			path := filepath.Join(dir, strconv.FormatUint(uint64(count), 10))
+			chunkFile, err := os.Create(path)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to create snapshot chunk file %q", path)
+			}
+			defer chunkFile.Close()
+			if _, err := io.Copy(chunkFile, chunkBody); err != nil {
+				return err
+			}
+			return chunkFile.Close()
+		}()
+		if err != nil {
+			return 0, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// moveChunk moves a chunk saved apart to the next chunk of the snapshot, hashing it into the chunk hash and
+// the stream and snapshot hashes.
+func (s *Store) moveChunk(path string, snapshot *types.Snapshot, chunkHasher, streamHasher, snapshotHasher hash.Hash) error {
+	chunkFile, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer chunkFile.Close()
+
+	chunkHasher.Reset()
+	if _, err := io.Copy(io.MultiWriter(chunkHasher, streamHasher, snapshotHasher), chunkFile); err != nil {
+		return sdkerrors.Wrapf(err, "failed to hash snapshot chunk file %q", path)
+	}
+	chunkFile.Close()
+
+	index := uint32(len(snapshot.Metadata.ChunkHashes))
+	if err := os.Rename(path, s.PathChunk(snapshot.Height, snapshot.Format, index)); err != nil {
+		return sdkerrors.Wrapf(err, "failed to move snapshot chunk %d", index)
+	}
+	snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHasher.Sum(nil))
+	return nil
+}
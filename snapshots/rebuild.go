@@ -0,0 +1,148 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RebuildSnapshot restores the stores of a snapshot into the multistore from its chunks, given in order, and
+// checks the chunks against the hashes of the snapshot. Unlike a restore, the extensions aren't restored, so the
+// multistore ends up committing to the state the snapshot holds, e.g. to check its app hash against a trusted
+// header. The multistore must be empty.
+func RebuildSnapshot(multistore types.Snapshotter, snapshot types.Snapshot, chunks <-chan io.ReadCloser) error {
+	if !types.IsSupportedFormat(snapshot.Format) {
+		DrainChunks(chunks)
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+	if int(snapshot.Chunks) != len(snapshot.Metadata.ChunkHashes) {
+		DrainChunks(chunks)
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunks, but %v chunk hashes",
+			snapshot.Chunks, len(snapshot.Metadata.ChunkHashes))
+	}
+	if snapshot.Format == types.ParallelFormat {
+		if err := validateStreams(multistore, snapshot); err != nil {
+			DrainChunks(chunks)
+			return err
+		}
+	}
+
+	verified := make(chan io.ReadCloser)
+	verifyErr := make(chan error, 1)
+	go func() {
+		defer close(verified)
+		verifyErr <- verifyChunks(snapshot, chunks, verified)
+	}()
+
+	var err error
+	if snapshot.Format == types.ParallelFormat {
+		err = rebuildStreams(multistore.(types.ParallelSnapshotter), snapshot, verified)
+	} else {
+		err = rebuildStream(snapshot, verified, func(streamReader *StreamReader) error {
+			_, err := multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+			return err
+		})
+	}
+	DrainChunks(verified)
+
+	// a corrupted chunk most likely fails the restore too, the mismatch is the error to report then
+	if err := <-verifyErr; err != nil {
+		return err
+	}
+	return err
+}
+
+// rebuildStreams restores the stores of a parallel snapshot one stream after the other, and commits them.
+func rebuildStreams(multistore types.ParallelSnapshotter, snapshot types.Snapshot, chunks <-chan io.ReadCloser) error {
+	streams := snapshot.Metadata.Streams
+	for _, stream := range streams[:len(streams)-1] {
+		ch := make(chan io.ReadCloser)
+		go func(count uint32) {
+			defer close(ch)
+			for i := uint32(0); i < count; i++ {
+				chunk, ok := <-chunks
+				if !ok {
+					return
+				}
+				ch <- chunk
+			}
+		}(stream.Chunks)
+
+		name := stream.Name
+		err := rebuildStream(snapshot, ch, func(streamReader *StreamReader) error {
+			return multistore.RestoreStream(snapshot.Height, name, streamReader)
+		})
+		DrainChunks(ch)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "restore stream %s", name)
+		}
+	}
+	return multistore.CommitRestore(snapshot.Height)
+}
+
+// rebuildStream restores a stream of chunks.
+func rebuildStream(snapshot types.Snapshot, chunks <-chan io.ReadCloser, restore func(*StreamReader) error) error {
+	streamReader, err := NewStreamReader(chunks)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+	return restore(streamReader)
+}
+
+// verifyChunks passes the chunks of a snapshot on once they match their hash, and checks the hashes of the
+// streams and of the whole snapshot once all of them are read.
+func verifyChunks(snapshot types.Snapshot, chunks <-chan io.ReadCloser, verified chan<- io.ReadCloser) error {
+	defer DrainChunks(chunks)
+
+	snapshotHasher := sha256.New()
+	streamHasher := sha256.New()
+	stream, streamEnd := 0, uint32(0)
+	if len(snapshot.Metadata.Streams) > 0 {
+		streamEnd = snapshot.Metadata.Streams[0].Chunks
+	}
+
+	index := uint32(0)
+	for chunk := range chunks {
+		if index >= snapshot.Chunks {
+			_ = chunk.Close()
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has more than %v chunks", snapshot.Chunks)
+		}
+		bz, err := io.ReadAll(chunk)
+		_ = chunk.Close()
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to read chunk %v", index)
+		}
+		chunkHash := sha256.Sum256(bz)
+		if expected := snapshot.Metadata.ChunkHashes[index]; !bytes.Equal(chunkHash[:], expected) {
+			return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "chunk %v: expected %x, got %x", index, expected, chunkHash)
+		}
+		snapshotHasher.Write(bz)
+		streamHasher.Write(bz)
+		index++
+
+		if len(snapshot.Metadata.Streams) > 0 && index == streamEnd {
+			if expected := snapshot.Metadata.Streams[stream].Hash; !bytes.Equal(streamHasher.Sum(nil), expected) {
+				return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "stream %s: expected %x, got %x",
+					snapshot.Metadata.Streams[stream].Name, expected, streamHasher.Sum(nil))
+			}
+			streamHasher.Reset()
+			stream++
+			if stream < len(snapshot.Metadata.Streams) {
+				streamEnd += snapshot.Metadata.Streams[stream].Chunks
+			}
+		}
+		verified <- io.NopCloser(bytes.NewReader(bz))
+	}
+
+	if index != snapshot.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunks, got %v", snapshot.Chunks, index)
+	}
+	if !bytes.Equal(snapshotHasher.Sum(nil), snapshot.Hash) {
+		return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "snapshot: expected %x, got %x", snapshot.Hash, snapshotHasher.Sum(nil))
+	}
+	return nil
+}
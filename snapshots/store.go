@@ -224,33 +224,20 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
-	defer DrainChunks(chunks)
-	if height == 0 {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot height cannot be 0")
-	}
-
-	s.mtx.Lock()
-	saving := s.saving[height]
-	s.saving[height] = true
-	s.mtx.Unlock()
-	if saving {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
-			"a snapshot for height %v is already being saved", height)
-	}
-	defer func() {
-		s.mtx.Lock()
-		delete(s.saving, height)
-		s.mtx.Unlock()
-	}()
+	return s.SaveStreams(height, format, nil, chunks)
+}
 
-	exists, err := s.db.Has(encodeKey(height, format))
+// SaveStreams saves a snapshot of the parallel format to disk, returning it. The streams give the names and
+// the chunk counts of the streams the chunks are split into, their hashes are computed from the chunks.
+func (s *Store) SaveStreams(
+	height uint64, format uint32, streams []*types.SnapshotStream, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	defer DrainChunks(chunks)
+	done, err := s.beginSave(height, format)
 	if err != nil {
 		return nil, err
 	}
-	if exists {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
-			"snapshot already exists for height %v format %v", height, format)
-	}
+	defer done()
 
 	snapshot := &types.Snapshot{
 		Height: height,
@@ -259,6 +246,8 @@ func (s *Store) Save(
 	index := uint32(0)
 	snapshotHasher := sha256.New()
 	chunkHasher := sha256.New()
+	streamHasher := sha256.New()
+	stream, streamEnd := 0, uint32(0)
 	for chunkBody := range chunks {
 		defer chunkBody.Close() //nolint:staticcheck
 		dir := s.pathSnapshot(height, format)
@@ -267,20 +256,78 @@ func (s *Store) Save(
 			return nil, sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
 		}
 
-		if err := s.saveChunk(chunkBody, index, snapshot, chunkHasher, snapshotHasher); err != nil {
+		if streams == nil {
+			if err := s.saveChunk(chunkBody, index, snapshot, chunkHasher, snapshotHasher); err != nil {
+				return nil, err
+			}
+			index++
+			continue
+		}
+
+		if stream == len(streams) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "more chunks than the %d of the streams", index)
+		}
+		if err := s.saveChunk(chunkBody, index, snapshot, chunkHasher, snapshotHasher, streamHasher); err != nil {
 			return nil, err
 		}
 		index++
+		if index == streamEnd+streams[stream].Chunks {
+			snapshot.Metadata.Streams = append(snapshot.Metadata.Streams, &types.SnapshotStream{
+				Name:   streams[stream].Name,
+				Chunks: streams[stream].Chunks,
+				Hash:   streamHasher.Sum(nil),
+			})
+			streamHasher.Reset()
+			streamEnd = index
+			stream++
+		}
+	}
+	if stream < len(streams) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "%d chunks missing from the streams", len(streams)-stream)
 	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
+// beginSave marks a snapshot as being saved, or errors if it is or if it exists. The returned function
+// ends the save.
+func (s *Store) beginSave(height uint64, format uint32) (func(), error) {
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot height cannot be 0")
+	}
+
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.saving[height] = true
+	s.mtx.Unlock()
+	if saving {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
+			"a snapshot for height %v is already being saved", height)
+	}
+	done := func() {
+		s.mtx.Lock()
+		delete(s.saving, height)
+		s.mtx.Unlock()
+	}
+
+	exists, err := s.db.Has(encodeKey(height, format))
+	if err != nil {
+		done()
+		return nil, err
+	}
+	if exists {
+		done()
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
+			"snapshot already exists for height %v format %v", height, format)
+	}
+	return done, nil
+}
+
 // saveChunk saves the given chunkBody with the given index to its appropriate path on disk.
 // The hash of the chunk is appended to the snapshot's metadata,
-// and the overall snapshot hash is updated with the chunk content too.
-func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types.Snapshot, chunkHasher, snapshotHasher hash.Hash) error {
+// and the overall snapshot hash, and the stream hash if any, are updated with the chunk content too.
+func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types.Snapshot, chunkHasher hash.Hash, hashers ...hash.Hash) error {
 	defer chunkBody.Close()
 
 	path := s.PathChunk(snapshot.Height, snapshot.Format, index)
@@ -291,7 +338,11 @@ func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types
 	defer chunkFile.Close()
 
 	chunkHasher.Reset()
-	if _, err := io.Copy(io.MultiWriter(chunkFile, chunkHasher, snapshotHasher), chunkBody); err != nil {
+	writers := []io.Writer{chunkFile, chunkHasher}
+	for _, hasher := range hashers {
+		writers = append(writers, hasher)
+	}
+	if _, err := io.Copy(io.MultiWriter(writers...), chunkBody); err != nil {
 		return sdkerrors.Wrapf(err, "failed to generate snapshot chunk %d", index)
 	}
 
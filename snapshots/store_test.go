@@ -339,3 +339,30 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_SaveStreams(t *testing.T) {
+	store := setupStore(t)
+	chunks := [][]byte{{1}, {2}, {3}}
+	streams := []*types.SnapshotStream{{Name: "a", Chunks: 2}, {Name: "b", Chunks: 1}}
+	snapshot, err := store.SaveStreams(4, types.ParallelFormat, streams, makeChunks(chunks))
+	require.NoError(t, err)
+	assert.Equal(t, &types.Snapshot{
+		Height: 4,
+		Format: types.ParallelFormat,
+		Chunks: 3,
+		Hash:   hash(chunks),
+		Metadata: types.Metadata{
+			ChunkHashes: checksums(chunks),
+			Streams: []*types.SnapshotStream{
+				{Name: "a", Chunks: 2, Hash: hash(chunks[:2])},
+				{Name: "b", Chunks: 1, Hash: hash(chunks[2:])},
+			},
+		},
+	}, snapshot)
+
+	// the chunks must match the streams
+	_, err = store.SaveStreams(5, types.ParallelFormat, streams, makeChunks(chunks[:2]))
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+	_, err = store.SaveStreams(6, types.ParallelFormat, streams, makeChunks(append(chunks, []byte{4})))
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+}
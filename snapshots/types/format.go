@@ -4,3 +4,13 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// ParallelFormat is the format of the snapshots whose stores are exported concurrently, each into an
+// independent stream of chunks, followed by a stream of the extensions. The streams are listed in the
+// metadata of the snapshot, and the stores are restored in parallel.
+const ParallelFormat uint32 = 4
+
+// IsSupportedFormat returns whether snapshots of the format can be restored.
+func IsSupportedFormat(format uint32) bool {
+	return format == CurrentFormat || format == ParallelFormat
+}
@@ -103,6 +103,9 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// streams are the independent streams a snapshot of the parallel format is made of, in the order of their
+	// chunks.
+	Streams []*SnapshotStream `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +148,75 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetStreams() []*SnapshotStream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+// SnapshotStream is an independently compressed stream of a parallel format snapshot, holding either a store
+// or the extensions.
+type SnapshotStream struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Chunks uint32 `protobuf:"varint,2,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	Hash   []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SnapshotStream) Reset()         { *m = SnapshotStream{} }
+func (m *SnapshotStream) String() string { return proto.CompactTextString(m) }
+func (*SnapshotStream) ProtoMessage()    {}
+func (*SnapshotStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+}
+func (m *SnapshotStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotStream.Merge(m, src)
+}
+func (m *SnapshotStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotStream proto.InternalMessageInfo
+
+func (m *SnapshotStream) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SnapshotStream) GetChunks() uint32 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
+func (m *SnapshotStream) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -166,7 +238,7 @@ func (m *SnapshotItem) Reset()         { *m = SnapshotItem{} }
 func (m *SnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotItem) ProtoMessage()    {}
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
 }
 func (m *SnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,7 +373,7 @@ func (m *SnapshotStoreItem) Reset()         { *m = SnapshotStoreItem{} }
 func (m *SnapshotStoreItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotStoreItem) ProtoMessage()    {}
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{4}
 }
 func (m *SnapshotStoreItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +425,7 @@ func (m *SnapshotIAVLItem) Reset()         { *m = SnapshotIAVLItem{} }
 func (m *SnapshotIAVLItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotIAVLItem) ProtoMessage()    {}
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{4}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{5}
 }
 func (m *SnapshotIAVLItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,7 +494,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{5}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,7 +548,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{6}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +599,7 @@ func (m *SnapshotKVItem) Reset()         { *m = SnapshotKVItem{} }
 func (m *SnapshotKVItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotKVItem) ProtoMessage()    {}
 func (*SnapshotKVItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{7}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{8}
 }
 func (m *SnapshotKVItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +656,7 @@ func (m *SnapshotSchema) Reset()         { *m = SnapshotSchema{} }
 func (m *SnapshotSchema) String() string { return proto.CompactTextString(m) }
 func (*SnapshotSchema) ProtoMessage()    {}
 func (*SnapshotSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{8}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{9}
 }
 func (m *SnapshotSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,6 +695,7 @@ func (m *SnapshotSchema) GetKeys() [][]byte {
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.base.snapshots.v1beta1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.base.snapshots.v1beta1.Metadata")
+	proto.RegisterType((*SnapshotStream)(nil), "cosmos.base.snapshots.v1beta1.SnapshotStream")
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem")
@@ -637,44 +710,47 @@ func init() {
 }
 
 var fileDescriptor_dd7a3c9b0a19e1ee = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xf6, 0x3a, 0x4e, 0x48, 0xd7, 0x06, 0xb5, 0xab, 0x82, 0x2c, 0x24, 0xdc, 0xe0, 0x4b, 0x7d,
-	0x68, 0x6d, 0x1a, 0x2a, 0x21, 0x21, 0x2e, 0x04, 0x81, 0x1c, 0x05, 0x04, 0xda, 0xa0, 0x1c, 0xb8,
-	0x54, 0x9b, 0x64, 0x1b, 0x47, 0x8e, 0xb3, 0x51, 0x76, 0x63, 0x91, 0x27, 0xe0, 0xca, 0xab, 0xf0,
-	0x16, 0x3d, 0xf6, 0xc8, 0xa9, 0x42, 0xc9, 0x8b, 0xa0, 0x5d, 0xff, 0xb4, 0x94, 0x16, 0xd2, 0x53,
-	0x66, 0x26, 0xdf, 0xf7, 0x79, 0x76, 0xbe, 0x9d, 0x85, 0x07, 0x03, 0xc6, 0x13, 0xc6, 0x83, 0x3e,
-	0xe1, 0x34, 0xe0, 0x53, 0x32, 0xe3, 0x11, 0x13, 0x3c, 0x48, 0x8f, 0xfa, 0x54, 0x90, 0xa3, 0xb2,
-	0xe2, 0xcf, 0xe6, 0x4c, 0x30, 0xf4, 0x24, 0x43, 0xfb, 0x12, 0xed, 0x97, 0x68, 0x3f, 0x47, 0x3f,
-	0xde, 0x1d, 0xb1, 0x11, 0x53, 0xc8, 0x40, 0x46, 0x19, 0xc9, 0xfd, 0x01, 0x60, 0xbd, 0x9b, 0x63,
-	0xd1, 0x23, 0x58, 0x8b, 0xe8, 0x78, 0x14, 0x09, 0x1b, 0x34, 0x80, 0x67, 0xe0, 0x3c, 0x93, 0xf5,
-	0x53, 0x36, 0x4f, 0x88, 0xb0, 0xf5, 0x06, 0xf0, 0xee, 0xe3, 0x3c, 0x93, 0xf5, 0x41, 0xb4, 0x98,
-	0xc6, 0xdc, 0xae, 0x64, 0xf5, 0x2c, 0x43, 0x08, 0x1a, 0x11, 0xe1, 0x91, 0x6d, 0x34, 0x80, 0x67,
-	0x61, 0x15, 0xa3, 0x36, 0xac, 0x27, 0x54, 0x90, 0x21, 0x11, 0xc4, 0xae, 0x36, 0x80, 0x67, 0x36,
-	0xf7, 0xfd, 0x7f, 0x36, 0xec, 0x7f, 0xc8, 0xe1, 0x2d, 0xe3, 0xec, 0x62, 0x4f, 0xc3, 0x25, 0xdd,
-	0x3d, 0x84, 0xf5, 0xe2, 0x3f, 0xf4, 0x14, 0x5a, 0xea, 0xa3, 0x27, 0xf2, 0x23, 0x94, 0xdb, 0xa0,
-	0x51, 0xf1, 0x2c, 0x6c, 0xaa, 0x5a, 0xa8, 0x4a, 0xee, 0x37, 0x03, 0x5a, 0xc5, 0x11, 0xdb, 0x82,
-	0x26, 0x28, 0x84, 0x55, 0x2e, 0xd8, 0x9c, 0xaa, 0x53, 0x9a, 0xcd, 0x67, 0xff, 0xe9, 0xa3, 0xe0,
-	0x76, 0x25, 0x47, 0x0a, 0x84, 0x1a, 0xce, 0x04, 0xd0, 0x47, 0x68, 0x8c, 0x49, 0x3a, 0x51, 0x63,
-	0x31, 0x9b, 0xc1, 0x86, 0x42, 0xed, 0xd7, 0xbd, 0xf7, 0x52, 0xa7, 0x55, 0x5f, 0x5d, 0xec, 0x19,
-	0x32, 0x0b, 0x35, 0xac, 0x84, 0xd0, 0x67, 0xb8, 0x45, 0xbf, 0x0a, 0x3a, 0xe5, 0x63, 0x36, 0x55,
-	0x43, 0x35, 0x9b, 0xc7, 0x1b, 0xaa, 0xbe, 0x2d, 0x78, 0x72, 0x36, 0xa1, 0x86, 0x2f, 0x85, 0xd0,
-	0x29, 0xdc, 0x29, 0x93, 0x93, 0x19, 0x59, 0x4e, 0x18, 0x19, 0x2a, 0x73, 0xcc, 0xe6, 0x8b, 0xbb,
-	0xaa, 0x7f, 0xca, 0xe8, 0xa1, 0x86, 0xb7, 0xe9, 0xb5, 0x1a, 0x6a, 0x43, 0x3d, 0x4e, 0x73, 0x77,
-	0x0f, 0x37, 0x14, 0xee, 0xf4, 0xca, 0x51, 0xe8, 0x9d, 0x9e, 0x0d, 0x42, 0x0d, 0xeb, 0x71, 0x8a,
-	0x3a, 0xb0, 0xc6, 0x07, 0x11, 0x4d, 0x88, 0x5d, 0xbb, 0x93, 0x5c, 0x57, 0x91, 0x5a, 0xba, 0x12,
-	0xca, 0x25, 0x5a, 0x35, 0x68, 0x8c, 0x05, 0x4d, 0xdc, 0x7d, 0xb8, 0xf3, 0x97, 0x99, 0xf2, 0xb2,
-	0x4e, 0x49, 0x92, 0x5d, 0x86, 0x2d, 0xac, 0x62, 0x77, 0x02, 0xb7, 0xaf, 0x9b, 0x85, 0xb6, 0x61,
-	0x25, 0xa6, 0x4b, 0x05, 0xb3, 0xb0, 0x0c, 0xd1, 0x2e, 0xac, 0xa6, 0x64, 0xb2, 0xa0, 0xca, 0x7e,
-	0x0b, 0x67, 0x09, 0xb2, 0xe1, 0xbd, 0x94, 0xce, 0x4b, 0x03, 0x2b, 0xb8, 0x48, 0xaf, 0xac, 0x97,
-	0x9c, 0x7d, 0xb5, 0x58, 0x2f, 0xf7, 0x0d, 0x7c, 0x78, 0xa3, 0x89, 0x37, 0xb5, 0x76, 0xdb, 0x2e,
-	0xba, 0xc7, 0xd0, 0xbe, 0xcd, 0x2b, 0xd9, 0x52, 0xe1, 0x7a, 0xd6, 0x7e, 0x91, 0xba, 0xaf, 0xe0,
-	0x83, 0x3f, 0x8d, 0xd8, 0xf4, 0x98, 0x2f, 0x75, 0x1b, 0xb8, 0xde, 0x25, 0x3b, 0x9b, 0xbb, 0xec,
-	0x38, 0xa6, 0xcb, 0x62, 0x0d, 0x55, 0x2c, 0x91, 0xad, 0x77, 0x67, 0x2b, 0x07, 0x9c, 0xaf, 0x1c,
-	0xf0, 0x6b, 0xe5, 0x80, 0xef, 0x6b, 0x47, 0x3b, 0x5f, 0x3b, 0xda, 0xcf, 0xb5, 0xa3, 0x7d, 0x39,
-	0x18, 0x8d, 0x45, 0xb4, 0xe8, 0xfb, 0x03, 0x96, 0x04, 0xf9, 0x73, 0x97, 0xfd, 0x1c, 0xf2, 0x61,
-	0x7c, 0xe5, 0xd1, 0x13, 0xcb, 0x19, 0xe5, 0xfd, 0x9a, 0x7a, 0xb5, 0x9e, 0xff, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xe5, 0xb5, 0xd9, 0x60, 0x1a, 0x05, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xf6, 0x3a, 0x4e, 0x9a, 0x8e, 0xf3, 0xff, 0x6a, 0x57, 0x05, 0xad, 0x90, 0x48, 0x83, 0x2f,
+	0xf5, 0xa1, 0x75, 0x68, 0xa8, 0x84, 0x84, 0xb8, 0x10, 0x04, 0x38, 0x2a, 0x88, 0x6a, 0x8b, 0x7a,
+	0xe0, 0x52, 0x6d, 0xd2, 0x6d, 0x1c, 0x25, 0xce, 0x46, 0xd9, 0xad, 0x45, 0x9e, 0x80, 0x2b, 0xaf,
+	0xc2, 0x5b, 0xf4, 0xd8, 0x23, 0xa7, 0x0a, 0xa5, 0x2f, 0x82, 0x76, 0x6d, 0x27, 0xa1, 0xa4, 0x90,
+	0x9e, 0x3c, 0x33, 0xfe, 0xe6, 0xdb, 0xd9, 0xf9, 0x76, 0x06, 0x76, 0x3b, 0x42, 0xc6, 0x42, 0xd6,
+	0xdb, 0x4c, 0xf2, 0xba, 0x1c, 0xb2, 0x91, 0x8c, 0x84, 0x92, 0xf5, 0x64, 0xbf, 0xcd, 0x15, 0xdb,
+	0x9f, 0x45, 0x82, 0xd1, 0x58, 0x28, 0x81, 0x1f, 0xa7, 0xe8, 0x40, 0xa3, 0x83, 0x19, 0x3a, 0xc8,
+	0xd0, 0x8f, 0xb6, 0xba, 0xa2, 0x2b, 0x0c, 0xb2, 0xae, 0xad, 0x34, 0xc9, 0xfb, 0x8e, 0xa0, 0x7c,
+	0x9c, 0x61, 0xf1, 0x43, 0x28, 0x45, 0xbc, 0xd7, 0x8d, 0x14, 0x41, 0x35, 0xe4, 0x3b, 0x34, 0xf3,
+	0x74, 0xfc, 0x5c, 0x8c, 0x63, 0xa6, 0x88, 0x5d, 0x43, 0xfe, 0x7f, 0x34, 0xf3, 0x74, 0xbc, 0x13,
+	0x5d, 0x0c, 0xfb, 0x92, 0x14, 0xd2, 0x78, 0xea, 0x61, 0x0c, 0x4e, 0xc4, 0x64, 0x44, 0x9c, 0x1a,
+	0xf2, 0x2b, 0xd4, 0xd8, 0xb8, 0x05, 0xe5, 0x98, 0x2b, 0x76, 0xc6, 0x14, 0x23, 0xc5, 0x1a, 0xf2,
+	0xdd, 0xc6, 0x4e, 0xf0, 0xd7, 0x82, 0x83, 0x0f, 0x19, 0xbc, 0xe9, 0x5c, 0x5e, 0x6f, 0x5b, 0x74,
+	0x96, 0xee, 0x25, 0x50, 0xce, 0xff, 0xe1, 0x27, 0x50, 0x31, 0x87, 0x9e, 0xea, 0x43, 0xb8, 0x24,
+	0xa8, 0x56, 0xf0, 0x2b, 0xd4, 0x35, 0xb1, 0xd0, 0x84, 0xf0, 0x3b, 0x58, 0x93, 0x6a, 0xcc, 0x59,
+	0x2c, 0x89, 0x5d, 0x2b, 0xf8, 0x6e, 0x63, 0xef, 0x1f, 0x07, 0xe7, 0xfd, 0x38, 0x36, 0x59, 0x34,
+	0xcf, 0xf6, 0x8e, 0xe0, 0xff, 0xdf, 0x7f, 0xe9, 0x8b, 0x0e, 0x59, 0xcc, 0x4d, 0xbb, 0xd6, 0xa9,
+	0xb1, 0x17, 0x9a, 0x62, 0x2f, 0x6d, 0x4a, 0x61, 0xde, 0x14, 0xef, 0xab, 0x03, 0x95, 0x9c, 0xb2,
+	0xa5, 0x78, 0x8c, 0x43, 0x28, 0x4a, 0x25, 0xc6, 0x29, 0xa3, 0xdb, 0x78, 0xba, 0x72, 0xa5, 0x62,
+	0xcc, 0x35, 0x41, 0x68, 0xd1, 0x94, 0x00, 0x7f, 0x04, 0xa7, 0xc7, 0x92, 0x81, 0x29, 0xc2, 0x6d,
+	0xd4, 0x57, 0x24, 0x6a, 0xbd, 0x3a, 0x79, 0xaf, 0x79, 0x9a, 0xe5, 0xe9, 0xf5, 0xb6, 0xa3, 0xbd,
+	0xd0, 0xa2, 0x86, 0x08, 0x7f, 0x82, 0x75, 0xfe, 0x45, 0xf1, 0xa1, 0xec, 0x89, 0xa1, 0xb9, 0x84,
+	0xdb, 0x38, 0x58, 0x91, 0xf5, 0x4d, 0x9e, 0xa7, 0x65, 0x0b, 0x2d, 0x3a, 0x27, 0xc2, 0xe7, 0xb0,
+	0x39, 0x73, 0x4e, 0x47, 0x6c, 0x32, 0x10, 0xec, 0xcc, 0xbc, 0x1b, 0xb7, 0xf1, 0xfc, 0xbe, 0xec,
+	0x47, 0x69, 0x7a, 0x68, 0xd1, 0x0d, 0x7e, 0x2b, 0x86, 0x5b, 0x60, 0xf7, 0x93, 0xec, 0xe1, 0xad,
+	0xaa, 0xff, 0xe1, 0xc9, 0xac, 0x15, 0xf6, 0xe1, 0x09, 0x41, 0xa1, 0x45, 0xed, 0x7e, 0x82, 0x0f,
+	0xa1, 0x24, 0x3b, 0x11, 0x8f, 0x19, 0x29, 0xdd, 0x8b, 0xee, 0xd8, 0x24, 0x35, 0x6d, 0x43, 0x94,
+	0x51, 0x34, 0x4b, 0xe0, 0xf4, 0x14, 0x8f, 0xbd, 0x1d, 0xd8, 0xfc, 0x43, 0xcc, 0x65, 0xcf, 0xcb,
+	0x1b, 0xc0, 0xc6, 0x6d, 0xb1, 0xf0, 0x06, 0x14, 0xfa, 0x7c, 0x62, 0x60, 0x15, 0xaa, 0x4d, 0xbc,
+	0x05, 0xc5, 0x84, 0x0d, 0x2e, 0xb8, 0x91, 0xbf, 0x42, 0x53, 0x07, 0x13, 0x58, 0x4b, 0xf8, 0x78,
+	0x26, 0x60, 0x81, 0xe6, 0xee, 0xc2, 0xe4, 0xeb, 0xde, 0x17, 0xf3, 0xc9, 0xf7, 0x5e, 0xc3, 0x83,
+	0xa5, 0x22, 0xde, 0xf5, 0xf2, 0x97, 0xad, 0x09, 0xef, 0x00, 0xc8, 0x5d, 0x5a, 0xe9, 0x92, 0x72,
+	0xd5, 0xd3, 0xf2, 0x73, 0xd7, 0x7b, 0x39, 0x9f, 0xb6, 0x54, 0x88, 0x55, 0xaf, 0xf9, 0xc2, 0x26,
+	0xc8, 0xf3, 0x17, 0x66, 0xd5, 0x74, 0x5a, 0x57, 0xdc, 0xe7, 0x93, 0x7c, 0x43, 0x18, 0x5b, 0x23,
+	0x9b, 0x6f, 0x2f, 0xa7, 0x55, 0x74, 0x35, 0xad, 0xa2, 0x9f, 0xd3, 0x2a, 0xfa, 0x76, 0x53, 0xb5,
+	0xae, 0x6e, 0xaa, 0xd6, 0x8f, 0x9b, 0xaa, 0xf5, 0x79, 0xb7, 0xdb, 0x53, 0xd1, 0x45, 0x3b, 0xe8,
+	0x88, 0xb8, 0x9e, 0x6d, 0xe2, 0xf4, 0xb3, 0x27, 0xcf, 0xfa, 0x0b, 0xfb, 0x58, 0x4d, 0x46, 0x5c,
+	0xb6, 0x4b, 0x66, 0xa1, 0x3e, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x35, 0x81, 0x8e, 0xb5,
+	0x05, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -752,6 +828,20 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -764,6 +854,48 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Chunks != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1180,6 +1312,32 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SnapshotStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovSnapshot(uint64(m.Chunks))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
 	return n
 }
 
@@ -1605,6 +1763,175 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, &SnapshotStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
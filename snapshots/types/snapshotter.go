@@ -26,6 +26,26 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// ParallelSnapshotter is a Snapshotter which can also export and restore its stores independently, for the
+// snapshots of the ParallelFormat.
+type ParallelSnapshotter interface {
+	Snapshotter
+
+	// SnapshotStreams returns the names of the streams the snapshot of the height is made of, one per store.
+	SnapshotStreams(height uint64) ([]string, error)
+
+	// SnapshotStream writes the snapshot items of a stream into the protobuf writer. It is called
+	// concurrently for the different streams of the height.
+	SnapshotStream(height uint64, name string, protoWriter protoio.Writer) error
+
+	// RestoreStream restores a stream, taking the reader of its protobuf message stream as input. It is
+	// called concurrently for the different streams of the height.
+	RestoreStream(height uint64, name string, protoReader protoio.Reader) error
+
+	// CommitRestore commits the height once all its streams are restored.
+	CommitRestore(height uint64) error
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -31,6 +31,9 @@ type Manager struct {
 	// These are the heights that are multiples of snapshotInterval and kept for state sync snapshots.
 	// The heights are added to this list to be pruned when a snapshot is complete.
 	pruneSnapshotHeights *list.List
+	// These are the heights pinned by retention constraints, the heights to prune among them are kept in
+	// pruneHeights until they aren't pinned anymore. They are guarded by pruneHeightsMx.
+	pinnedHeights []types.PinnedHeights
 }
 
 // NegativeHeightsError is returned when a negative height is provided to the manager.
@@ -87,14 +90,52 @@ func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
 		return nil, err
 	}
 
-	// Return a copy to prevent data races.
-	pruningHeights := make([]int64, len(m.pruneHeights))
-	copy(pruningHeights, m.pruneHeights)
-	m.pruneHeights = m.pruneHeights[:0]
+	// Return a copy to prevent data races, the pinned heights are kept to be pruned once they are released.
+	pruningHeights := make([]int64, 0, len(m.pruneHeights))
+	pinned := []int64{}
+	for _, height := range m.pruneHeights {
+		if m.isPinned(height) {
+			pinned = append(pinned, height)
+		} else {
+			pruningHeights = append(pruningHeights, height)
+		}
+	}
+	if len(pinned) > 0 {
+		m.logger.Debug("keeping pinned heights from pruning", "heights", pinned)
+	}
+	m.pruneHeights = pinned
 
 	return pruningHeights, nil
 }
 
+// SetPinnedHeights sets the heights pinned by retention constraints, which aren't pruned until they are set
+// again without them.
+func (m *Manager) SetPinnedHeights(pins []types.PinnedHeights) {
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	m.pinnedHeights = append([]types.PinnedHeights{}, pins...)
+	types.SortPinnedHeights(m.pinnedHeights)
+}
+
+// GetPinnedHeights returns the heights pinned by retention constraints, sorted by their start.
+func (m *Manager) GetPinnedHeights() []types.PinnedHeights {
+	m.pruneHeightsMx.Lock()
+	defer m.pruneHeightsMx.Unlock()
+
+	return append([]types.PinnedHeights{}, m.pinnedHeights...)
+}
+
+// isPinned returns whether the height is pinned, pruneHeightsMx must be held.
+func (m *Manager) isPinned(height int64) bool {
+	for _, pin := range m.pinnedHeights {
+		if pin.Contains(height) {
+			return true
+		}
+	}
+	return false
+}
+
 // HandleHeight determines if previousHeight height needs to be kept for pruning at the right interval prescribed by
 // the pruning strategy. Returns previousHeight, if it was kept to be pruned at the next call to Prune(), 0 otherwise.
 // previousHeight must be greater than 0 for the handling to take effect since valid heights start at 1 and 0 represents
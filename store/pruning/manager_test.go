@@ -530,3 +530,40 @@ func TestGetFlushAndResetPruningHeights_DbErr_Panic(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, heights)
 }
+
+func TestPinnedHeights(t *testing.T) {
+	manager := pruning.NewManager(db.NewMemDB(), log.NewNopLogger())
+	manager.SetOptions(types.NewCustomPruningOptions(2, 1))
+
+	pins := []types.PinnedHeights{
+		{Start: 5, End: 5, Reason: "second"},
+		{Start: 2, End: 3, Reason: "first"},
+	}
+	manager.SetPinnedHeights(pins)
+	require.Equal(t, []types.PinnedHeights{pins[1], pins[0]}, manager.GetPinnedHeights())
+
+	for height := int64(3); height <= 8; height++ {
+		manager.HandleHeight(height)
+	}
+
+	// the pinned heights are kept to be pruned later
+	heights, err := manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 4, 6}, heights)
+	heights, err = manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Empty(t, heights)
+
+	// once released, they are pruned with the next heights
+	manager.SetPinnedHeights([]types.PinnedHeights{pins[0]})
+	manager.HandleHeight(9)
+	heights, err = manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3, 7}, heights)
+
+	manager.SetPinnedHeights(nil)
+	require.Empty(t, manager.GetPinnedHeights())
+	heights, err = manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Equal(t, []int64{5}, heights)
+}
@@ -0,0 +1,26 @@
+package types
+
+import "sort"
+
+// PinnedHeights is a range of heights, from Start to End included, whose state is kept from pruning for the
+// reason given by the retention constraint which pinned it.
+type PinnedHeights struct {
+	Start  int64  `json:"start"`
+	End    int64  `json:"end"`
+	Reason string `json:"reason"`
+}
+
+// Contains returns whether the height is pinned by the range.
+func (p PinnedHeights) Contains(height int64) bool {
+	return p.Start <= height && height <= p.End
+}
+
+// SortPinnedHeights sorts pinned heights by their start, then by their end.
+func SortPinnedHeights(pins []PinnedHeights) {
+	sort.SliceStable(pins, func(i, j int) bool {
+		if pins[i].Start != pins[j].Start {
+			return pins[i].Start < pins[j].Start
+		}
+		return pins[i].End < pins[j].End
+	})
+}
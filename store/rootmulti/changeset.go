@@ -0,0 +1,254 @@
+package rootmulti
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	changeSetKeyFmt = "s/changeset/%d" // s/changeset/<version>
+
+	// changeSetKeepRecent is the number of versions whose change set commitments are kept.
+	changeSetKeepRecent = 100000
+)
+
+// ChangeSetCommitment is the commitment to the writes of a block into the persisted stores. It doesn't depend
+// on the store types, so a fast node running plain DB stores and a full node running IAVL stores commit to the
+// same change sets, and a node diverging from the chain is caught at the first block it writes differently.
+type ChangeSetCommitment struct {
+	Version int64 `json:"version"`
+	// StartVersion is the version the chain of hashes started at, the chained hashes of two nodes can only be
+	// compared if they started at the same version.
+	StartVersion int64 `json:"start_version"`
+	// BlockHash is the hash of the change sets of the block into all the stores.
+	BlockHash []byte `json:"block_hash"`
+	// Hash chains the block hashes since the start version.
+	Hash []byte `json:"hash"`
+	// Stores are the commitments of the change sets of the block into each store, sorted by name.
+	Stores []StoreChangeSetCommitment `json:"stores"`
+}
+
+// StoreChangeSetCommitment is the commitment to the writes of a block into a store.
+type StoreChangeSetCommitment struct {
+	Name      string `json:"name"`
+	BlockHash []byte `json:"block_hash"`
+	Hash      []byte `json:"hash"`
+}
+
+// changeSetWrite is the last write of a block to a key.
+type changeSetWrite struct {
+	value  []byte
+	delete bool
+}
+
+// changeSetHasher listens to the writes into the persisted stores and commits to them at every version.
+type changeSetHasher struct {
+	mtx sync.Mutex
+
+	listening map[types.StoreKey]bool
+	writes    map[string]map[string]changeSetWrite
+	last      ChangeSetCommitment
+}
+
+var _ types.WriteListener = (*changeSetHasher)(nil)
+
+func newChangeSetHasher() *changeSetHasher {
+	return &changeSetHasher{
+		listening: make(map[types.StoreKey]bool),
+		writes:    make(map[string]map[string]changeSetWrite),
+	}
+}
+
+// OnWrite implements WriteListener.
+func (h *changeSetHasher) OnWrite(storeKey types.StoreKey, key, value []byte, delete bool) error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	writes, ok := h.writes[storeKey.Name()]
+	if !ok {
+		writes = make(map[string]changeSetWrite)
+		h.writes[storeKey.Name()] = writes
+	}
+	writes[string(key)] = changeSetWrite{value: bytes.Clone(value), delete: delete}
+	return nil
+}
+
+// SetChangeSetCommitment enables or disables the commitment to the change sets of the persisted stores, see
+// ChangeSetCommitment. The commitment of a version replaces the fixed commit id of the plain DB stores in
+// its commit info. It must be called before the stores are loaded.
+func (rs *Store) SetChangeSetCommitment(enabled bool) {
+	if !enabled {
+		rs.changeSets = nil
+		return
+	}
+	rs.changeSets = newChangeSetHasher()
+}
+
+// ChangeSetCommitment returns the change set commitment of a version.
+func (rs *Store) ChangeSetCommitment(version int64) (*ChangeSetCommitment, error) {
+	if rs.changeSets == nil {
+		return nil, errors.New("change set commitment is disabled")
+	}
+	return loadChangeSetCommitment(rs.db, version)
+}
+
+// loadChangeSets resumes the chain of the change set commitments at the version and listens to the writes of
+// the persisted stores.
+func (rs *Store) loadChangeSets(version int64) error {
+	h := rs.changeSets
+	if h == nil {
+		return nil
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.writes = make(map[string]map[string]changeSetWrite)
+	h.last = ChangeSetCommitment{Version: version, StartVersion: version + 1}
+	if version > 0 {
+		last, err := loadChangeSetCommitment(rs.db, version)
+		switch {
+		case err == nil:
+			h.last = *last
+		case errors.Is(err, errChangeSetNotFound):
+			rs.logger.Info("Starting the change set commitments", "version", version+1)
+		default:
+			return err
+		}
+	}
+
+	for key, store := range rs.stores {
+		if h.listening[key] {
+			continue
+		}
+		if typ := store.GetStoreType(); typ == types.StoreTypeIAVL || typ == types.StoreTypeDB || typ == types.StoreTypeSMT {
+			h.listening[key] = true
+			rs.AddListeners(key, []types.WriteListener{h})
+		}
+	}
+	return nil
+}
+
+// commitChangeSets commits to the writes of the version, saves the commitment and sets the commit ids of
+// the plain DB stores in the commit info.
+func (rs *Store) commitChangeSets(version int64, cInfo *types.CommitInfo) error {
+	h := rs.changeSets
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	prevHashes := make(map[string][]byte, len(h.last.Stores))
+	for _, store := range h.last.Stores {
+		prevHashes[store.Name] = store.Hash
+	}
+	if h.last.Version != version-1 {
+		// the versions in between were committed without the commitment, restart the chain
+		h.last = ChangeSetCommitment{StartVersion: version}
+		prevHashes = map[string][]byte{}
+	}
+
+	commitment := ChangeSetCommitment{Version: version, StartVersion: h.last.StartVersion}
+	blockHasher := sha256.New()
+	for _, info := range cInfo.StoreInfos {
+		blockHash := hashChangeSet(h.writes[info.Name])
+		commitment.Stores = append(commitment.Stores, StoreChangeSetCommitment{
+			Name:      info.Name,
+			BlockHash: blockHash,
+			Hash:      chainChangeSetHash(prevHashes[info.Name], version, blockHash),
+		})
+		writeLengthPrefixed(blockHasher, []byte(info.Name))
+		blockHasher.Write(blockHash)
+	}
+	commitment.BlockHash = blockHasher.Sum(nil)
+	commitment.Hash = chainChangeSetHash(h.last.Hash, version, commitment.BlockHash)
+
+	for i, info := range cInfo.StoreInfos {
+		if store, ok := rs.stores[rs.keysByName[info.Name]]; ok && store.GetStoreType() == types.StoreTypeDB {
+			cInfo.StoreInfos[i].CommitId = types.CommitID{Version: version, Hash: commitment.Stores[i].Hash}
+		}
+	}
+
+	bz, err := json.Marshal(commitment)
+	if err != nil {
+		return err
+	}
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set([]byte(fmt.Sprintf(changeSetKeyFmt, version)), bz); err != nil {
+		return err
+	}
+	if version > changeSetKeepRecent {
+		if err := batch.Delete([]byte(fmt.Sprintf(changeSetKeyFmt, version-changeSetKeepRecent))); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	h.last = commitment
+	h.writes = make(map[string]map[string]changeSetWrite)
+	return nil
+}
+
+var errChangeSetNotFound = errors.New("change set commitment not found")
+
+func loadChangeSetCommitment(db dbm.DB, version int64) (*ChangeSetCommitment, error) {
+	bz, err := db.Get([]byte(fmt.Sprintf(changeSetKeyFmt, version)))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, errors.Wrapf(errChangeSetNotFound, "version %d", version)
+	}
+
+	commitment := &ChangeSetCommitment{}
+	if err := json.Unmarshal(bz, commitment); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal change set commitment")
+	}
+	return commitment, nil
+}
+
+// hashChangeSet hashes the writes sorted by key.
+func hashChangeSet(writes map[string]changeSetWrite) []byte {
+	keys := make([]string, 0, len(writes))
+	for key := range writes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hasher := sha256.New()
+	for _, key := range keys {
+		write := writes[key]
+		writeLengthPrefixed(hasher, []byte(key))
+		if write.delete {
+			hasher.Write([]byte{1})
+		} else {
+			hasher.Write([]byte{0})
+			writeLengthPrefixed(hasher, write.value)
+		}
+	}
+	return hasher.Sum(nil)
+}
+
+func chainChangeSetHash(prev []byte, version int64, blockHash []byte) []byte {
+	hasher := sha256.New()
+	hasher.Write(prev)
+	_ = binary.Write(hasher, binary.BigEndian, version)
+	hasher.Write(blockHash)
+	return hasher.Sum(nil)
+}
+
+func writeLengthPrefixed(w interface{ Write([]byte) (int, error) }, bz []byte) {
+	_, _ = w.Write(binary.AppendUvarint(nil, uint64(len(bz))))
+	_, _ = w.Write(bz)
+}
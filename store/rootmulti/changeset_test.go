@@ -0,0 +1,74 @@
+package rootmulti
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newChangeSetTestStore(t *testing.T, db dbm.DB, typ types.StoreType) *Store {
+	t.Helper()
+
+	store := NewStore(db, log.NewNopLogger())
+	store.SetChangeSetCommitment(true)
+	store.MountStoreWithDB(testStoreKey1, typ, nil)
+	store.MountStoreWithDB(testStoreKey2, typ, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	return store
+}
+
+func writeChangeSetTestBlock(store *Store, value string) {
+	branch := store.CacheMultiStore()
+	branch.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("overwritten"))
+	branch.GetKVStore(testStoreKey1).Set([]byte("a"), []byte(value))
+	branch.GetKVStore(testStoreKey1).Delete([]byte("b"))
+	branch.Write()
+	store.Commit()
+}
+
+func TestChangeSetCommitment(t *testing.T) {
+	fullDb, fastDb := dbm.NewMemDB(), dbm.NewMemDB()
+	full := newChangeSetTestStore(t, fullDb, types.StoreTypeIAVL)
+	fast := newChangeSetTestStore(t, fastDb, types.StoreTypeDB)
+
+	// the same writes give the same commitments whatever the store types
+	writeChangeSetTestBlock(full, "1")
+	writeChangeSetTestBlock(fast, "1")
+	fullCommitment, err := full.ChangeSetCommitment(1)
+	require.NoError(t, err)
+	fastCommitment, err := fast.ChangeSetCommitment(1)
+	require.NoError(t, err)
+	require.Equal(t, fullCommitment, fastCommitment)
+	require.Equal(t, int64(1), fastCommitment.StartVersion)
+	require.Len(t, fastCommitment.Stores, 2)
+	require.Equal(t, hashChangeSet(nil), fastCommitment.Stores[1].BlockHash)
+
+	// the plain DB stores commit to their change sets
+	cInfo, err := fast.GetCommitInfo(1)
+	require.NoError(t, err)
+	require.Equal(t, types.CommitID{Version: 1, Hash: fastCommitment.Stores[0].Hash}, cInfo.StoreInfos[0].CommitId)
+
+	// the chain resumes after a restart, and diverging writes are caught
+	fast = newChangeSetTestStore(t, fastDb, types.StoreTypeDB)
+	writeChangeSetTestBlock(full, "2")
+	writeChangeSetTestBlock(fast, "3")
+	fullCommitment, err = full.ChangeSetCommitment(2)
+	require.NoError(t, err)
+	fastCommitment, err = fast.ChangeSetCommitment(2)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), fastCommitment.StartVersion)
+	require.NotEqual(t, fullCommitment.BlockHash, fastCommitment.BlockHash)
+	require.NotEqual(t, fullCommitment.Stores[0].BlockHash, fastCommitment.Stores[0].BlockHash)
+	require.Equal(t, fullCommitment.Stores[1], fastCommitment.Stores[1])
+
+	_, err = fast.ChangeSetCommitment(3)
+	require.ErrorIs(t, err, errChangeSetNotFound)
+
+	disabled := NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	_, err = disabled.ChangeSetCommitment(1)
+	require.Error(t, err)
+}
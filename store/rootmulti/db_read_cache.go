@@ -0,0 +1,179 @@
+package rootmulti
+
+import (
+	"sync"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// readCacheDB is a read-through cache over the DB of a plain DB store. The prefetch of a block populates it
+// with the committed values DeliverTx reads next, the writes invalidate their keys and the commit clears it.
+//
+// The prefetch may still be reading while the block is committed, so a value read from the DB is only
+// cached if no write or commit happened during the read. The DB is always written before the cache is
+// invalidated, so a read started after an invalidation sees the new value.
+type readCacheDB struct {
+	dbm.DB
+
+	mtx        sync.RWMutex
+	values     map[string][]byte
+	generation uint64
+}
+
+var _ dbm.DB = (*readCacheDB)(nil)
+
+func newReadCacheDB(db dbm.DB) *readCacheDB {
+	return &readCacheDB{DB: db, values: make(map[string][]byte)}
+}
+
+// Get implements DB.
+func (db *readCacheDB) Get(key []byte) ([]byte, error) {
+	db.mtx.RLock()
+	value, ok := db.values[string(key)]
+	generation := db.generation
+	db.mtx.RUnlock()
+	if ok {
+		telemetry.IncrCounter(1, "store", "db_read_cache", "hit")
+		return value, nil
+	}
+	telemetry.IncrCounter(1, "store", "db_read_cache", "miss")
+
+	value, err := db.DB.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	db.mtx.Lock()
+	if db.generation == generation {
+		db.values[string(key)] = value
+	}
+	db.mtx.Unlock()
+
+	return value, nil
+}
+
+// Has implements DB.
+func (db *readCacheDB) Has(key []byte) (bool, error) {
+	db.mtx.RLock()
+	value, ok := db.values[string(key)]
+	db.mtx.RUnlock()
+	if ok {
+		return value != nil, nil
+	}
+
+	return db.DB.Has(key)
+}
+
+// Set implements DB.
+func (db *readCacheDB) Set(key, value []byte) error {
+	defer db.invalidate(key)
+	return db.DB.Set(key, value)
+}
+
+// SetSync implements DB.
+func (db *readCacheDB) SetSync(key, value []byte) error {
+	defer db.invalidate(key)
+	return db.DB.SetSync(key, value)
+}
+
+// Delete implements DB.
+func (db *readCacheDB) Delete(key []byte) error {
+	defer db.invalidate(key)
+	return db.DB.Delete(key)
+}
+
+// DeleteSync implements DB.
+func (db *readCacheDB) DeleteSync(key []byte) error {
+	defer db.invalidate(key)
+	return db.DB.DeleteSync(key)
+}
+
+// NewBatch implements DB.
+func (db *readCacheDB) NewBatch() dbm.Batch {
+	return &readCacheBatch{Batch: db.DB.NewBatch(), db: db}
+}
+
+func (db *readCacheDB) invalidate(keys ...[]byte) {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	db.generation++
+	for _, key := range keys {
+		delete(db.values, string(key))
+	}
+}
+
+// clear drops all the cached values, it is called when the store is committed.
+func (db *readCacheDB) clear() {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	db.generation++
+	db.values = make(map[string][]byte)
+}
+
+// readCacheBatch invalidates the keys it writes in the cache of its DB once it is written.
+type readCacheBatch struct {
+	dbm.Batch
+
+	db   *readCacheDB
+	keys [][]byte
+}
+
+// Set implements Batch.
+func (b *readCacheBatch) Set(key, value []byte) error {
+	b.keys = append(b.keys, key)
+	return b.Batch.Set(key, value)
+}
+
+// Delete implements Batch.
+func (b *readCacheBatch) Delete(key []byte) error {
+	b.keys = append(b.keys, key)
+	return b.Batch.Delete(key)
+}
+
+// Write implements Batch.
+func (b *readCacheBatch) Write() error {
+	defer b.db.invalidate(b.keys...)
+	return b.Batch.Write()
+}
+
+// WriteSync implements Batch.
+func (b *readCacheBatch) WriteSync() error {
+	defer b.db.invalidate(b.keys...)
+	return b.Batch.WriteSync()
+}
+
+// prefetchDB is the view of a readCacheDB used by the prefetch of a block, which populates the cache and
+// drops the writes, as the prefetched txs must not modify the state.
+type prefetchDB struct {
+	*readCacheDB
+}
+
+// Set implements DB.
+func (prefetchDB) Set(_, _ []byte) error { return nil }
+
+// SetSync implements DB.
+func (prefetchDB) SetSync(_, _ []byte) error { return nil }
+
+// Delete implements DB.
+func (prefetchDB) Delete(_ []byte) error { return nil }
+
+// DeleteSync implements DB.
+func (prefetchDB) DeleteSync(_ []byte) error { return nil }
+
+// NewBatch implements DB.
+func (db prefetchDB) NewBatch() dbm.Batch {
+	return prefetchBatch{}
+}
+
+// prefetchBatch is a batch dropping the writes of the prefetch.
+type prefetchBatch struct{}
+
+func (prefetchBatch) Set(_, _ []byte) error { return nil }
+func (prefetchBatch) Delete(_ []byte) error { return nil }
+func (prefetchBatch) Write() error          { return nil }
+func (prefetchBatch) WriteSync() error      { return nil }
+func (prefetchBatch) Close() error          { return nil }
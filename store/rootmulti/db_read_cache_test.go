@@ -0,0 +1,103 @@
+package rootmulti
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestReadCacheDB(t *testing.T) {
+	parent := dbm.NewMemDB()
+	require.NoError(t, parent.Set([]byte("a"), []byte("1")))
+	db := newReadCacheDB(parent)
+
+	// the reads populate the cache, including the missing keys
+	value, err := db.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+	value, err = db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+	require.Len(t, db.values, 2)
+	has, err := db.Has([]byte("b"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the writes reach the DB and invalidate their keys
+	require.NoError(t, db.Set([]byte("b"), []byte("2")))
+	require.NotContains(t, db.values, "b")
+	value, err = db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value)
+	require.NoError(t, db.Delete([]byte("a")))
+	has, err = db.Has([]byte("a"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("b"), []byte("3")))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+	value, err = db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), value)
+
+	db.clear()
+	require.Empty(t, db.values)
+}
+
+func TestPrefetchDB(t *testing.T) {
+	parent := dbm.NewMemDB()
+	require.NoError(t, parent.Set([]byte("a"), []byte("1")))
+	db := newReadCacheDB(parent)
+	prefetch := prefetchDB{readCacheDB: db}
+
+	value, err := prefetch.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+	require.Contains(t, db.values, "a")
+
+	// the writes of the prefetch are dropped
+	require.NoError(t, prefetch.Set([]byte("a"), []byte("2")))
+	require.NoError(t, prefetch.Delete([]byte("a")))
+	batch := prefetch.NewBatch()
+	require.NoError(t, batch.Set([]byte("b"), []byte("2")))
+	require.NoError(t, batch.Write())
+	value, err = parent.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+	has, err := parent.Has([]byte("b"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestDeepCopyAndCacheDBStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := types.NewKVStoreKey("plain")
+	store := NewStore(db, log.NewNopLogger())
+	store.MountStoreWithDB(key, types.StoreTypeDB, dbm.NewPrefixDB(db, []byte("plain/")))
+	require.NoError(t, store.LoadLatestVersion())
+
+	store.GetKVStore(key).Set([]byte("k"), []byte("v1"))
+	store.Commit()
+
+	// the prefetch branch populates the read cache of the store but doesn't write to it
+	prefetch := store.DeepCopyAndCache()
+	require.Equal(t, []byte("v1"), prefetch.GetKVStore(key).Get([]byte("k")))
+	prefetch.GetKVStore(key).Set([]byte("k"), []byte("v2"))
+	prefetch.Write()
+
+	cached := store.GetCommitKVStore(key).(commitDBStoreAdapter).DB.(*readCacheDB)
+	require.Contains(t, cached.values, "k")
+	require.Equal(t, []byte("v1"), store.GetKVStore(key).Get([]byte("k")))
+
+	// DeliverTx writes through the cache, and the commit clears it
+	store.GetKVStore(key).Set([]byte("k"), []byte("v3"))
+	require.Equal(t, []byte("v3"), store.DeepCopyAndCache().GetKVStore(key).Get([]byte("k")))
+	store.Commit()
+	require.Empty(t, cached.values)
+}
@@ -19,6 +19,10 @@ type commitDBStoreAdapter struct {
 }
 
 func (cdsa commitDBStoreAdapter) Commit() types.CommitID {
+	if db, ok := cdsa.DB.(*readCacheDB); ok {
+		db.clear()
+	}
+
 	return types.CommitID{
 		Version: -1,
 		Hash:    commithash,
@@ -39,3 +43,20 @@ func (cdsa commitDBStoreAdapter) SetPruning(_ pruningtypes.PruningOptions) {}
 func (cdsa commitDBStoreAdapter) GetPruning() pruningtypes.PruningOptions {
 	return pruningtypes.NewPruningOptions(pruningtypes.PruningUndefined)
 }
+
+// prefetchStore returns a view of the store for the prefetch, which populates its read cache and drops the
+// writes.
+func (cdsa commitDBStoreAdapter) prefetchStore() commitDBStoreAdapter {
+	if db, ok := cdsa.DB.(*readCacheDB); ok {
+		return commitDBStoreAdapter{Store: dbadapter.Store{DB: prefetchDB{readCacheDB: db}}}
+	}
+	return commitDBStoreAdapter{Store: dbadapter.Store{DB: prefetchDB{readCacheDB: newReadCacheDB(cdsa.DB)}}}
+}
+
+// uncachedStore returns a view of the store which bypasses its read cache.
+func (cdsa commitDBStoreAdapter) uncachedStore() commitDBStoreAdapter {
+	if db, ok := cdsa.DB.(*readCacheDB); ok {
+		return commitDBStoreAdapter{Store: dbadapter.Store{DB: db.DB}}
+	}
+	return cdsa
+}
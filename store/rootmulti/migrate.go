@@ -0,0 +1,414 @@
+package rootmulti
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// DefaultMigrateBatchSize is the number of keys MigrateStores writes per batch and checkpoint by default.
+	DefaultMigrateBatchSize = 10000
+
+	migrateCheckpointPrefix = "s/migrate/" // s/migrate/<store name>
+)
+
+// MigrateOptions configures the migration of the stores by MigrateStores.
+type MigrateOptions struct {
+	// Stores are the names of the stores to convert, all the persisted stores are converted if it is empty.
+	// The other stores are copied as they are, which gives a hybrid layout of IAVL and plain DB stores.
+	Stores []string
+	// BatchSize is the number of keys written per batch, DefaultMigrateBatchSize if it is not positive.
+	BatchSize int
+	// Progress is called with the state of the migration of a store after every batch. The migration stops
+	// if it returns an error, and resumes from the last checkpoint when MigrateStores is called again.
+	Progress func(StoreMigration) error
+}
+
+// StoreMigration reports the migration of a store by MigrateStores.
+type StoreMigration struct {
+	Name string
+	From types.StoreType
+	// To is the type of the store in the new db, From if the store is copied as it is.
+	To types.StoreType
+	// Keys is the number of keys migrated so far, the raw db entries of the stores which are copied.
+	Keys uint64
+	// SourceKeys and TargetKeys are the numbers of keys of the store in the source and new dbs, counted to
+	// verify the store once it is migrated.
+	SourceKeys uint64
+	TargetKeys uint64
+	// CommitID is the commit id of a store converted to IAVL.
+	CommitID types.CommitID
+	// Resumed is whether the migration of the store resumed from a checkpoint.
+	Resumed bool
+}
+
+// migrateCheckpoint is the progress of the migration of a store, saved in the new db along with the keys.
+type migrateCheckpoint struct {
+	Version int64  `json:"version"`
+	LastKey []byte `json:"last_key,omitempty"`
+	Keys    uint64 `json:"keys"`
+	Done    bool   `json:"done"`
+	Hash    []byte `json:"hash,omitempty"`
+	// TreeVersion is the version of the last batch saved of a store converted to IAVL, whose batches are
+	// saved from InitialVersion with BatchSize keys each.
+	TreeVersion    int64  `json:"tree_version,omitempty"`
+	InitialVersion int64  `json:"initial_version,omitempty"`
+	BatchSize      uint64 `json:"batch_size,omitempty"`
+}
+
+// MigrateStores migrates the stores of the latest version to another type in another db, converting IAVL
+// stores to plain DB stores or the other way around. The stores which are not converted, SMT stores
+// included, are copied as they are, and the commit info of the latest version is written with the commit
+// ids of the stores converted to IAVL. A store converted to IAVL is rebuilt in batches saved at the versions
+// preceding the latest one, so its hash depends on the batch size and is not the one the chain committed
+// unless it fits in a single batch and all its keys were written at the latest version.
+//
+// The progress is checkpointed in the new db after every batch, so an interrupted migration resumes where it
+// stopped when it is called again with the same new db. Every store is verified by counting its keys in
+// both dbs once migrated.
+func (rs *Store) MigrateStores(targetType types.StoreType, newDb dbm.DB, opts MigrateOptions) ([]StoreMigration, error) {
+	if targetType != types.StoreTypeIAVL && targetType != types.StoreTypeDB {
+		return nil, errors.New("only StoreTypeIAVL and StoreTypeDB are supported")
+	}
+	version := rs.LastCommitID().Version
+	if version <= 0 {
+		return nil, errors.New("no committed version to migrate")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultMigrateBatchSize
+	}
+
+	selected := make(map[string]bool, len(opts.Stores))
+	for _, name := range opts.Stores {
+		if _, ok := rs.keysByName[name]; !ok {
+			return nil, fmt.Errorf("unknown store %s", name)
+		}
+		selected[name] = true
+	}
+
+	cInfo, err := rs.GetCommitInfo(version)
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []StoreMigration
+	for _, key := range keysForStoreKeyMap(rs.stores) {
+		store := rs.GetCommitKVStore(key)
+		storeType := store.GetStoreType()
+		if storeType != types.StoreTypeIAVL && storeType != types.StoreTypeDB && storeType != types.StoreTypeSMT {
+			rs.logger.Info("Skipping store which is not persisted", "store name", key.Name(), "type", storeType)
+			continue
+		}
+		if rs.storesParams[key].db != nil {
+			rs.logger.Info("Skipping store which has its own db", "store name", key.Name())
+			continue
+		}
+
+		migration := StoreMigration{Name: key.Name(), From: storeType, To: storeType}
+		// the SMT stores are copied as they are
+		if storeType != types.StoreTypeSMT && storeType != targetType && (len(selected) == 0 || selected[key.Name()]) {
+			migration.To = targetType
+		}
+		rs.logger.Info("Migrating store", "store name", key.Name(), "from", migration.From, "to", migration.To)
+
+		if err := rs.migrateStore(key, store, newDb, version, &migration, opts); err != nil {
+			return migrations, errors.Wrapf(err, "failed to migrate store %s", key.Name())
+		}
+		migrations = append(migrations, migration)
+	}
+
+	batch := newDb.NewBatch()
+	defer batch.Close()
+	for _, migration := range migrations {
+		if migration.From != types.StoreTypeIAVL && migration.To == types.StoreTypeIAVL {
+			for i, info := range cInfo.StoreInfos {
+				if info.Name == migration.Name {
+					cInfo.StoreInfos[i].CommitId = migration.CommitID
+				}
+			}
+		}
+		if err := batch.Delete([]byte(migrateCheckpointPrefix + migration.Name)); err != nil {
+			return migrations, err
+		}
+	}
+	flushCommitInfo(batch, version, cInfo)
+	flushLatestVersion(batch, version)
+
+	return migrations, batch.WriteSync()
+}
+
+// migrateStore migrates a store from its last checkpoint, then verifies it.
+func (rs *Store) migrateStore(key types.StoreKey, store types.CommitKVStore, newDb dbm.DB, version int64, migration *StoreMigration, opts MigrateOptions) error {
+	prefix := []byte("s/k:" + key.Name() + "/")
+	checkpointKey := []byte(migrateCheckpointPrefix + key.Name())
+
+	checkpoint, err := loadMigrateCheckpoint(newDb, checkpointKey)
+	if err != nil {
+		return err
+	}
+	if checkpoint.Version != 0 && checkpoint.Version != version {
+		return fmt.Errorf("the migration was checkpointed at version %d but the store is at version %d", checkpoint.Version, version)
+	}
+	checkpoint.Version = version
+	migration.Resumed = checkpoint.Keys > 0 || checkpoint.Done
+	migration.Keys = checkpoint.Keys
+
+	// the stores which are not converted are copied from the raw entries of the db
+	source := func(start []byte) (types.Iterator, error) {
+		if migration.From == migration.To {
+			return dbm.NewPrefixDB(rs.db, prefix).Iterator(start, nil)
+		}
+		return store.Iterator(start, nil), nil
+	}
+
+	if checkpoint.Done {
+		migration.SourceKeys, migration.TargetKeys = checkpoint.Keys, checkpoint.Keys
+		migration.CommitID = types.CommitID{Version: version, Hash: checkpoint.Hash}
+		return nil
+	}
+
+	if migration.To == types.StoreTypeIAVL && migration.From != types.StoreTypeIAVL {
+		if err := rs.migrateToIAVL(key, newDb, prefix, checkpointKey, &checkpoint, source, migration, opts); err != nil {
+			return err
+		}
+		checkpoint.Hash = migration.CommitID.Hash
+	} else {
+		var start []byte
+		if checkpoint.LastKey != nil {
+			// resume from the key following the last checkpointed one
+			start = append(checkpoint.LastKey, 0)
+		}
+		iterator, err := source(start)
+		if err != nil {
+			return err
+		}
+		defer iterator.Close()
+
+		for iterator.Valid() {
+			batch := newDb.NewBatch()
+			for n := 0; n < opts.BatchSize && iterator.Valid(); n++ {
+				checkpoint.LastKey = bytes.Clone(iterator.Key())
+				if err := batch.Set(append(append([]byte{}, prefix...), iterator.Key()...), iterator.Value()); err != nil {
+					batch.Close()
+					return err
+				}
+				checkpoint.Keys++
+				iterator.Next()
+			}
+			err := writeMigrateCheckpoint(batch, checkpointKey, checkpoint)
+			batch.Close()
+			if err != nil {
+				return err
+			}
+
+			migration.Keys = checkpoint.Keys
+			if opts.Progress != nil {
+				if err := opts.Progress(*migration); err != nil {
+					return err
+				}
+			}
+		}
+		if err := iterator.Error(); err != nil {
+			return err
+		}
+	}
+
+	// verify the store by counting its keys in both dbs
+	iterator, err := source(nil)
+	if err != nil {
+		return err
+	}
+	migration.SourceKeys, err = countKeys(iterator)
+	if err != nil {
+		return err
+	}
+	if migration.To == types.StoreTypeIAVL && migration.From != types.StoreTypeIAVL {
+		tree, err := iavl.LoadStore(dbm.NewPrefixDB(newDb, prefix), rs.logger, key, migration.CommitID, false, rs.iavlCacheSize, rs.iavlDisableFastNode)
+		if err != nil {
+			return err
+		}
+		iterator = tree.Iterator(nil, nil)
+	} else {
+		iterator, err = dbm.NewPrefixDB(newDb, prefix).Iterator(nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+	migration.TargetKeys, err = countKeys(iterator)
+	if err != nil {
+		return err
+	}
+	if migration.SourceKeys != migration.TargetKeys || migration.SourceKeys != migration.Keys {
+		return fmt.Errorf("verification failed, %d keys in the source db, %d keys in the new db and %d keys migrated",
+			migration.SourceKeys, migration.TargetKeys, migration.Keys)
+	}
+
+	checkpoint.Done = true
+	batch := newDb.NewBatch()
+	defer batch.Close()
+	return writeMigrateCheckpoint(batch, checkpointKey, checkpoint)
+}
+
+// migrateToIAVL builds the IAVL tree of a store in the new db, saving it every batch so that the memory is
+// bounded by the batch size. The batches are saved at consecutive versions ending with the version of the
+// store, the batch size being raised if there are more batches than versions, and the tree resumes from the
+// last checkpointed batch. The intermediate versions are deleted once the tree is built.
+func (rs *Store) migrateToIAVL(key types.StoreKey, newDb dbm.DB, prefix, checkpointKey []byte, checkpoint *migrateCheckpoint, source func([]byte) (types.Iterator, error), migration *StoreMigration, opts MigrateOptions) error {
+	version := checkpoint.Version
+	if checkpoint.TreeVersion == 0 {
+		// remove the tree of a migration interrupted before its first batch was checkpointed
+		if err := deletePrefix(newDb, prefix, opts.BatchSize); err != nil {
+			return err
+		}
+		iterator, err := source(nil)
+		if err != nil {
+			return err
+		}
+		keys, err := countKeys(iterator)
+		if err != nil {
+			return err
+		}
+		checkpoint.BatchSize = uint64(opts.BatchSize)
+		batches := (keys + checkpoint.BatchSize - 1) / checkpoint.BatchSize
+		if batches > uint64(version) {
+			checkpoint.BatchSize = (keys + uint64(version) - 1) / uint64(version)
+			batches = (keys + checkpoint.BatchSize - 1) / checkpoint.BatchSize
+		}
+		if batches == 0 {
+			batches = 1
+		}
+		checkpoint.InitialVersion = version - int64(batches) + 1
+		checkpoint.LastKey, checkpoint.Keys = nil, 0
+	}
+	migration.Keys = checkpoint.Keys
+
+	store, err := iavl.LoadStoreWithInitialVersion(dbm.NewPrefixDB(newDb, prefix), rs.logger, key, types.CommitID{Version: checkpoint.TreeVersion}, false, uint64(checkpoint.InitialVersion), rs.iavlCacheSize, rs.iavlDisableFastNode)
+	if err != nil {
+		return err
+	}
+	tree := store.(*iavl.Store)
+
+	var start []byte
+	if checkpoint.LastKey != nil {
+		// resume from the key following the last checkpointed one
+		start = append(checkpoint.LastKey, 0)
+	}
+	iterator, err := source(start)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for checkpoint.TreeVersion < version {
+		for n := uint64(0); n < checkpoint.BatchSize && iterator.Valid(); n++ {
+			checkpoint.LastKey = bytes.Clone(iterator.Key())
+			tree.Set(iterator.Key(), iterator.Value())
+			checkpoint.Keys++
+			iterator.Next()
+		}
+		if err := iterator.Error(); err != nil {
+			return err
+		}
+		// a batch already saved before an interruption is saved again to the same hash
+		checkpoint.TreeVersion = tree.Commit().Version
+
+		batch := newDb.NewBatch()
+		err := writeMigrateCheckpoint(batch, checkpointKey, *checkpoint)
+		batch.Close()
+		if err != nil {
+			return err
+		}
+
+		migration.Keys = checkpoint.Keys
+		if opts.Progress != nil {
+			if err := opts.Progress(*migration); err != nil {
+				return err
+			}
+		}
+	}
+	if iterator.Valid() {
+		return fmt.Errorf("the IAVL tree was saved at version %d before the last key", version)
+	}
+
+	var versions []int64
+	for _, v := range tree.GetAllVersions() {
+		if int64(v) < version {
+			versions = append(versions, int64(v))
+		}
+	}
+	if err := tree.DeleteVersions(versions...); err != nil {
+		return err
+	}
+
+	migration.CommitID = tree.LastCommitID()
+	if migration.CommitID.Version != version {
+		return fmt.Errorf("the IAVL tree was saved at version %d instead of %d", migration.CommitID.Version, version)
+	}
+	return nil
+}
+
+func loadMigrateCheckpoint(db dbm.DB, key []byte) (migrateCheckpoint, error) {
+	var checkpoint migrateCheckpoint
+	bz, err := db.Get(key)
+	if err != nil || bz == nil {
+		return checkpoint, err
+	}
+	if err := json.Unmarshal(bz, &checkpoint); err != nil {
+		return checkpoint, errors.Wrap(err, "failed to unmarshal the migration checkpoint")
+	}
+	return checkpoint, nil
+}
+
+// writeMigrateCheckpoint writes the batch along with the checkpoint.
+func writeMigrateCheckpoint(batch dbm.Batch, key []byte, checkpoint migrateCheckpoint) error {
+	bz, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	if err := batch.Set(key, bz); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+func countKeys(iterator types.Iterator) (uint64, error) {
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count, iterator.Error()
+}
+
+func deletePrefix(db dbm.DB, prefix []byte, batchSize int) error {
+	for {
+		iterator, err := dbm.IteratePrefix(db, prefix)
+		if err != nil {
+			return err
+		}
+		batch := db.NewBatch()
+		n := 0
+		for ; n < batchSize && iterator.Valid(); iterator.Next() {
+			if err := batch.Delete(bytes.Clone(iterator.Key())); err != nil {
+				iterator.Close()
+				batch.Close()
+				return err
+			}
+			n++
+		}
+		iterator.Close()
+		err = batch.Write()
+		batch.Close()
+		if err != nil || n < batchSize {
+			return err
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package rootmulti
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newMigrateTestStore(t *testing.T, db dbm.DB, typ1, typ2 types.StoreType) *Store {
+	t.Helper()
+
+	store := NewStore(db, log.NewNopLogger())
+	store.MountStoreWithDB(testStoreKey1, typ1, nil)
+	store.MountStoreWithDB(testStoreKey2, typ2, nil)
+	store.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	return store
+}
+
+func requireMigrateTestStore(t *testing.T, store *Store) {
+	t.Helper()
+
+	for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2} {
+		for i := 0; i < 5; i++ {
+			require.Equal(t, []byte(fmt.Sprintf("%s-%d", key.Name(), i)), store.GetKVStore(key).Get([]byte(fmt.Sprintf("k%d", i))))
+		}
+	}
+}
+
+func TestMigrateStores(t *testing.T) {
+	store := newMigrateTestStore(t, dbm.NewMemDB(), types.StoreTypeIAVL, types.StoreTypeIAVL)
+	for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2} {
+		for i := 0; i < 5; i++ {
+			store.GetKVStore(key).Set([]byte(fmt.Sprintf("k%d", i)), []byte(fmt.Sprintf("%s-%d", key.Name(), i)))
+		}
+	}
+	store.Commit()
+	iavlHash := store.GetCommitKVStore(testStoreKey1).LastCommitID().Hash
+
+	_, err := store.MigrateStores(types.StoreTypeMemory, dbm.NewMemDB(), MigrateOptions{})
+	require.Error(t, err)
+	_, err = store.MigrateStores(types.StoreTypeDB, dbm.NewMemDB(), MigrateOptions{Stores: []string{"unknown"}})
+	require.Error(t, err)
+
+	// the migration stops after the first batch, then resumes
+	dbDb := dbm.NewMemDB()
+	errStop := errors.New("stop")
+	_, err = store.MigrateStores(types.StoreTypeDB, dbDb, MigrateOptions{
+		BatchSize: 2,
+		Progress:  func(StoreMigration) error { return errStop },
+	})
+	require.ErrorIs(t, err, errStop)
+	migrations, err := store.MigrateStores(types.StoreTypeDB, dbDb, MigrateOptions{BatchSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, []StoreMigration{
+		{Name: "store1", From: types.StoreTypeIAVL, To: types.StoreTypeDB, Keys: 5, SourceKeys: 5, TargetKeys: 5, Resumed: true},
+		{Name: "store2", From: types.StoreTypeIAVL, To: types.StoreTypeDB, Keys: 5, SourceKeys: 5, TargetKeys: 5},
+	}, migrations)
+	has, err := dbDb.Has([]byte(migrateCheckpointPrefix + "store1"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	plainStore := newMigrateTestStore(t, dbDb, types.StoreTypeDB, types.StoreTypeDB)
+	require.Equal(t, int64(1), plainStore.LastCommitID().Version)
+	requireMigrateTestStore(t, plainStore)
+
+	// only the first store is converted back to IAVL, the second one is copied as it is
+	hybridDb := dbm.NewMemDB()
+	migrations, err = plainStore.MigrateStores(types.StoreTypeIAVL, hybridDb, MigrateOptions{Stores: []string{"store1"}})
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	require.Equal(t, types.StoreTypeIAVL, migrations[0].To)
+	require.Equal(t, types.CommitID{Version: 1, Hash: iavlHash}, migrations[0].CommitID)
+	require.Equal(t, types.StoreTypeDB, migrations[1].To)
+	require.Equal(t, uint64(5), migrations[1].TargetKeys)
+
+	hybridStore := newMigrateTestStore(t, hybridDb, types.StoreTypeIAVL, types.StoreTypeDB)
+	requireMigrateTestStore(t, hybridStore)
+	require.Equal(t, iavlHash, hybridStore.GetCommitKVStore(testStoreKey1).LastCommitID().Hash)
+	cInfo, err := hybridStore.GetCommitInfo(1)
+	require.NoError(t, err)
+	require.Equal(t, iavlHash, cInfo.StoreInfos[0].CommitId.Hash)
+}
+
+func TestMigrateStoresToIAVLResume(t *testing.T) {
+	plainStore := newMigrateTestStore(t, dbm.NewMemDB(), types.StoreTypeDB, types.StoreTypeDB)
+	for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2} {
+		for i := 0; i < 5; i++ {
+			plainStore.GetKVStore(key).Set([]byte(fmt.Sprintf("k%d", i)), []byte(fmt.Sprintf("%s-%d", key.Name(), i)))
+		}
+	}
+	for i := 0; i < 4; i++ {
+		plainStore.Commit()
+	}
+
+	// the tree of the first store is saved after the first batch, then the migration stops and resumes
+	iavlDb := dbm.NewMemDB()
+	errStop := errors.New("stop")
+	var progress []StoreMigration
+	_, err := plainStore.MigrateStores(types.StoreTypeIAVL, iavlDb, MigrateOptions{
+		BatchSize: 2,
+		Progress: func(migration StoreMigration) error {
+			progress = append(progress, migration)
+			return errStop
+		},
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Len(t, progress, 1)
+	require.Equal(t, uint64(2), progress[0].Keys)
+	checkpoint, err := loadMigrateCheckpoint(iavlDb, []byte(migrateCheckpointPrefix+"store1"))
+	require.NoError(t, err)
+	require.Equal(t, migrateCheckpoint{Version: 4, LastKey: []byte("k1"), Keys: 2, TreeVersion: 2, InitialVersion: 2, BatchSize: 2}, checkpoint)
+
+	migrations, err := plainStore.MigrateStores(types.StoreTypeIAVL, iavlDb, MigrateOptions{BatchSize: 2})
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	for i, migration := range migrations {
+		require.Equal(t, i == 0, migration.Resumed)
+		require.Equal(t, uint64(5), migration.Keys)
+		require.Equal(t, uint64(5), migration.TargetKeys)
+		require.Equal(t, int64(4), migration.CommitID.Version)
+	}
+
+	iavlStore := newMigrateTestStore(t, iavlDb, types.StoreTypeIAVL, types.StoreTypeIAVL)
+	require.Equal(t, int64(4), iavlStore.LastCommitID().Version)
+	requireMigrateTestStore(t, iavlStore)
+	for i, key := range []types.StoreKey{testStoreKey1, testStoreKey2} {
+		store := iavlStore.GetCommitKVStore(key).(*iavl.Store)
+		require.Equal(t, migrations[i].CommitID, store.LastCommitID())
+		// the intermediate versions of the batches are deleted
+		require.Equal(t, []int{4}, store.GetAllVersions())
+	}
+
+	// the hash doesn't depend on the interruption
+	uninterrupted, err := plainStore.MigrateStores(types.StoreTypeIAVL, dbm.NewMemDB(), MigrateOptions{BatchSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, migrations[0].CommitID, uninterrupted[0].CommitID)
+}
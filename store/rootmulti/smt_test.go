@@ -0,0 +1,111 @@
+package rootmulti
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/smt"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newSMTMultiStore(t *testing.T, db dbm.DB) (*Store, *types.KVStoreKey, *types.KVStoreKey) {
+	t.Helper()
+	store := NewStore(db, log.NewNopLogger())
+	store.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	iavlKey, smtKey := types.NewKVStoreKey("iavl"), types.NewKVStoreKey("smt")
+	store.MountStoreWithDB(iavlKey, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(smtKey, types.StoreTypeSMT, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	return store, iavlKey, smtKey
+}
+
+func TestSMTStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, iavlKey, smtKey := newSMTMultiStore(t, db)
+	for version := 1; version <= 3; version++ {
+		for i := 0; i < 10; i++ {
+			store.GetKVStore(iavlKey).Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("%d", version)))
+			store.GetKVStore(smtKey).Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("%d", version)))
+		}
+		store.Commit()
+	}
+	id := store.LastCommitID()
+	require.IsType(t, &smt.Store{}, store.GetCommitKVStore(smtKey))
+
+	// the existence and the absence of the keys are proven against the app hash, the key of an SMT proof
+	// being the hash of the key
+	prt := DefaultProofRuntime()
+	for _, key := range []string{"key1", "missing"} {
+		res := store.Query(abci.RequestQuery{Path: "/smt/key", Data: []byte(key), Height: id.Version, Prove: true})
+		require.EqualValues(t, 0, res.Code, res.Log)
+		path := sha256.Sum256([]byte(key))
+		keyPath := new(merkle.KeyPath).AppendKey([]byte("smt"), merkle.KeyEncodingURL).AppendKey(path[:], merkle.KeyEncodingHex)
+		if res.Value != nil {
+			require.Equal(t, []byte("3"), res.Value)
+			require.NoError(t, prt.VerifyValue(res.ProofOps, id.Hash, keyPath.String(), res.Value))
+		} else {
+			require.NoError(t, prt.VerifyAbsence(res.ProofOps, id.Hash, keyPath.String()))
+		}
+	}
+
+	// the past versions are read from their trees, and the copies read the last one
+	cms, err := store.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), cms.GetKVStore(smtKey).Get([]byte("key1")))
+	require.Equal(t, []byte("3"), store.DeepCopy().GetKVStore(smtKey).Get([]byte("key1")))
+	checkState := store.DeepCopyAndCache()
+	checkState.GetKVStore(smtKey).Set([]byte("key1"), []byte("check"))
+	checkState.Write()
+	require.Equal(t, []byte("3"), store.GetKVStore(smtKey).Get([]byte("key1")))
+
+	// the reloaded store has the same hash, and its pruned versions are deleted
+	reloaded := NewStore(db, log.NewNopLogger())
+	reloaded.SetPruning(pruningtypes.NewCustomPruningOptions(1, 1))
+	reloaded.MountStoreWithDB(iavlKey, types.StoreTypeIAVL, nil)
+	reloaded.MountStoreWithDB(smtKey, types.StoreTypeSMT, nil)
+	require.NoError(t, reloaded.LoadLatestVersion())
+	require.Equal(t, id, reloaded.LastCommitID())
+	reloaded.Commit()
+	reloaded.Commit()
+	_, err = reloaded.CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+	require.Equal(t, []byte("3"), reloaded.GetKVStore(smtKey).Get([]byte("key1")))
+}
+
+func TestSMTStoreSnapshotRestore(t *testing.T) {
+	source, _, smtKey := newSMTMultiStore(t, dbm.NewMemDB())
+	for i := 0; i < 100; i++ {
+		source.GetKVStore(smtKey).Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	source.Commit()
+	source.GetKVStore(smtKey).Delete([]byte("key1"))
+	source.Commit()
+	version := uint64(source.LastCommitID().Version)
+
+	chunks := make(chan io.ReadCloser, 100)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		defer streamWriter.Close()
+		require.NoError(t, source.Snapshot(version, streamWriter))
+	}()
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	require.NoError(t, err)
+
+	target, _, targetKey := newSMTMultiStore(t, dbm.NewMemDB())
+	_, err = target.Restore(version, snapshottypes.CurrentFormat, streamReader)
+	require.NoError(t, err)
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	require.Nil(t, target.GetKVStore(targetKey).Get([]byte("key1")))
+	require.Equal(t, []byte("value2"), target.GetKVStore(targetKey).Get([]byte("key2")))
+}
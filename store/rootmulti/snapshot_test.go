@@ -1,6 +1,7 @@
 package rootmulti_test
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -224,6 +225,137 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestoreParallel(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+	opts := snapshottypes.SnapshotOptions{Format: snapshottypes.ParallelFormat}
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, opts, source, nil, log.NewNopLogger())
+
+	// every store has its own stream, followed by the extensions
+	snapshot, err := manager.Create(version)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.ParallelFormat, snapshot.Format)
+	var names []string
+	for _, stream := range snapshot.Metadata.Streams {
+		names = append(names, stream.Name)
+	}
+	require.Equal(t, []string{"iavl1", "iavl2", "iavl3", "extensions"}, names)
+	require.EqualValues(t, len(names), snapshot.Chunks)
+
+	requireRestored := func(target *rootmulti.Store) {
+		require.Equal(t, source.LastCommitID(), target.LastCommitID())
+		for _, name := range []string{"iavl1", "iavl2", "iavl3"} {
+			assertStoresEqual(t, source.GetStoreByName(name).(types.CommitKVStore),
+				target.GetStoreByName(name).(types.CommitKVStore), "store %q not equal", name)
+		}
+	}
+
+	// the stores are restored from the chunks fed by state sync
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	targetStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	targetManager := snapshots.NewManager(targetStore, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+	requireRestored(target)
+
+	// and from a local snapshot
+	target = newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	localManager := snapshots.NewManager(snapshotStore, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, localManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	requireRestored(target)
+
+	// a stream whose hash doesn't match fails the restore
+	target = newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	snapshot.Metadata.Streams[1].Hash = []byte{1}
+	targetManager = snapshots.NewManager(targetStore, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, targetStore.Delete(snapshot.Height, snapshot.Format))
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		_, err = targetManager.RestoreChunk(chunk)
+		if err != nil {
+			require.ErrorIs(t, err, snapshottypes.ErrChunkHashMismatch)
+			return
+		}
+	}
+	t.Fatal("the restore didn't fail")
+}
+
+func TestMultistoreSnapshotRebuild(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	for _, format := range []uint32{snapshottypes.CurrentFormat, snapshottypes.ParallelFormat} {
+		snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+		require.NoError(t, err)
+		opts := snapshottypes.SnapshotOptions{Format: format}
+		manager := snapshots.NewManager(snapshotStore, opts, source, nil, log.NewNopLogger())
+		snapshot, err := manager.Create(version)
+		require.NoError(t, err)
+
+		rebuild := func(corrupt uint32) error {
+			target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+			chunks := make(chan io.ReadCloser)
+			go func() {
+				defer close(chunks)
+				for i := uint32(0); i < snapshot.Chunks; i++ {
+					chunk, err := snapshotStore.LoadChunk(snapshot.Height, snapshot.Format, i)
+					require.NoError(t, err)
+					if i == corrupt {
+						chunk = io.NopCloser(io.MultiReader(chunk, bytes.NewReader([]byte{1})))
+					}
+					chunks <- chunk
+				}
+			}()
+			if err := snapshots.RebuildSnapshot(target, *snapshot, chunks); err != nil {
+				return err
+			}
+			require.Equal(t, source.LastCommitID(), target.LastCommitID(), "format %v", format)
+			return nil
+		}
+		require.NoError(t, rebuild(snapshot.Chunks))
+		require.ErrorIs(t, rebuild(0), snapshottypes.ErrChunkHashMismatch, "format %v", format)
+	}
+}
+
+func TestMultistoreSnapshotRestoreStores(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	for _, format := range []uint32{snapshottypes.CurrentFormat, snapshottypes.ParallelFormat} {
+		snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+		require.NoError(t, err)
+		opts := snapshottypes.SnapshotOptions{Format: format}
+		snapshot, err := snapshots.NewManager(snapshotStore, opts, source, nil, log.NewNopLogger()).Create(version)
+		require.NoError(t, err)
+
+		target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+		require.Error(t, target.SetRestoreStores("iavl2", "unknown"))
+		require.NoError(t, target.SetRestoreStores("iavl2"))
+		manager := snapshots.NewManager(snapshotStore, opts, target, nil, log.NewNopLogger())
+		require.NoError(t, manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+
+		require.Equal(t, int64(version), target.LastCommitID().Version)
+		assertStoresEqual(t, source.GetStoreByName("iavl2").(types.CommitKVStore),
+			target.GetStoreByName("iavl2").(types.CommitKVStore))
+		for _, name := range []string{"iavl1", "iavl3"} {
+			iter := target.GetStoreByName(name).(types.KVStore).Iterator(nil, nil)
+			require.False(t, iter.Valid(), "store %q not skipped, format %v", name, format)
+			iter.Close()
+		}
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
@@ -0,0 +1,415 @@
+package rootmulti
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	stateSizeKeyFmt = "s/statesize/%d" // s/statesize/<version>
+
+	// stateSizeKeepRecent is the number of versions whose state sizes are all kept, the older ones are only
+	// kept every stateSizeCheckpointInterval versions.
+	stateSizeKeepRecent         = 1000
+	stateSizeCheckpointInterval = 1000
+)
+
+// StateSizePrefix is a key prefix of a store whose size is tracked apart from the rest of the store.
+type StateSizePrefix struct {
+	Store  string `json:"store"`
+	Name   string `json:"name"`
+	Prefix []byte `json:"prefix"`
+}
+
+// ParseStateSizePrefix parses a prefix formatted as <store>/<name>=<hex prefix>.
+func ParseStateSizePrefix(s string) (StateSizePrefix, error) {
+	path, prefix, ok := strings.Cut(s, "=")
+	store, name, ok2 := strings.Cut(path, "/")
+	if !ok || !ok2 || store == "" || name == "" {
+		return StateSizePrefix{}, fmt.Errorf("invalid state size prefix %q, expected <store>/<name>=<hex prefix>", s)
+	}
+	bz, err := hex.DecodeString(strings.TrimPrefix(prefix, "0x"))
+	if err != nil || len(bz) == 0 {
+		return StateSizePrefix{}, fmt.Errorf("invalid state size prefix %q, the prefix must be non-empty hex", s)
+	}
+	return StateSizePrefix{Store: store, Name: name, Prefix: bz}, nil
+}
+
+// StateSize is the size of the persisted stores at a version. The size of a key is the length of the key
+// plus the length of its value, regardless of how the store lays it out in the DB.
+type StateSize struct {
+	Version int64            `json:"version"`
+	Stores  []StoreStateSize `json:"stores"`
+}
+
+// StoreStateSize is the size of a store and its growth at the version, sorted by name.
+type StoreStateSize struct {
+	Name       string            `json:"name"`
+	Keys       uint64            `json:"keys"`
+	Bytes      uint64            `json:"bytes"`
+	KeysDelta  int64             `json:"keys_delta"`
+	BytesDelta int64             `json:"bytes_delta"`
+	Prefixes   []PrefixStateSize `json:"prefixes,omitempty"`
+}
+
+// PrefixStateSize is the size of the keys of a store under a prefix and its growth at the version.
+type PrefixStateSize struct {
+	Name       string `json:"name"`
+	Prefix     []byte `json:"prefix"`
+	Keys       uint64 `json:"keys"`
+	Bytes      uint64 `json:"bytes"`
+	KeysDelta  int64  `json:"keys_delta"`
+	BytesDelta int64  `json:"bytes_delta"`
+}
+
+// stateSizeWrite is the size of a key before its first write of the block and after its last one.
+type stateSizeWrite struct {
+	prevExists, exists bool
+	prevSize, size     int64
+}
+
+// stateSizeTracker tracks the writes of a block into the persisted stores to update their sizes at commit.
+type stateSizeTracker struct {
+	mtx sync.Mutex
+
+	prefixes map[string][]StateSizePrefix
+	writes   map[string]map[string]*stateSizeWrite
+	last     StateSize
+}
+
+func newStateSizeTracker() *stateSizeTracker {
+	return &stateSizeTracker{
+		prefixes: make(map[string][]StateSizePrefix),
+		writes:   make(map[string]map[string]*stateSizeWrite),
+	}
+}
+
+// write records a write into a store, reading the size of the key before its first write of the block.
+func (t *stateSizeTracker) write(name string, parent types.KVStore, key, value []byte) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	writes, ok := t.writes[name]
+	if !ok {
+		writes = make(map[string]*stateSizeWrite)
+		t.writes[name] = writes
+	}
+	w, ok := writes[string(key)]
+	if !ok {
+		w = &stateSizeWrite{}
+		if prev := parent.Get(key); prev != nil {
+			w.prevExists, w.prevSize = true, int64(len(key)+len(prev))
+		}
+		writes[string(key)] = w
+	}
+	w.exists, w.size = value != nil, 0
+	if value != nil {
+		w.size = int64(len(key) + len(value))
+	}
+}
+
+// stateSizeStore records the writes into a persisted store for its tracker.
+type stateSizeStore struct {
+	types.KVStore
+
+	name    string
+	tracker *stateSizeTracker
+}
+
+// Set implements KVStore.
+func (s stateSizeStore) Set(key, value []byte) {
+	types.AssertValidValue(value)
+	s.tracker.write(s.name, s.KVStore, key, value)
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s stateSizeStore) Delete(key []byte) {
+	s.tracker.write(s.name, s.KVStore, key, nil)
+	s.KVStore.Delete(key)
+}
+
+// CacheWrap implements CacheWrapper.
+func (s stateSizeStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s stateSizeStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// SetStateSizeTracking enables or disables the tracking of the sizes of the persisted stores, see
+// StateSize. It must be called before the stores are loaded. The first time it is enabled, or when the
+// stores or the prefixes change, the sizes are computed by iterating all the stores when they are loaded.
+func (rs *Store) SetStateSizeTracking(enabled bool) {
+	if !enabled {
+		rs.stateSizes = nil
+		return
+	}
+	rs.stateSizes = newStateSizeTracker()
+}
+
+// AddStateSizePrefixes registers key prefixes whose sizes are tracked apart from the rest of their stores.
+func (rs *Store) AddStateSizePrefixes(prefixes ...StateSizePrefix) {
+	rs.stateSizePrefixes = append(rs.stateSizePrefixes, prefixes...)
+}
+
+// StateSize returns the state size of a version, of the latest one if the version is 0.
+func (rs *Store) StateSize(version int64) (*StateSize, error) {
+	if rs.stateSizes == nil {
+		return nil, errors.New("state size tracking is disabled")
+	}
+	if version == 0 {
+		version = rs.LastCommitID().Version
+	}
+	return loadStateSize(rs.db, version)
+}
+
+// trackStateSize wraps a persisted store to track the size of its writes.
+func (rs *Store) trackStateSize(key types.StoreKey, store types.KVStore) types.KVStore {
+	if rs.stateSizes == nil || !isPersisted(rs.stores[key].GetStoreType()) {
+		return store
+	}
+	return stateSizeStore{KVStore: store, name: key.Name(), tracker: rs.stateSizes}
+}
+
+func isPersisted(typ types.StoreType) bool {
+	return typ == types.StoreTypeIAVL || typ == types.StoreTypeDB || typ == types.StoreTypeSMT
+}
+
+// loadStateSizes loads the state size of the version, or computes it if it wasn't tracked for the same stores
+// and prefixes.
+func (rs *Store) loadStateSizes(version int64) error {
+	t := rs.stateSizes
+	if t == nil {
+		return nil
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.writes = make(map[string]map[string]*stateSizeWrite)
+	t.prefixes = make(map[string][]StateSizePrefix)
+	for _, prefix := range rs.stateSizePrefixes {
+		t.prefixes[prefix.Store] = append(t.prefixes[prefix.Store], prefix)
+	}
+	for _, prefixes := range t.prefixes {
+		sort.Slice(prefixes, func(i, j int) bool { return prefixes[i].Name < prefixes[j].Name })
+	}
+
+	expected := rs.emptyStateSize(version)
+	if version > 0 {
+		last, err := loadStateSize(rs.db, version)
+		if err == nil && sameStateSizeLayout(last, expected) {
+			t.last = *last
+			return nil
+		}
+		if err != nil && !errors.Is(err, errStateSizeNotFound) {
+			return err
+		}
+	}
+
+	return rs.computeStateSize(expected)
+}
+
+// computeStateSize computes the sizes of the stores by iterating all their keys, and saves them.
+func (rs *Store) computeStateSize(size *StateSize) error {
+	t := rs.stateSizes
+	rs.logger.Info("Computing the state sizes, it may take a while", "version", size.Version)
+	start := time.Now()
+	for i := range size.Stores {
+		store := &size.Stores[i]
+		it := rs.stores[rs.keysByName[store.Name]].Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			n := uint64(len(it.Key()) + len(it.Value()))
+			store.Keys++
+			store.Bytes += n
+			for j := range store.Prefixes {
+				if bytes.HasPrefix(it.Key(), store.Prefixes[j].Prefix) {
+					store.Prefixes[j].Keys++
+					store.Prefixes[j].Bytes += n
+				}
+			}
+		}
+		err := it.Error()
+		it.Close()
+		if err != nil {
+			return err
+		}
+	}
+	rs.logger.Info("Computed the state sizes", "version", size.Version, "elapsed", time.Since(start))
+
+	t.last = *size
+	t.writes = make(map[string]map[string]*stateSizeWrite)
+	if size.Version > 0 {
+		return saveStateSize(rs.db, size)
+	}
+	return nil
+}
+
+// emptyStateSize returns a state size of the persisted stores and their prefixes without any key.
+func (rs *Store) emptyStateSize(version int64) *StateSize {
+	size := &StateSize{Version: version}
+	for _, key := range keysForStoreKeyMap(rs.stores) {
+		if !isPersisted(rs.stores[key].GetStoreType()) {
+			continue
+		}
+		store := StoreStateSize{Name: key.Name()}
+		for _, prefix := range rs.stateSizes.prefixes[key.Name()] {
+			store.Prefixes = append(store.Prefixes, PrefixStateSize{Name: prefix.Name, Prefix: prefix.Prefix})
+		}
+		size.Stores = append(size.Stores, store)
+	}
+	sort.Slice(size.Stores, func(i, j int) bool { return size.Stores[i].Name < size.Stores[j].Name })
+	return size
+}
+
+func sameStateSizeLayout(a, b *StateSize) bool {
+	if len(a.Stores) != len(b.Stores) {
+		return false
+	}
+	for i := range a.Stores {
+		if a.Stores[i].Name != b.Stores[i].Name || len(a.Stores[i].Prefixes) != len(b.Stores[i].Prefixes) {
+			return false
+		}
+		for j, prefix := range a.Stores[i].Prefixes {
+			other := b.Stores[i].Prefixes[j]
+			if prefix.Name != other.Name || !bytes.Equal(prefix.Prefix, other.Prefix) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// commitStateSizes applies the writes of the version to the sizes of the stores, saves them and exports them
+// to telemetry.
+func (rs *Store) commitStateSizes(version int64) error {
+	t := rs.stateSizes
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	expected := rs.emptyStateSize(version)
+	if !sameStateSizeLayout(&t.last, expected) {
+		// the stores were added or removed by an upgrade, their committed keys are iterated again
+		if err := rs.computeStateSize(expected); err != nil {
+			return err
+		}
+		rs.exportStateSize(expected)
+		return nil
+	}
+
+	size := StateSize{Version: version, Stores: make([]StoreStateSize, len(t.last.Stores))}
+	for i, last := range t.last.Stores {
+		store := StoreStateSize{Name: last.Name, Keys: last.Keys, Bytes: last.Bytes}
+		store.Prefixes = make([]PrefixStateSize, len(last.Prefixes))
+		for j, prefix := range last.Prefixes {
+			store.Prefixes[j] = PrefixStateSize{Name: prefix.Name, Prefix: prefix.Prefix, Keys: prefix.Keys, Bytes: prefix.Bytes}
+		}
+
+		for key, w := range t.writes[store.Name] {
+			keys, bytes := stateSizeDelta(w)
+			store.KeysDelta += keys
+			store.BytesDelta += bytes
+			for j := range store.Prefixes {
+				if strings.HasPrefix(key, string(store.Prefixes[j].Prefix)) {
+					store.Prefixes[j].KeysDelta += keys
+					store.Prefixes[j].BytesDelta += bytes
+				}
+			}
+		}
+		store.Keys = uint64(int64(store.Keys) + store.KeysDelta)
+		store.Bytes = uint64(int64(store.Bytes) + store.BytesDelta)
+		for j := range store.Prefixes {
+			store.Prefixes[j].Keys = uint64(int64(store.Prefixes[j].Keys) + store.Prefixes[j].KeysDelta)
+			store.Prefixes[j].Bytes = uint64(int64(store.Prefixes[j].Bytes) + store.Prefixes[j].BytesDelta)
+		}
+		size.Stores[i] = store
+	}
+
+	if err := saveStateSize(rs.db, &size); err != nil {
+		return err
+	}
+	t.last = size
+	t.writes = make(map[string]map[string]*stateSizeWrite)
+	rs.exportStateSize(&size)
+	return nil
+}
+
+// exportStateSize sets the telemetry gauges of the sizes of the stores and their prefixes.
+func (rs *Store) exportStateSize(size *StateSize) {
+	for _, store := range size.Stores {
+		labels := []metrics.Label{telemetry.NewLabel("store", store.Name)}
+		telemetry.SetGaugeWithLabels([]string{"store", "state_size", "keys"}, float32(store.Keys), labels)
+		telemetry.SetGaugeWithLabels([]string{"store", "state_size", "bytes"}, float32(store.Bytes), labels)
+		for _, prefix := range store.Prefixes {
+			labels := []metrics.Label{telemetry.NewLabel("store", store.Name), telemetry.NewLabel("prefix", prefix.Name)}
+			telemetry.SetGaugeWithLabels([]string{"store", "state_size", "prefix_keys"}, float32(prefix.Keys), labels)
+			telemetry.SetGaugeWithLabels([]string{"store", "state_size", "prefix_bytes"}, float32(prefix.Bytes), labels)
+		}
+	}
+}
+
+func stateSizeDelta(w *stateSizeWrite) (keys, bytes int64) {
+	switch {
+	case w.exists && !w.prevExists:
+		keys = 1
+	case !w.exists && w.prevExists:
+		keys = -1
+	}
+	return keys, w.size - w.prevSize
+}
+
+var errStateSizeNotFound = errors.New("state size not found")
+
+func loadStateSize(db dbm.DB, version int64) (*StateSize, error) {
+	bz, err := db.Get([]byte(fmt.Sprintf(stateSizeKeyFmt, version)))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, errors.Wrapf(errStateSizeNotFound, "version %d, the state sizes are kept for the last %d versions and every %d versions",
+			version, stateSizeKeepRecent, stateSizeCheckpointInterval)
+	}
+
+	size := &StateSize{}
+	if err := json.Unmarshal(bz, size); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal state size")
+	}
+	return size, nil
+}
+
+func saveStateSize(db dbm.DB, size *StateSize) error {
+	bz, err := json.Marshal(size)
+	if err != nil {
+		return err
+	}
+	batch := db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set([]byte(fmt.Sprintf(stateSizeKeyFmt, size.Version)), bz); err != nil {
+		return err
+	}
+	if old := size.Version - stateSizeKeepRecent; old > 0 && old%stateSizeCheckpointInterval != 0 {
+		if err := batch.Delete([]byte(fmt.Sprintf(stateSizeKeyFmt, old))); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
@@ -0,0 +1,90 @@
+package rootmulti
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newStateSizeTestStore(t *testing.T, db dbm.DB, prefixes ...StateSizePrefix) *Store {
+	t.Helper()
+
+	store := NewStore(db, log.NewNopLogger())
+	store.SetStateSizeTracking(true)
+	store.AddStateSizePrefixes(prefixes...)
+	store.MountStoreWithDB(testStoreKey1, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(testStoreKey2, types.StoreTypeDB, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	return store
+}
+
+func TestStateSize(t *testing.T) {
+	db := dbm.NewMemDB()
+	prefix := StateSizePrefix{Store: testStoreKey1.Name(), Name: "p", Prefix: []byte("p/")}
+	store := newStateSizeTestStore(t, db, prefix)
+
+	branch := store.CacheMultiStore()
+	branch.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("1"))
+	branch.GetKVStore(testStoreKey1).Set([]byte("p/a"), []byte("12"))
+	branch.GetKVStore(testStoreKey2).Set([]byte("b"), []byte("123"))
+	branch.Write()
+	store.Commit()
+
+	size, err := store.StateSize(0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), size.Version)
+	require.Equal(t, []StoreStateSize{
+		{Name: testStoreKey1.Name(), Keys: 2, Bytes: 7, KeysDelta: 2, BytesDelta: 7, Prefixes: []PrefixStateSize{
+			{Name: "p", Prefix: []byte("p/"), Keys: 1, Bytes: 5, KeysDelta: 1, BytesDelta: 5},
+		}},
+		{Name: testStoreKey2.Name(), Keys: 1, Bytes: 4, KeysDelta: 1, BytesDelta: 4},
+	}, size.Stores)
+
+	// overwriting, deleting and writing a key back within a block only counts the difference
+	branch = store.CacheMultiStore()
+	branch.GetKVStore(testStoreKey1).Set([]byte("p/a"), []byte("1"))
+	branch.GetKVStore(testStoreKey1).Delete([]byte("a"))
+	branch.GetKVStore(testStoreKey2).Delete([]byte("b"))
+	branch.Write()
+	store.GetKVStore(testStoreKey2).Set([]byte("b"), []byte("123"))
+	store.GetKVStore(testStoreKey2).Delete([]byte("missing"))
+	store.Commit()
+
+	size, err = store.StateSize(2)
+	require.NoError(t, err)
+	require.Equal(t, StoreStateSize{Name: testStoreKey1.Name(), Keys: 1, Bytes: 4, KeysDelta: -1, BytesDelta: -3, Prefixes: []PrefixStateSize{
+		{Name: "p", Prefix: []byte("p/"), Keys: 1, Bytes: 4, KeysDelta: 0, BytesDelta: -1},
+	}}, size.Stores[0])
+	require.Equal(t, StoreStateSize{Name: testStoreKey2.Name(), Keys: 1, Bytes: 4}, size.Stores[1])
+
+	// the sizes are resumed after a restart, and computed again when the prefixes change
+	store = newStateSizeTestStore(t, db, prefix)
+	store.GetKVStore(testStoreKey1).Set([]byte("p/b"), []byte("1"))
+	store.Commit()
+	size, err = store.StateSize(3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), size.Stores[0].Prefixes[0].Keys)
+
+	store = newStateSizeTestStore(t, db)
+	size, err = store.StateSize(3)
+	require.NoError(t, err)
+	require.Equal(t, StoreStateSize{Name: testStoreKey1.Name(), Keys: 2, Bytes: 8}, size.Stores[0])
+
+	_, err = store.StateSize(10)
+	require.ErrorIs(t, err, errStateSizeNotFound)
+}
+
+func TestParseStateSizePrefix(t *testing.T) {
+	prefix, err := ParseStateSizePrefix("crosschain/packages=0x01")
+	require.NoError(t, err)
+	require.Equal(t, StateSizePrefix{Store: "crosschain", Name: "packages", Prefix: []byte{1}}, prefix)
+
+	for _, s := range []string{"crosschain=01", "crosschain/packages", "/packages=01", "crosschain/packages=", "crosschain/packages=zz"} {
+		_, err := ParseStateSizePrefix(s)
+		require.Error(t, err, s)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/mem"
 	"github.com/cosmos/cosmos-sdk/store/pruning"
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/smt"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -74,6 +75,10 @@ type Store struct {
 	interBlockCache     types.MultiStorePersistentCache
 	listeners           map[types.StoreKey][]types.WriteListener
 	commitHeader        tmproto.Header
+	changeSets          *changeSetHasher
+	stateSizes          *stateSizeTracker
+	stateSizePrefixes   []StateSizePrefix
+	restoreStores       map[string]bool
 }
 
 var (
@@ -112,6 +117,17 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 	rs.pruningManager.SetOptions(pruningOpts)
 }
 
+// SetPinnedHeights sets the heights pinned by retention constraints, which are kept from pruning until they
+// are set again without them.
+func (rs *Store) SetPinnedHeights(pins []pruningtypes.PinnedHeights) {
+	rs.pruningManager.SetPinnedHeights(pins)
+}
+
+// GetPinnedHeights returns the heights pinned by retention constraints.
+func (rs *Store) GetPinnedHeights() []pruningtypes.PinnedHeights {
+	return rs.pruningManager.GetPinnedHeights()
+}
+
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
 func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
@@ -136,31 +152,6 @@ func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
 }
 
-// MigrateStores will migrate stores to another type in another db.
-func (rs *Store) MigrateStores(targetType types.StoreType, newDb dbm.DB) error {
-	if targetType != types.StoreTypeDB {
-		return errors.New("only StoreTypeDB is supported")
-	}
-
-	for key, store := range rs.stores {
-		switch store.GetStoreType() {
-		case types.StoreTypeIAVL:
-			rs.logger.Info("Migrating IAVL store", "store name", key.Name())
-			iterator := store.Iterator(nil, nil)
-			for ; iterator.Valid(); iterator.Next() {
-				prefixKey := append([]byte("s/k:"+key.Name()+"/"), iterator.Key()...)
-				if err := newDb.SetSync(prefixKey, iterator.Value()); err != nil {
-					return err
-				}
-			}
-			_ = iterator.Close()
-		default:
-
-		}
-	}
-	return nil
-}
-
 // MountStoreWithDB implements CommitMultiStore.
 func (rs *Store) MountStoreWithDB(key types.StoreKey, typ types.StoreType, db dbm.DB) {
 	if key == nil {
@@ -269,7 +260,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		// If it has been added, set the initial version
 		if upgrades.IsAdded(key.Name()) || upgrades.RenamedFrom(key.Name()) != "" {
 			storeParams.initialVersion = uint64(ver) + 1
-		} else if commitID.Version != ver && storeParams.typ == types.StoreTypeIAVL {
+		} else if commitID.Version != ver && (storeParams.typ == types.StoreTypeIAVL || storeParams.typ == types.StoreTypeSMT) {
 			return fmt.Errorf("version of store %s mismatch root store's version; expected %d got %d; new stores should be added using StoreUpgrades", key.Name(), ver, commitID.Version)
 		}
 
@@ -313,6 +304,13 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	rs.lastCommitInfo = cInfo
 	rs.stores = newStores
 
+	if err := rs.loadChangeSets(ver); err != nil {
+		return errors.Wrap(err, "failed to load change set commitment")
+	}
+	if err := rs.loadStateSizes(ver); err != nil {
+		return errors.Wrap(err, "failed to load state sizes")
+	}
+
 	// load any pruned heights we missed from disk to be pruned on the next run
 	if err := rs.pruningManager.LoadPruningHeights(rs.db); err != nil {
 		return err
@@ -470,6 +468,16 @@ func (rs *Store) Commit() types.CommitID {
 	}
 
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
+	if rs.changeSets != nil {
+		if err := rs.commitChangeSets(version, rs.lastCommitInfo); err != nil {
+			panic(err)
+		}
+	}
+	if rs.stateSizes != nil {
+		if err := rs.commitStateSizes(version); err != nil {
+			panic(err)
+		}
+	}
 	rs.lastCommitInfo.Timestamp = rs.commitHeader.Time
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
@@ -510,7 +518,7 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		store := types.KVStore(v)
+		store := rs.trackStateSize(k, v)
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
 		if rs.ListeningEnabled(k) {
@@ -565,6 +573,13 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 				}
 			}
 
+		case types.StoreTypeSMT:
+			var err error
+			cacheStore, err = store.(*smt.Store).GetImmutable(version)
+			if err != nil {
+				return nil, err
+			}
+
 		default:
 			cacheStore = store
 		}
@@ -599,7 +614,9 @@ func (rs *Store) DeepCopyAndCache() types.CacheMultiStore {
 		} else if _, ok := v.(*transient.Store); ok {
 			stores[k] = transient.NewStore()
 		} else if dbStore, ok := v.(commitDBStoreAdapter); ok {
-			stores[k] = cache.NewCommitKVStoreCache(commitDBStoreAdapter{Store: dbadapter.Store{DB: dbStore.Store.DB}}, 1000).CommitKVStore
+			stores[k] = dbStore.prefetchStore()
+		} else if smtStore, ok := v.(*smt.Store); ok {
+			stores[k] = smtStore.Clone()
 		}
 	}
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext())
@@ -623,7 +640,9 @@ func (rs *Store) DeepCopy() *Store {
 		} else if _, ok := v.(*transient.Store); ok {
 			stores[k] = transient.NewStore()
 		} else if dbStore, ok := v.(commitDBStoreAdapter); ok {
-			stores[k] = cache.NewCommitKVStoreCache(commitDBStoreAdapter{Store: dbadapter.Store{DB: dbStore.Store.DB}}, 1000).CommitKVStore
+			stores[k] = dbStore.uncachedStore()
+		} else if smtStore, ok := v.(*smt.Store); ok {
+			stores[k] = smtStore.Clone()
 		}
 	}
 
@@ -676,7 +695,7 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if s == nil {
 		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
 	}
-	store := types.KVStore(s)
+	store := rs.trackStateSize(key, s)
 
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.getTracingContext())
@@ -725,6 +744,13 @@ func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (
 	for key, store := range rs.stores {
 		rs.logger.Debug("pruning store", "key", key) // Also log store.name (a private variable)?
 
+		if store.GetStoreType() == types.StoreTypeSMT {
+			if err := store.(*smt.Store).DeleteVersions(pruningHeights...); err != nil {
+				return err
+			}
+			continue
+		}
+
 		// If the store is wrapped with an inter-block cache, we must first unwrap
 		// it to get the underlying IAVL store.
 		if store.GetStoreType() != types.StoreTypeIAVL {
@@ -816,10 +842,10 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 func (rs *Store) SetInitialVersion(version int64) error {
 	rs.initialVersion = version
 
-	// Loop through all the stores, if it's an IAVL store, then set initial
+	// Loop through all the stores, if it's an IAVL or SMT store, then set initial
 	// version on it.
 	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
+		if typ := store.GetStoreType(); typ == types.StoreTypeIAVL || typ == types.StoreTypeSMT {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
@@ -855,91 +881,166 @@ func parsePath(path string) (storeName, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
+
+	// Export each IAVL or SMT store, the SMT stores only export leaves. Stores are serialized as a stream of SnapshotItem Protobuf
+	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
+	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+	// are demarcated by new SnapshotStore items.
+	for _, store := range stores {
+		if err := rs.snapshotStore(height, store, protoWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnapshotStreams implements snapshottypes.ParallelSnapshotter, every IAVL or SMT store is exported into its
+// own stream, named after the store.
+func (rs *Store) SnapshotStreams(height uint64) ([]string, error) {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(stores))
+	for i, store := range stores {
+		names[i] = store.name
+	}
+	return names, nil
+}
+
+// SnapshotStream implements snapshottypes.ParallelSnapshotter, the stream of a store holds the same items as
+// the store does in the Snapshot stream.
+func (rs *Store) SnapshotStream(height uint64, name string, protoWriter protoio.Writer) error {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
+	for _, store := range stores {
+		if store.name == name {
+			return rs.snapshotStore(height, store, protoWriter)
+		}
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrLogic, "no store %q to snapshot", name)
+}
+
+// namedStore is a store exported into snapshots.
+type namedStore struct {
+	export func(version int64) (storeExporter, error)
+	name   string
+}
+
+// snapshotStores returns the stores to snapshot at the height, sorted by name.
+func (rs *Store) snapshotStores(height uint64) ([]namedStore, error) {
 	if height == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
 	}
 	if height > uint64(GetLatestVersion(rs.db)) {
-		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
+	// Collect stores to snapshot (only IAVL and SMT stores are supported)
 	stores := []namedStore{}
 	keys := keysForStoreKeyMap(rs.stores)
 	for _, key := range keys {
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
+			stores = append(stores, namedStore{name: key.Name(), export: func(version int64) (storeExporter, error) {
+				return store.Export(version)
+			}})
+		case *smt.Store:
+			stores = append(stores, namedStore{name: key.Name(), export: func(version int64) (storeExporter, error) {
+				return store.Export(version)
+			}})
 		case *transient.Store, *mem.Store:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
 	sort.Slice(stores, func(i, j int) bool {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
+	return stores, nil
+}
 
-	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
-	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
-	// are demarcated by new SnapshotStore items.
-	for _, store := range stores {
-		rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
-		exporter, err := store.Export(int64(height))
-		if err != nil {
-			rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
+// snapshotStore writes the SnapshotStore item of a store followed by its nodes.
+func (rs *Store) snapshotStore(height uint64, store namedStore, protoWriter protoio.Writer) error {
+	rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
+	exporter, err := store.export(int64(height))
+	if err != nil {
+		rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
+		return err
+	}
+	defer exporter.Close()
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{
+				Name: store.name,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	nodeCount := 0
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ErrorExportDone {
+			rs.logger.Debug("snapshot Done", "store", store.name, "nodeCount", nodeCount)
+			return nil
+		} else if err != nil {
 			return err
 		}
-		defer exporter.Close()
 		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-			Item: &snapshottypes.SnapshotItem_Store{
-				Store: &snapshottypes.SnapshotStoreItem{
-					Name: store.name,
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
 				},
 			},
 		})
 		if err != nil {
+			rs.logger.Error("snapshot failed; item store write failed", "store", store.name, "err", err)
 			return err
 		}
+		nodeCount++
+	}
+}
 
-		nodeCount := 0
-		for {
-			node, err := exporter.Next()
-			if err == iavltree.ErrorExportDone {
-				rs.logger.Debug("snapshot Done", "store", store.name, "nodeCount", nodeCount)
-				nodeCount = 0
-				break
-			} else if err != nil {
-				return err
-			}
-			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-				Item: &snapshottypes.SnapshotItem_IAVL{
-					IAVL: &snapshottypes.SnapshotIAVLItem{
-						Key:     node.Key,
-						Value:   node.Value,
-						Height:  int32(node.Height),
-						Version: node.Version,
-					},
-				},
-			})
-			if err != nil {
-				rs.logger.Error("snapshot failed; item store write failed", "store", store.name, "err", err)
-				return err
-			}
-			nodeCount++
+// SetRestoreStores restricts the restores of snapshots to the named stores, the nodes of the other stores are
+// skipped and they are committed empty. The restored multistore doesn't commit to the app hash of the snapshot
+// then, it only serves the state of the named stores, e.g. on an analytics node. No names restores every store.
+func (rs *Store) SetRestoreStores(names ...string) error {
+	if len(names) == 0 {
+		rs.restoreStores = nil
+		return nil
+	}
+	restoreStores := make(map[string]bool, len(names))
+	for _, name := range names {
+		if _, ok := rs.keysByName[name]; !ok {
+			return fmt.Errorf("no store %q to restore", name)
 		}
-		exporter.Close()
+		restoreStores[name] = true
 	}
-
+	rs.restoreStores = restoreStores
 	return nil
 }
 
+// restoresStore returns whether snapshot restores import the named store.
+func (rs *Store) restoresStore(name string) bool {
+	return rs.restoreStores == nil || rs.restoreStores[name]
+}
+
 // Restore implements snapshottypes.Snapshotter.
 // returns next snapshot item and error.
 func (rs *Store) Restore(
@@ -948,8 +1049,9 @@ func (rs *Store) Restore(
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
-	var importer *iavltree.Importer
+	var importer storeImporter
 	var snapshotItem snapshottypes.SnapshotItem
+	skipping := false
 loop:
 	for {
 		snapshotItem = snapshottypes.SnapshotItem{}
@@ -969,44 +1071,26 @@ loop:
 				}
 				importer.Close()
 			}
-			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
-			if !ok || store == nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
+			skipping = !rs.restoresStore(item.Store.Name)
+			if skipping {
+				rs.logger.Info("skipping store in snapshot restore", "store", item.Store.Name)
 			}
-			importer, err = store.Import(int64(height))
+			importer, err = rs.storeImporter(height, item.Store.Name)
 			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "import failed")
+				return snapshottypes.SnapshotItem{}, err
 			}
 			defer importer.Close()
-			// Importer height must reflect the node height (which usually matches the block height, but not always)
-			rs.logger.Debug("restoring snapshot", "store", item.Store.Name)
 
 		case *snapshottypes.SnapshotItem_IAVL:
 			if importer == nil {
 				rs.logger.Error("failed to restore; received IAVL node item before store item")
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
+			if skipping {
+				continue
 			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL node import failed")
+			if err := restoreNode(importer, item.IAVL); err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
 
 		default:
@@ -1026,6 +1110,124 @@ loop:
 	return snapshotItem, rs.LoadLatestVersion()
 }
 
+// RestoreStream implements snapshottypes.ParallelSnapshotter, the stream must hold the items of the store it
+// is named after and nothing else.
+func (rs *Store) RestoreStream(height uint64, name string, protoReader protoio.Reader) error {
+	var importer storeImporter
+	skipping := false
+	for {
+		var snapshotItem snapshottypes.SnapshotItem
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if importer != nil || item.Store.Name != name {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected store item %q in the stream of store %q", item.Store.Name, name)
+			}
+			skipping = !rs.restoresStore(name)
+			if skipping {
+				rs.logger.Info("skipping store in snapshot restore", "store", name)
+			}
+			importer, err = rs.storeImporter(height, name)
+			if err != nil {
+				return err
+			}
+			defer importer.Close()
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if importer == nil {
+				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
+			}
+			if skipping {
+				continue
+			}
+			if err := restoreNode(importer, item.IAVL); err != nil {
+				return err
+			}
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot item %T in the stream of store %q", item, name)
+		}
+	}
+
+	if importer == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "no store item in the stream of store %q", name)
+	}
+	if err := importer.Commit(); err != nil {
+		return sdkerrors.Wrap(err, "IAVL commit failed")
+	}
+	rs.logger.Debug("restored snapshot stream", "store", name)
+	return nil
+}
+
+// CommitRestore implements snapshottypes.ParallelSnapshotter.
+func (rs *Store) CommitRestore(height uint64) error {
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return rs.LoadLatestVersion()
+}
+
+// storeImporter starts importing a store at the height.
+func (rs *Store) storeImporter(height uint64, name string) (importer storeImporter, err error) {
+	switch store := rs.GetStoreByName(name).(type) {
+	case *iavl.Store:
+		importer, err = store.Import(int64(height))
+	case *smt.Store:
+		importer, err = store.Import(int64(height))
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", name)
+	}
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "import failed")
+	}
+	// Importer height must reflect the node height (which usually matches the block height, but not always)
+	rs.logger.Debug("restoring snapshot", "store", name)
+	return importer, nil
+}
+
+// restoreNode imports a node into a store.
+func restoreNode(importer storeImporter, item *snapshottypes.SnapshotIAVLItem) error {
+	if item.Height > math.MaxInt8 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	if err := importer.Add(node); err != nil {
+		return sdkerrors.Wrap(err, "IAVL node import failed")
+	}
+	return nil
+}
+
+// storeExporter exports the nodes of a store into a snapshot.
+type storeExporter interface {
+	Next() (*iavltree.ExportNode, error)
+	Close()
+}
+
+// storeImporter imports the nodes of a store from a snapshot.
+type storeImporter interface {
+	Add(node *iavltree.ExportNode) error
+	Commit() error
+	Close()
+}
+
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
 	var db dbm.DB
 
@@ -1064,7 +1266,10 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		return store, err
 
 	case types.StoreTypeDB:
-		return commitDBStoreAdapter{Store: dbadapter.Store{DB: db}}, nil
+		return commitDBStoreAdapter{Store: dbadapter.Store{DB: newReadCacheDB(db)}}, nil
+
+	case types.StoreTypeSMT:
+		return smt.LoadStoreWithInitialVersion(db, rs.logger, key, id, params.initialVersion)
 
 	case types.StoreTypeTransient:
 		_, ok := key.(*types.TransientStoreKey)
@@ -1126,6 +1331,10 @@ func (rs *Store) RollbackToVersion(target int64) error {
 			if err != nil {
 				return err
 			}
+		} else if store.GetStoreType() == types.StoreTypeSMT {
+			if err := store.(*smt.Store).LoadVersionForOverwriting(target); err != nil {
+				return err
+			}
 		}
 	}
 
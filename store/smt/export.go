@@ -0,0 +1,148 @@
+package smt
+
+import (
+	"errors"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	iavltree "github.com/cosmos/iavl"
+)
+
+// importBatchSize is the number of keys imported between two writes of the import batch.
+const importBatchSize = 10000
+
+// Exporter exports the values of a version in the order of their keys. They are exported as the leaves of
+// an IAVL tree, with a height of 0, so the snapshots of the SMT stores share the format of the IAVL ones.
+type Exporter struct {
+	it      *valueIterator
+	version int64
+}
+
+// Export exports the values of a version.
+func (st *Store) Export(version int64) (*Exporter, error) {
+	if !st.VersionExists(version) {
+		return nil, fmt.Errorf("smt export failed for version %v: %w", version, ErrVersionDoesNotExist)
+	}
+	it, err := newValueIterator(st.db, version, nil, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{it: it, version: version}, nil
+}
+
+// Next returns the next value, or iavl.ErrorExportDone once all the values were exported.
+func (e *Exporter) Next() (*iavltree.ExportNode, error) {
+	if !e.it.Valid() {
+		if err := e.it.Error(); err != nil {
+			return nil, err
+		}
+		return nil, iavltree.ErrorExportDone
+	}
+	node := &iavltree.ExportNode{Key: e.it.Key(), Value: e.it.Value(), Version: e.version, Height: 0}
+	e.it.Next()
+	return node, nil
+}
+
+// Close closes the exporter.
+func (e *Exporter) Close() {
+	e.it.Close()
+}
+
+// Importer imports the values exported at a version into an empty store, and commits the version.
+type Importer struct {
+	st      *Store
+	version int64
+	batch   dbm.Batch
+	tree    *treeWriter
+	count   int
+}
+
+// Import starts importing a version into the store, which must be empty.
+func (st *Store) Import(version int64) (*Importer, error) {
+	latest, err := latestVersion(st.db)
+	if err != nil {
+		return nil, err
+	}
+	if latest > 0 || st.version > 0 {
+		return nil, errors.New("smt import failed: the store isn't empty")
+	}
+	if version <= 0 {
+		return nil, fmt.Errorf("smt import failed: invalid version %d", version)
+	}
+	// drop the leftovers of an import which wasn't committed
+	if err := st.deleteVersion(version); err != nil {
+		return nil, err
+	}
+	return &Importer{
+		st:      st,
+		version: version,
+		batch:   st.db.NewBatch(),
+		tree:    newTreeWriter(st.db, version, emptyRef),
+	}, nil
+}
+
+// Add imports a value.
+func (i *Importer) Add(node *iavltree.ExportNode) error {
+	if i.batch == nil {
+		return errors.New("smt import failed: the importer is closed")
+	}
+	if node.Height != 0 {
+		return fmt.Errorf("smt import failed: unexpected inner node of height %d", node.Height)
+	}
+	if node.Value == nil {
+		return errors.New("smt import failed: the value of a key is nil")
+	}
+
+	if err := i.batch.Set(valueKey(node.Key, i.version), encodeValue(node.Value)); err != nil {
+		return err
+	}
+	if err := i.batch.Set(changeKey(i.version, node.Key), encodeVersion(0)); err != nil {
+		return err
+	}
+	if err := i.tree.set(node.Key, node.Value); err != nil {
+		return err
+	}
+
+	i.count++
+	if i.count%importBatchSize == 0 {
+		return i.write()
+	}
+	return nil
+}
+
+// write writes the imported values and the nodes of the tree built so far.
+func (i *Importer) write() error {
+	if err := i.tree.flush(i.batch); err != nil {
+		return err
+	}
+	if err := i.batch.Write(); err != nil {
+		return err
+	}
+	i.batch.Close()
+	i.batch = i.st.db.NewBatch()
+	return nil
+}
+
+// Commit commits the imported version and loads it in the store.
+func (i *Importer) Commit() error {
+	if i.batch == nil {
+		return errors.New("smt import failed: the importer is closed")
+	}
+	if err := i.batch.Set(rootKey(i.version), i.tree.root.encode()); err != nil {
+		return err
+	}
+	if err := i.write(); err != nil {
+		return err
+	}
+	i.st.version, i.st.root = i.version, i.tree.root
+	i.st.resetCache()
+	return nil
+}
+
+// Close closes the importer, the values imported without being committed are deleted by the next import.
+func (i *Importer) Close() {
+	if i.batch != nil {
+		i.batch.Close()
+		i.batch = nil
+	}
+}
@@ -0,0 +1,212 @@
+package smt
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// The tree is a compressed sparse Merkle tree over the sha256 hashes of the keys, its hashing is the one of
+// ics23.SmtSpec:
+//
+//	leaf  = sha256(0x00 || path || sha256(value))
+//	inner = sha256(0x01 || left || right)
+//
+// An empty subtree hashes to 32 zero bytes, and a subtree holding a single leaf is replaced by the leaf, so
+// every inner node has at least two leaves below it.
+
+const hashSize = sha256.Size
+
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+
+	emptyHash = make([]byte, hashSize)
+)
+
+// Layout of the DB of a store.
+var (
+	nodePrefix   = []byte("n/") // n/<version><hash> -> node created at version
+	rootPrefix   = []byte("r/") // r/<version> -> <root version><root hash>
+	orphanPrefix = []byte("o/") // o/<version><node version><hash> -> nil, node unreachable since version
+	valuePrefix  = []byte("k/") // k/<escaped key><version> -> value written at version
+	changePrefix = []byte("c/") // c/<version><escaped key> -> version of the value superseded at version
+)
+
+// nodeRef references a node by the version it was created at and its hash. The empty subtree has version 0.
+type nodeRef struct {
+	version int64
+	hash    []byte
+}
+
+var emptyRef = nodeRef{hash: emptyHash}
+
+func (r nodeRef) isEmpty() bool {
+	return r.version == 0
+}
+
+func (r nodeRef) encode() []byte {
+	bz := make([]byte, 8, 8+hashSize)
+	binary.BigEndian.PutUint64(bz, uint64(r.version))
+	return append(bz, r.hash...)
+}
+
+func decodeRef(bz []byte) (nodeRef, error) {
+	if len(bz) != 8+hashSize {
+		return nodeRef{}, fmt.Errorf("invalid node reference length %d", len(bz))
+	}
+	return nodeRef{version: int64(binary.BigEndian.Uint64(bz)), hash: bz[8:]}, nil
+}
+
+// node is a leaf or an inner node of the tree. A leaf also holds its key, which isn't hashed, so the values
+// of the neighbours of an absent key can be read to prove its absence.
+type node struct {
+	leaf bool
+
+	path      []byte
+	valueHash []byte
+	key       []byte
+
+	left, right nodeRef
+}
+
+func newLeaf(key, value []byte) *node {
+	path := sha256.Sum256(key)
+	valueHash := sha256.Sum256(value)
+	return &node{leaf: true, path: path[:], valueHash: valueHash[:], key: key}
+}
+
+func (n *node) child(right bool) nodeRef {
+	if right {
+		return n.right
+	}
+	return n.left
+}
+
+func (n *node) hash() []byte {
+	h := sha256.New()
+	if n.leaf {
+		h.Write(leafPrefix)
+		h.Write(n.path)
+		h.Write(n.valueHash)
+	} else {
+		h.Write(innerPrefix)
+		h.Write(n.left.hash)
+		h.Write(n.right.hash)
+	}
+	return h.Sum(nil)
+}
+
+// encode encodes a leaf as 0x00 || path || valueHash || key and an inner node as
+// 0x01 || leftHash || rightHash || leftVersion || rightVersion.
+func (n *node) encode() []byte {
+	if n.leaf {
+		bz := make([]byte, 0, 1+2*hashSize+len(n.key))
+		bz = append(bz, leafPrefix...)
+		bz = append(bz, n.path...)
+		bz = append(bz, n.valueHash...)
+		return append(bz, n.key...)
+	}
+	bz := make([]byte, 0, 1+2*hashSize+16)
+	bz = append(bz, innerPrefix...)
+	bz = append(bz, n.left.hash...)
+	bz = append(bz, n.right.hash...)
+	bz = binary.BigEndian.AppendUint64(bz, uint64(n.left.version))
+	return binary.BigEndian.AppendUint64(bz, uint64(n.right.version))
+}
+
+func decodeNode(bz []byte) (*node, error) {
+	if len(bz) < 1+2*hashSize {
+		return nil, fmt.Errorf("invalid node length %d", len(bz))
+	}
+	switch bz[0] {
+	case leafPrefix[0]:
+		return &node{
+			leaf:      true,
+			path:      bz[1 : 1+hashSize],
+			valueHash: bz[1+hashSize : 1+2*hashSize],
+			key:       bz[1+2*hashSize:],
+		}, nil
+	case innerPrefix[0]:
+		if len(bz) != 1+2*hashSize+16 {
+			return nil, fmt.Errorf("invalid inner node length %d", len(bz))
+		}
+		return &node{
+			left:  nodeRef{hash: bz[1 : 1+hashSize], version: int64(binary.BigEndian.Uint64(bz[1+2*hashSize:]))},
+			right: nodeRef{hash: bz[1+hashSize : 1+2*hashSize], version: int64(binary.BigEndian.Uint64(bz[1+2*hashSize+8:]))},
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid node prefix %x", bz[0])
+	}
+}
+
+// bit returns the bit of the path at the depth, a set bit goes right.
+func bit(path []byte, depth int) bool {
+	return path[depth/8]&(1<<(7-depth%8)) != 0
+}
+
+func nodeKey(ref nodeRef) []byte {
+	return append(append([]byte{}, nodePrefix...), ref.encode()...)
+}
+
+func rootKey(version int64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, rootPrefix...), uint64(version))
+}
+
+func orphanKey(version int64, ref nodeRef) []byte {
+	bz := binary.BigEndian.AppendUint64(append([]byte{}, orphanPrefix...), uint64(version))
+	return append(bz, ref.encode()...)
+}
+
+// escapeKey encodes a key preserving its order so that the versions appended to it don't mix with the other
+// keys: the zero bytes are escaped as 0x00 0xff, and the key is terminated by 0x00. The versions are below
+// 2^63, so the byte following the terminator is never 0xff.
+func escapeKey(key []byte) []byte {
+	bz := make([]byte, 0, len(key)+1)
+	for _, b := range key {
+		bz = append(bz, b)
+		if b == 0 {
+			bz = append(bz, 0xff)
+		}
+	}
+	return append(bz, 0)
+}
+
+// unescapeKey decodes an escaped key and returns the rest of the bytes.
+func unescapeKey(bz []byte) (key, rest []byte, err error) {
+	key = make([]byte, 0, len(bz))
+	for i := 0; i < len(bz); i++ {
+		if bz[i] != 0 {
+			key = append(key, bz[i])
+			continue
+		}
+		if i+1 < len(bz) && bz[i+1] == 0xff {
+			key = append(key, 0)
+			i++
+			continue
+		}
+		return key, bz[i+1:], nil
+	}
+	return nil, nil, fmt.Errorf("unterminated key %x", bz)
+}
+
+func valueKey(key []byte, version int64) []byte {
+	bz := append(append([]byte{}, valuePrefix...), escapeKey(key)...)
+	return binary.BigEndian.AppendUint64(bz, uint64(version))
+}
+
+func decodeValueKey(bz []byte) (key []byte, version int64, err error) {
+	key, rest, err := unescapeKey(bz[len(valuePrefix):])
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rest) != 8 {
+		return nil, 0, fmt.Errorf("invalid value key %x", bz)
+	}
+	return key, int64(binary.BigEndian.Uint64(rest)), nil
+}
+
+func changeKey(version int64, key []byte) []byte {
+	bz := binary.BigEndian.AppendUint64(append([]byte{}, changePrefix...), uint64(version))
+	return append(bz, escapeKey(key)...)
+}
@@ -0,0 +1,155 @@
+package smt
+
+import (
+	"bytes"
+	"errors"
+
+	dbm "github.com/cometbft/cometbft-db"
+	ics23 "github.com/confio/ics23/go"
+)
+
+// proofPath is a path from the root of the tree to a leaf or an empty subtree, with the siblings of the nodes
+// along it, from the root down.
+type proofPath struct {
+	siblings []nodeRef
+	right    []bool
+	end      nodeRef
+	leaf     *node
+}
+
+// walk descends the tree of a version along the path of a key.
+func walk(db dbm.DB, root nodeRef, path []byte) (*proofPath, error) {
+	p := &proofPath{end: root}
+	for depth := 0; !p.end.isEmpty(); depth++ {
+		n, err := getNode(db, p.end)
+		if err != nil {
+			return nil, err
+		}
+		if n.leaf {
+			p.leaf = n
+			break
+		}
+		right := bit(path, depth)
+		p.siblings = append(p.siblings, n.child(!right))
+		p.right = append(p.right, right)
+		p.end = n.child(right)
+	}
+	return p, nil
+}
+
+// innerOps returns the inner ops of the path, from the leaf up.
+func (p *proofPath) innerOps() []*ics23.InnerOp {
+	ops := make([]*ics23.InnerOp, 0, len(p.siblings))
+	for i := len(p.siblings) - 1; i >= 0; i-- {
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if p.right[i] {
+			op.Prefix = append(append([]byte{}, innerPrefix...), p.siblings[i].hash...)
+		} else {
+			op.Prefix = innerPrefix
+			op.Suffix = p.siblings[i].hash
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+// existenceProof proves the leaf of a path, whose value is read from the version.
+func existenceProof(db dbm.DB, version int64, p *proofPath) (*ics23.ExistenceProof, error) {
+	value, err := getValue(db, p.leaf.key, version)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, errors.New("value of a leaf not found")
+	}
+	return &ics23.ExistenceProof{
+		Key:   p.leaf.path,
+		Value: value,
+		Leaf:  ics23.SmtSpec.LeafSpec,
+		Path:  p.innerOps(),
+	}, nil
+}
+
+// neighbour returns the path to the closest leaf before or after a path, or nil if there is none.
+func neighbour(db dbm.DB, root nodeRef, path []byte, after bool) (*proofPath, error) {
+	// descend along the path, remembering the last subtree on the side of the neighbour
+	var (
+		candidate      nodeRef
+		candidateDepth = -1
+	)
+	ref := root
+	for depth := 0; !ref.isEmpty(); depth++ {
+		n, err := getNode(db, ref)
+		if err != nil {
+			return nil, err
+		}
+		if n.leaf {
+			if cmp := bytes.Compare(n.path, path); (after && cmp > 0) || (!after && cmp < 0) {
+				return walk(db, root, n.path)
+			}
+			break
+		}
+		right := bit(path, depth)
+		if right != after && !n.child(after).isEmpty() {
+			candidate, candidateDepth = n.child(after), depth
+		}
+		ref = n.child(right)
+	}
+	if candidateDepth < 0 {
+		return nil, nil
+	}
+
+	// the neighbour is the first or last leaf of the candidate subtree
+	for ref = candidate; ; {
+		n, err := getNode(db, ref)
+		if err != nil {
+			return nil, err
+		}
+		if n.leaf {
+			return walk(db, root, n.path)
+		}
+		if first := n.child(!after); !first.isEmpty() {
+			ref = first
+		} else {
+			ref = n.child(after)
+		}
+	}
+}
+
+// createProof proves the existence or the absence of a key in the tree of a version. The key of the proof is
+// the sha256 hash of the key, its path in the tree.
+func createProof(db dbm.DB, root nodeRef, version int64, key []byte) (*ics23.CommitmentProof, error) {
+	path := newLeaf(key, nil).path
+	p, err := walk(db, root, path)
+	if err != nil {
+		return nil, err
+	}
+	if p.leaf != nil && bytes.Equal(p.leaf.path, path) {
+		exist, err := existenceProof(db, version, p)
+		if err != nil {
+			return nil, err
+		}
+		return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: exist}}, nil
+	}
+
+	nonExist := &ics23.NonExistenceProof{Key: path}
+	for _, after := range []bool{false, true} {
+		p, err := neighbour(db, root, path, after)
+		if err != nil || p == nil {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		exist, err := existenceProof(db, version, p)
+		if err != nil {
+			return nil, err
+		}
+		if after {
+			nonExist.Right = exist
+		} else {
+			nonExist.Left = exist
+		}
+	}
+	return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonExist}}, nil
+}
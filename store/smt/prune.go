@@ -0,0 +1,207 @@
+package smt
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// A node orphaned at a version is reachable from the versions in between the one it was created at and the
+// one before it was orphaned, and so is a value superseded at a version from the one it was written at. They
+// are deleted once none of these versions is retained.
+
+// DeleteVersions deletes the versions and the nodes and values only they could read. The versions which
+// don't exist are ignored, and the last committed version can't be deleted.
+func (st *Store) DeleteVersions(versions ...int64) error {
+	deleted := make(map[int64]bool, len(versions))
+	var min, max int64
+	for _, version := range versions {
+		if !st.VersionExists(version) {
+			continue
+		}
+		if version >= st.version {
+			return fmt.Errorf("cannot delete the last committed version %d", st.version)
+		}
+		deleted[version] = true
+		if min == 0 || version < min {
+			min = version
+		}
+		if version > max {
+			max = version
+		}
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+	for version := range deleted {
+		if err := batch.Delete(rootKey(version)); err != nil {
+			return err
+		}
+	}
+
+	retained := func(from, to int64) (bool, error) {
+		if from > to {
+			return false, nil
+		}
+		it, err := st.db.Iterator(rootKey(from), rootKey(to+1))
+		if err != nil {
+			return false, err
+		}
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			if !deleted[int64(binary.BigEndian.Uint64(it.Key()[len(rootPrefix):]))] {
+				return true, nil
+			}
+		}
+		return false, it.Error()
+	}
+
+	// only the records of the versions up to the first one retained after the deleted ones can become
+	// unreachable, the older ones were reachable from a retained version
+	next, err := nextVersion(st.db, max)
+	if err != nil {
+		return err
+	}
+
+	err = iterateVersions(st.db, orphanPrefix, min+1, next, func(version int64, key, rest, _ []byte) error {
+		ref, err := decodeRef(rest)
+		if err != nil {
+			return err
+		}
+		if ok, err := retained(ref.version, version-1); ok || err != nil {
+			return err
+		}
+		if err := batch.Delete(nodeKey(ref)); err != nil {
+			return err
+		}
+		return batch.Delete(key)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = iterateVersions(st.db, changePrefix, min+1, next, func(version int64, key, rest, value []byte) error {
+		changed, _, err := unescapeKey(rest)
+		if err != nil {
+			return err
+		}
+		if len(value) == 8 {
+			prevVersion := int64(binary.BigEndian.Uint64(value))
+			if ok, err := retained(prevVersion, version-1); ok || err != nil {
+				return err
+			}
+			if err := batch.Delete(valueKey(changed, prevVersion)); err != nil {
+				return err
+			}
+		}
+		// the record is kept while its version is, to delete its value when rolling it back
+		if deleted[version] {
+			return batch.Delete(key)
+		}
+		return batch.Set(key, encodeVersion(0))
+	})
+	if err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+// deleteVersionsAfter deletes the versions committed after a version, from the latest one down.
+func (st *Store) deleteVersionsAfter(version, latest int64) error {
+	for v := latest; v > version; v-- {
+		if err := st.deleteVersion(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteVersion deletes the root, the nodes and the values written at a version, and restores the nodes and
+// values it superseded.
+func (st *Store) deleteVersion(version int64) error {
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	deletePrefix := func(prefix []byte, fn func(key []byte) error) error {
+		it, err := dbm.IteratePrefix(st.db, prefix)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			key := append([]byte{}, it.Key()...)
+			if fn != nil {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			if err := batch.Delete(key); err != nil {
+				return err
+			}
+		}
+		return it.Error()
+	}
+
+	prefix := func(p []byte) []byte {
+		return binary.BigEndian.AppendUint64(append([]byte{}, p...), uint64(version))
+	}
+	if err := deletePrefix(prefix(nodePrefix), nil); err != nil {
+		return err
+	}
+	if err := deletePrefix(prefix(orphanPrefix), nil); err != nil {
+		return err
+	}
+	err := deletePrefix(prefix(changePrefix), func(key []byte) error {
+		k, _, err := unescapeKey(key[len(changePrefix)+8:])
+		if err != nil {
+			return err
+		}
+		return batch.Delete(valueKey(k, version))
+	})
+	if err != nil {
+		return err
+	}
+	if err := batch.Delete(rootKey(version)); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// nextVersion returns the first version committed after a version.
+func nextVersion(db dbm.DB, version int64) (int64, error) {
+	it, err := db.Iterator(rootKey(version+1), types.PrefixEndBytes(rootPrefix))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return 0, fmt.Errorf("no version committed after %d", version)
+	}
+	return int64(binary.BigEndian.Uint64(it.Key()[len(rootPrefix):])), nil
+}
+
+// iterateVersions iterates the records of a prefix keyed by version, from a version to another one included.
+func iterateVersions(db dbm.DB, prefix []byte, from, to int64, fn func(version int64, key, rest, value []byte) error) error {
+	start := binary.BigEndian.AppendUint64(append([]byte{}, prefix...), uint64(from))
+	end := binary.BigEndian.AppendUint64(append([]byte{}, prefix...), uint64(to+1))
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := append([]byte{}, it.Key()...)
+		version := int64(binary.BigEndian.Uint64(key[len(prefix):]))
+		if err := fn(version, key, key[len(prefix)+8:], it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
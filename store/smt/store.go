@@ -0,0 +1,416 @@
+package smt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	_ types.KVStore                 = (*Store)(nil)
+	_ types.CommitStore             = (*Store)(nil)
+	_ types.CommitKVStore           = (*Store)(nil)
+	_ types.Queryable               = (*Store)(nil)
+	_ types.StoreWithInitialVersion = (*Store)(nil)
+)
+
+// ErrVersionDoesNotExist is returned when a version was pruned or is yet to be committed.
+var ErrVersionDoesNotExist = errors.New("version does not exist")
+
+// Store implements types.KVStore and CommitKVStore over a sparse Merkle tree. Its commitment is the root of
+// the tree, and it proves the existence and the absence of the keys with ics23.SmtSpec proofs.
+//
+// The writes of a version are buffered in memory and applied to the tree at commit. Every version keeps its
+// root and the values written at it, so the retained versions can be read, iterated and proven until they
+// are deleted.
+type Store struct {
+	db     dbm.DB
+	logger log.Logger
+
+	version        int64
+	root           nodeRef
+	initialVersion int64
+
+	// the writes of the next version, nil for a read-only store of a committed version
+	cache *cachekv.Store
+	dirty map[string]struct{}
+}
+
+// LoadStore returns an SMT Store as a CommitKVStore loaded at the version of the commit id. The versions
+// committed after it, if any, are deleted. An error is returned if the version doesn't exist.
+func LoadStore(db dbm.DB, logger log.Logger, key types.StoreKey, id types.CommitID) (types.CommitKVStore, error) {
+	return LoadStoreWithInitialVersion(db, logger, key, id, 0)
+}
+
+// LoadStoreWithInitialVersion returns an SMT Store as a CommitKVStore setting its initial version to the one
+// given, see LoadStore.
+func LoadStoreWithInitialVersion(db dbm.DB, logger log.Logger, key types.StoreKey, id types.CommitID, initialVersion uint64) (types.CommitKVStore, error) {
+	st := &Store{db: db, logger: logger, initialVersion: int64(initialVersion)}
+	if err := st.loadVersion(id.Version); err != nil {
+		return nil, fmt.Errorf("failed to load SMT store %s: %w", key.Name(), err)
+	}
+	if id.Version > 0 && !bytes.Equal(st.root.hash, id.Hash) {
+		return nil, fmt.Errorf("SMT store %s hash mismatch at version %d; expected %X got %X", key.Name(), id.Version, id.Hash, st.root.hash)
+	}
+	return st, nil
+}
+
+func (st *Store) loadVersion(version int64) error {
+	latest, err := latestVersion(st.db)
+	if err != nil {
+		return err
+	}
+	if latest > version {
+		if st.logger != nil {
+			st.logger.Info("Deleting the SMT versions committed after the loaded one", "version", version, "latest", latest)
+		}
+		if err := st.deleteVersionsAfter(version, latest); err != nil {
+			return err
+		}
+	}
+
+	st.version, st.root = version, emptyRef
+	if version > 0 {
+		root, ok, err := getRoot(st.db, version)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%w: %d", ErrVersionDoesNotExist, version)
+		}
+		st.root = root
+	}
+	st.resetCache()
+	return nil
+}
+
+// LoadVersionForOverwriting loads a version and deletes the versions committed after it.
+func (st *Store) LoadVersionForOverwriting(version int64) error {
+	return st.loadVersion(version)
+}
+
+func (st *Store) resetCache() {
+	st.cache = cachekv.NewStore(&Store{db: st.db, version: st.version, root: st.root})
+	st.dirty = make(map[string]struct{})
+}
+
+// GetImmutable returns a read-only store of a committed version. Any write panics.
+func (st *Store) GetImmutable(version int64) (*Store, error) {
+	root, ok, err := getRoot(st.db, version)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrVersionDoesNotExist, version)
+	}
+	return &Store{db: st.db, logger: st.logger, version: version, root: root}, nil
+}
+
+// Clone returns a store at the same committed version without the pending writes. Its writes are never
+// committed, it is used to run the txs of the mempool and the queries besides the committing store.
+func (st *Store) Clone() *Store {
+	clone := &Store{db: st.db, logger: st.logger, version: st.version, root: st.root, initialVersion: st.initialVersion}
+	clone.resetCache()
+	return clone
+}
+
+// VersionExists returns whether or not a given version is stored.
+func (st *Store) VersionExists(version int64) bool {
+	_, ok, err := getRoot(st.db, version)
+	return err == nil && ok
+}
+
+// GetStoreType implements Store.
+func (st *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeSMT
+}
+
+// CacheWrap implements Store.
+func (st *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
+
+// Get implements types.KVStore.
+func (st *Store) Get(key []byte) []byte {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "get")
+	if st.cache != nil {
+		return st.cache.Get(key)
+	}
+	value, err := getValue(st.db, key, st.version)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Has implements types.KVStore.
+func (st *Store) Has(key []byte) bool {
+	return st.Get(key) != nil
+}
+
+// Set implements types.KVStore.
+func (st *Store) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	st.mustBeWritable()
+	st.cache.Set(key, value)
+	st.dirty[string(key)] = struct{}{}
+}
+
+// Delete implements types.KVStore.
+func (st *Store) Delete(key []byte) {
+	types.AssertValidKey(key)
+	st.mustBeWritable()
+	st.cache.Delete(key)
+	st.dirty[string(key)] = struct{}{}
+}
+
+func (st *Store) mustBeWritable() {
+	if st.cache == nil {
+		panic("cannot write to an immutable SMT store")
+	}
+}
+
+// Iterator implements types.KVStore.
+func (st *Store) Iterator(start, end []byte) types.Iterator {
+	return st.iterator(start, end, false)
+}
+
+// ReverseIterator implements types.KVStore.
+func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return st.iterator(start, end, true)
+}
+
+func (st *Store) iterator(start, end []byte, reverse bool) types.Iterator {
+	if st.cache != nil {
+		if reverse {
+			return st.cache.ReverseIterator(start, end)
+		}
+		return st.cache.Iterator(start, end)
+	}
+	it, err := newValueIterator(st.db, st.version, start, end, reverse)
+	if err != nil {
+		panic(err)
+	}
+	return it
+}
+
+// Commit applies the writes to the tree and commits the next version.
+func (st *Store) Commit() types.CommitID {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "commit")
+	st.mustBeWritable()
+
+	version := st.version + 1
+	if st.version == 0 && st.initialVersion > 1 {
+		version = st.initialVersion
+	}
+	if err := st.commit(version); err != nil {
+		panic(err)
+	}
+	return st.LastCommitID()
+}
+
+func (st *Store) commit(version int64) error {
+	keys := make([]string, 0, len(st.dirty))
+	for key := range st.dirty {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	w := newTreeWriter(st.db, version, st.root)
+	for _, k := range keys {
+		key := []byte(k)
+		value := st.cache.Get(key)
+		prev, prevVersion, err := lastValue(st.db, key, st.version)
+		if err != nil {
+			return err
+		}
+		if (prev == nil) == (value == nil) && bytes.Equal(prev, value) {
+			continue
+		}
+
+		if err := batch.Set(valueKey(key, version), encodeValue(value)); err != nil {
+			return err
+		}
+		if err := batch.Set(changeKey(version, key), encodeVersion(prevVersion)); err != nil {
+			return err
+		}
+		if value == nil {
+			err = w.remove(key)
+		} else {
+			err = w.set(key, value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := w.flush(batch); err != nil {
+		return err
+	}
+	if err := batch.Set(rootKey(version), w.root.encode()); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	st.version, st.root = version, w.root
+	st.resetCache()
+	return nil
+}
+
+// LastCommitID implements Committer.
+func (st *Store) LastCommitID() types.CommitID {
+	if st.version == 0 {
+		return types.CommitID{}
+	}
+	return types.CommitID{
+		Version: st.version,
+		Hash:    st.root.hash,
+	}
+}
+
+// SetPruning panics as the pruning of the store is driven by the multistore with DeleteVersions.
+func (st *Store) SetPruning(_ pruningtypes.PruningOptions) {
+	panic("cannot set pruning options on an SMT store")
+}
+
+// GetPruning panics as the pruning of the store is driven by the multistore with DeleteVersions.
+func (st *Store) GetPruning() pruningtypes.PruningOptions {
+	panic("cannot get pruning options on an SMT store")
+}
+
+// SetInitialVersion sets the initial version of the store. It is used when starting a new chain at an
+// arbitrary height.
+func (st *Store) SetInitialVersion(version int64) {
+	st.initialVersion = version
+}
+
+// Query implements ABCI interface, allows queries
+//
+// by default we will return from (latest height -1),
+// as we will have merkle proofs immediately (header height = data height + 1)
+// If latest-1 is not present, use latest (which must be present)
+// if you care to have the latest data to see a tx results, you must
+// explicitly set the height you want to see
+//
+// The key of the proof of a key is its sha256 hash, its path in the tree.
+func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	defer telemetry.MeasureSince(time.Now(), "store", "smt", "query")
+
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"), false)
+	}
+
+	res.Height = req.Height
+	if res.Height == 0 {
+		res.Height = st.version
+		if st.VersionExists(st.version - 1) {
+			res.Height = st.version - 1
+		}
+	}
+
+	switch req.Path {
+	case "/key": // get by key
+		key := req.Data // data holds the key bytes
+
+		res.Key = key
+		root, ok, err := getRoot(st.db, res.Height)
+		if err != nil {
+			panic(err)
+		}
+		if !ok {
+			res.Log = ErrVersionDoesNotExist.Error()
+			break
+		}
+
+		value, err := getValue(st.db, key, res.Height)
+		if err != nil {
+			panic(err)
+		}
+		res.Value = value
+
+		if !req.Prove {
+			break
+		}
+
+		proof, err := createProof(st.db, root, res.Height, key)
+		if err != nil {
+			panic(fmt.Sprintf("failed to prove key %X at version %d: %s", key, res.Height, err))
+		}
+		res.ProofOps = &tmcrypto.ProofOps{
+			Ops: []tmcrypto.ProofOp{types.NewSmtCommitmentOp(newLeaf(key, nil).path, proof).ProofOp()},
+		}
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		subspace := req.Data
+		res.Key = subspace
+
+		iterator := types.KVStorePrefixIterator(st, subspace)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path), false)
+	}
+
+	return res
+}
+
+func encodeVersion(version int64) []byte {
+	if version == 0 {
+		return []byte{}
+	}
+	return binary.BigEndian.AppendUint64(nil, uint64(version))
+}
+
+func latestVersion(db dbm.DB) (int64, error) {
+	it, err := db.ReverseIterator(rootPrefix, types.PrefixEndBytes(rootPrefix))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return 0, it.Error()
+	}
+	return int64(binary.BigEndian.Uint64(it.Key()[len(rootPrefix):])), nil
+}
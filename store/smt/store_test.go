@@ -0,0 +1,316 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	ics23 "github.com/confio/ics23/go"
+	iavltree "github.com/cosmos/iavl"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newStore(t *testing.T, db dbm.DB, id types.CommitID) *Store {
+	t.Helper()
+	st, err := LoadStore(db, log.NewNopLogger(), types.NewKVStoreKey("test"), id)
+	require.NoError(t, err)
+	return st.(*Store)
+}
+
+// rootHash computes the root of the tree holding the values from scratch.
+func rootHash(values map[string]string) []byte {
+	leaves := make([]*node, 0, len(values))
+	for k, v := range values {
+		leaves = append(leaves, newLeaf([]byte(k), []byte(v)))
+	}
+	var hash func(leaves []*node, depth int) []byte
+	hash = func(leaves []*node, depth int) []byte {
+		switch len(leaves) {
+		case 0:
+			return emptyHash
+		case 1:
+			return leaves[0].hash()
+		}
+		var left, right []*node
+		for _, leaf := range leaves {
+			if bit(leaf.path, depth) {
+				right = append(right, leaf)
+			} else {
+				left = append(left, leaf)
+			}
+		}
+		h := sha256.New()
+		h.Write(innerPrefix)
+		h.Write(hash(left, depth+1))
+		h.Write(hash(right, depth+1))
+		return h.Sum(nil)
+	}
+	return hash(leaves, 0)
+}
+
+func requireValues(t *testing.T, st types.KVStore, values map[string]string) {
+	t.Helper()
+	keys := make([]string, 0, len(values))
+	for k, v := range values {
+		keys = append(keys, k)
+		require.Equal(t, []byte(v), st.Get([]byte(k)), k)
+	}
+	sort.Strings(keys)
+
+	var iterated []string
+	it := st.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		iterated = append(iterated, string(it.Key()))
+		require.Equal(t, []byte(values[string(it.Key())]), it.Value())
+	}
+	require.NoError(t, it.Close())
+	requireKeys(t, keys, iterated)
+
+	iterated = nil
+	it = st.ReverseIterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		iterated = append([]string{string(it.Key())}, iterated...)
+	}
+	require.NoError(t, it.Close())
+	requireKeys(t, keys, iterated)
+}
+
+func requireKeys(t *testing.T, expected, actual []string) {
+	t.Helper()
+	if len(expected) == 0 {
+		require.Empty(t, actual)
+		return
+	}
+	require.Equal(t, expected, actual)
+}
+
+func TestStoreCommit(t *testing.T) {
+	db := dbm.NewMemDB()
+	st := newStore(t, db, types.CommitID{})
+	require.Equal(t, types.StoreTypeSMT, st.GetStoreType())
+
+	r := rand.New(rand.NewSource(1))
+	history := make(map[int64]map[string]string)
+	values := make(map[string]string)
+	var last types.CommitID
+	for version := int64(1); version <= 20; version++ {
+		for i := 0; i < 50; i++ {
+			// keys sharing prefixes and holding zero bytes
+			key := fmt.Sprintf("k%d\x00%d", r.Intn(40), r.Intn(3))
+			if r.Intn(3) == 0 {
+				st.Delete([]byte(key))
+				delete(values, key)
+			} else {
+				value := fmt.Sprintf("v%d", r.Intn(1000))
+				st.Set([]byte(key), []byte(value))
+				values[key] = value
+			}
+		}
+		requireValues(t, st, values)
+
+		last = st.Commit()
+		require.Equal(t, version, last.Version)
+		require.Equal(t, rootHash(values), last.Hash)
+
+		history[version] = make(map[string]string, len(values))
+		for k, v := range values {
+			history[version][k] = v
+		}
+	}
+
+	// every version is readable, and the reloaded store is at the last one
+	for version, values := range history {
+		immutable, err := st.GetImmutable(version)
+		require.NoError(t, err)
+		requireValues(t, immutable, values)
+		require.Panics(t, func() { immutable.Set([]byte("k"), []byte("v")) })
+	}
+	reloaded := newStore(t, db, last)
+	requireValues(t, reloaded, values)
+	require.Equal(t, last, reloaded.LastCommitID())
+
+	_, err := LoadStore(db, log.NewNopLogger(), types.NewKVStoreKey("test"), types.CommitID{Version: 21})
+	require.ErrorIs(t, err, ErrVersionDoesNotExist)
+}
+
+func TestStoreProofs(t *testing.T) {
+	st := newStore(t, dbm.NewMemDB(), types.CommitID{})
+	values := make(map[string]string)
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("key%d", i)
+		st.Set([]byte(key), []byte(fmt.Sprintf("value%d", i)))
+		values[key] = fmt.Sprintf("value%d", i)
+	}
+	id := st.Commit()
+	// the next version doesn't change the proofs of the previous one
+	st.Set([]byte("key0"), []byte("changed"))
+	st.Commit()
+
+	for i := 0; i < 120; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		res := st.Query(abci.RequestQuery{Path: "/key", Data: key, Height: id.Version, Prove: true})
+		require.EqualValues(t, 0, res.Code, res.Log)
+		require.Len(t, res.ProofOps.Ops, 1)
+
+		op, err := types.CommitmentOpDecoder(res.ProofOps.Ops[0])
+		require.NoError(t, err)
+		var args [][]byte
+		if i < 100 {
+			require.Equal(t, []byte(values[string(key)]), res.Value)
+			args = [][]byte{res.Value}
+		} else {
+			require.Nil(t, res.Value)
+		}
+		root, err := op.Run(args)
+		require.NoError(t, err, string(key))
+		require.Equal(t, [][]byte{id.Hash}, root)
+
+		path := sha256.Sum256(key)
+		require.Equal(t, path[:], op.GetKey())
+		proof := op.(types.CommitmentOp).Proof
+		if i < 100 {
+			require.True(t, ics23.VerifyMembership(ics23.SmtSpec, id.Hash, proof, path[:], res.Value))
+			require.False(t, ics23.VerifyMembership(ics23.SmtSpec, id.Hash, proof, path[:], []byte("other")))
+		} else {
+			require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, id.Hash, proof, path[:]))
+		}
+	}
+
+	res := st.Query(abci.RequestQuery{Path: "/key", Data: []byte("key1"), Height: 10})
+	require.Equal(t, ErrVersionDoesNotExist.Error(), res.Log)
+}
+
+func TestStorePruneAndRollback(t *testing.T) {
+	db := dbm.NewMemDB()
+	st := newStore(t, db, types.CommitID{})
+	history := make(map[int64]map[string]string)
+	values := make(map[string]string)
+	ids := make(map[int64]types.CommitID)
+	for version := int64(1); version <= 10; version++ {
+		for i := 0; i < 10; i++ {
+			key := fmt.Sprintf("k%d", (int(version)+i)%15)
+			if i%4 == 0 {
+				st.Delete([]byte(key))
+				delete(values, key)
+			} else {
+				st.Set([]byte(key), []byte(fmt.Sprintf("%d-%d", version, i)))
+				values[key] = fmt.Sprintf("%d-%d", version, i)
+			}
+		}
+		ids[version] = st.Commit()
+		history[version] = make(map[string]string)
+		for k, v := range values {
+			history[version][k] = v
+		}
+	}
+	countKeys := func() int {
+		n := 0
+		it, err := db.Iterator(nil, nil)
+		require.NoError(t, err)
+		for ; it.Valid(); it.Next() {
+			n++
+		}
+		require.NoError(t, it.Close())
+		return n
+	}
+
+	require.Error(t, st.DeleteVersions(10))
+	before := countKeys()
+	require.NoError(t, st.DeleteVersions(1, 2, 3, 5, 6, 8))
+	require.Less(t, countKeys(), before)
+	for version, values := range history {
+		immutable, err := st.GetImmutable(version)
+		switch version {
+		case 1, 2, 3, 5, 6, 8:
+			require.ErrorIs(t, err, ErrVersionDoesNotExist)
+		default:
+			require.NoError(t, err)
+			requireValues(t, immutable, values)
+		}
+	}
+
+	// pruning all but the last version leaves only the nodes and values of its tree
+	require.NoError(t, st.DeleteVersions(4, 7, 9))
+	requireValues(t, newStore(t, db, ids[10]), history[10])
+	fresh := newStore(t, dbm.NewMemDB(), types.CommitID{})
+	for k, v := range history[10] {
+		fresh.Set([]byte(k), []byte(v))
+	}
+	fresh.SetInitialVersion(10)
+	require.Equal(t, ids[10], fresh.Commit())
+	require.Equal(t, countNodes(t, fresh.db), countNodes(t, db))
+
+	// loading a version deletes the later ones, which are committed again
+	st = newStore(t, db, ids[10])
+	st.Set([]byte("a"), []byte("b"))
+	st.Commit()
+	st.Set([]byte("c"), []byte("d"))
+	st.Commit()
+	st = newStore(t, db, ids[10])
+	require.False(t, st.VersionExists(11))
+	requireValues(t, st, history[10])
+	require.Equal(t, countNodes(t, fresh.db), countNodes(t, db))
+}
+
+func countNodes(t *testing.T, db dbm.DB) int {
+	t.Helper()
+	n := 0
+	it, err := dbm.IteratePrefix(db, nodePrefix)
+	require.NoError(t, err)
+	for ; it.Valid(); it.Next() {
+		n++
+	}
+	require.NoError(t, it.Close())
+	return n
+}
+
+func TestStoreExportImport(t *testing.T) {
+	st := newStore(t, dbm.NewMemDB(), types.CommitID{})
+	values := make(map[string]string)
+	for i := 0; i < importBatchSize+100; i++ {
+		key := fmt.Sprintf("key%d", i)
+		st.Set([]byte(key), []byte(key))
+		values[key] = key
+	}
+	id := st.Commit()
+	st.Set([]byte("key1"), []byte("changed"))
+	st.Commit()
+
+	exporter, err := st.Export(id.Version)
+	require.NoError(t, err)
+	defer exporter.Close()
+
+	db := dbm.NewMemDB()
+	target := newStore(t, db, types.CommitID{})
+	// an import which isn't committed is dropped
+	importer, err := target.Import(id.Version)
+	require.NoError(t, err)
+	require.NoError(t, importer.Add(&iavltree.ExportNode{Key: []byte("stale"), Value: []byte("stale")}))
+	importer.Close()
+
+	importer, err = target.Import(id.Version)
+	require.NoError(t, err)
+	defer importer.Close()
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ErrorExportDone {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, importer.Add(node))
+	}
+	require.NoError(t, importer.Commit())
+
+	require.Equal(t, id, target.LastCommitID())
+	requireValues(t, target, values)
+	require.Equal(t, id, newStore(t, db, id).LastCommitID())
+	require.True(t, bytes.Equal(id.Hash, rootHash(values)))
+}
@@ -0,0 +1,213 @@
+package smt
+
+import (
+	"bytes"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+// getNode reads a committed node.
+func getNode(db dbm.DB, ref nodeRef) (*node, error) {
+	bz, err := db.Get(nodeKey(ref))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("node %d/%X not found", ref.version, ref.hash)
+	}
+	return decodeNode(bz)
+}
+
+// getRoot reads the root of a committed version.
+func getRoot(db dbm.DB, version int64) (nodeRef, bool, error) {
+	bz, err := db.Get(rootKey(version))
+	if err != nil || bz == nil {
+		return nodeRef{}, false, err
+	}
+	ref, err := decodeRef(bz)
+	return ref, err == nil, err
+}
+
+// treeWriter applies the writes of a version to the tree. The nodes it creates are kept in memory until they
+// are flushed into a batch, and the nodes of the previous versions it replaces are recorded as orphans.
+type treeWriter struct {
+	db      dbm.DB
+	version int64
+	root    nodeRef
+
+	pending map[string]*node
+	// flushed are the nodes of the version already flushed and replaced since, they are deleted at the next flush.
+	flushed [][]byte
+	orphans []nodeRef
+}
+
+func newTreeWriter(db dbm.DB, version int64, root nodeRef) *treeWriter {
+	return &treeWriter{db: db, version: version, root: root, pending: make(map[string]*node)}
+}
+
+func (w *treeWriter) get(ref nodeRef) (*node, error) {
+	if ref.version == w.version {
+		if n, ok := w.pending[string(ref.hash)]; ok {
+			return n, nil
+		}
+	}
+	return getNode(w.db, ref)
+}
+
+func (w *treeWriter) add(n *node) nodeRef {
+	ref := nodeRef{version: w.version, hash: n.hash()}
+	w.pending[string(ref.hash)] = n
+	return ref
+}
+
+func (w *treeWriter) orphan(ref nodeRef) {
+	if ref.version != w.version {
+		w.orphans = append(w.orphans, ref)
+		return
+	}
+	if _, ok := w.pending[string(ref.hash)]; ok {
+		delete(w.pending, string(ref.hash))
+	} else {
+		w.flushed = append(w.flushed, nodeKey(ref))
+	}
+}
+
+func (w *treeWriter) inner(right bool, child, sibling nodeRef) nodeRef {
+	if right {
+		return w.add(&node{left: sibling, right: child})
+	}
+	return w.add(&node{left: child, right: sibling})
+}
+
+// set sets the leaf of a key.
+func (w *treeWriter) set(key, value []byte) error {
+	root, _, err := w.update(w.root, 0, newLeaf(key, value))
+	if err != nil {
+		return err
+	}
+	w.root = root
+	return nil
+}
+
+// remove removes the leaf of a key.
+func (w *treeWriter) remove(key []byte) error {
+	root, _, err := w.delete(w.root, 0, newLeaf(key, nil).path)
+	if err != nil {
+		return err
+	}
+	w.root = root
+	return nil
+}
+
+func (w *treeWriter) update(ref nodeRef, depth int, leaf *node) (nodeRef, bool, error) {
+	if ref.isEmpty() {
+		return w.add(leaf), true, nil
+	}
+	n, err := w.get(ref)
+	if err != nil {
+		return nodeRef{}, false, err
+	}
+
+	if n.leaf {
+		if bytes.Equal(n.path, leaf.path) {
+			if bytes.Equal(n.valueHash, leaf.valueHash) {
+				return ref, false, nil
+			}
+			w.orphan(ref)
+			return w.add(leaf), true, nil
+		}
+		return w.split(depth, ref, n.path, leaf), true, nil
+	}
+
+	right := bit(leaf.path, depth)
+	child, changed, err := w.update(n.child(right), depth+1, leaf)
+	if err != nil || !changed {
+		return ref, false, err
+	}
+	w.orphan(ref)
+	return w.inner(right, child, n.child(!right)), true, nil
+}
+
+// split replaces a leaf by the subtree holding it and a new leaf.
+func (w *treeWriter) split(depth int, ref nodeRef, path []byte, leaf *node) nodeRef {
+	diverge := depth
+	for bit(path, diverge) == bit(leaf.path, diverge) {
+		diverge++
+	}
+	top := w.inner(bit(leaf.path, diverge), w.add(leaf), ref)
+	for d := diverge - 1; d >= depth; d-- {
+		top = w.inner(bit(leaf.path, d), top, emptyRef)
+	}
+	return top
+}
+
+func (w *treeWriter) delete(ref nodeRef, depth int, path []byte) (nodeRef, bool, error) {
+	if ref.isEmpty() {
+		return ref, false, nil
+	}
+	n, err := w.get(ref)
+	if err != nil {
+		return nodeRef{}, false, err
+	}
+
+	if n.leaf {
+		if !bytes.Equal(n.path, path) {
+			return ref, false, nil
+		}
+		w.orphan(ref)
+		return emptyRef, true, nil
+	}
+
+	right := bit(path, depth)
+	child, changed, err := w.delete(n.child(right), depth+1, path)
+	if err != nil || !changed {
+		return ref, false, err
+	}
+	w.orphan(ref)
+
+	// a subtree left with a single leaf is replaced by the leaf
+	sibling := n.child(!right)
+	switch {
+	case child.isEmpty():
+		siblingNode, err := w.get(sibling)
+		if err != nil {
+			return nodeRef{}, false, err
+		}
+		if siblingNode.leaf {
+			return sibling, true, nil
+		}
+	case sibling.isEmpty():
+		childNode, err := w.get(child)
+		if err != nil {
+			return nodeRef{}, false, err
+		}
+		if childNode.leaf {
+			return child, true, nil
+		}
+	}
+	return w.inner(right, child, sibling), true, nil
+}
+
+// flush writes the pending nodes and the orphans into the batch.
+func (w *treeWriter) flush(batch dbm.Batch) error {
+	for _, key := range w.flushed {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	for hash, n := range w.pending {
+		if err := batch.Set(nodeKey(nodeRef{version: w.version, hash: []byte(hash)}), n.encode()); err != nil {
+			return err
+		}
+	}
+	for _, ref := range w.orphans {
+		if err := batch.Set(orphanKey(w.version, ref), []byte{}); err != nil {
+			return err
+		}
+	}
+	w.pending = make(map[string]*node)
+	w.flushed = nil
+	w.orphans = nil
+	return nil
+}
@@ -0,0 +1,169 @@
+package smt
+
+import (
+	"bytes"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// The values are stored apart from the tree, by key and version, so a version is read without walking the
+// tree and iterated in the order of the keys. A value is stored as 0x00 || value, and a deletion as 0x01.
+
+var (
+	valueSet    = []byte{0}
+	valueDelete = []byte{1}
+)
+
+func encodeValue(value []byte) []byte {
+	if value == nil {
+		return valueDelete
+	}
+	return append(append([]byte{}, valueSet...), value...)
+}
+
+func decodeValue(bz []byte) []byte {
+	if len(bz) == 0 || bz[0] != valueSet[0] {
+		return nil
+	}
+	return bz[1:]
+}
+
+// getValue reads the value of a key at a version, the last one written at or before it.
+func getValue(db dbm.DB, key []byte, version int64) ([]byte, error) {
+	value, _, err := lastValue(db, key, version)
+	return value, err
+}
+
+// lastValue returns the last value written for a key at or before a version and the version it was written
+// at, 0 if none was.
+func lastValue(db dbm.DB, key []byte, version int64) ([]byte, int64, error) {
+	it, err := db.ReverseIterator(valueKey(key, 0), valueKey(key, version+1))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return nil, 0, it.Error()
+	}
+	_, last, err := decodeValueKey(it.Key())
+	if err != nil {
+		return nil, 0, err
+	}
+	return bytes.Clone(decodeValue(it.Value())), last, nil
+}
+
+// valueIterator iterates the values of a version over a domain of keys.
+type valueIterator struct {
+	it         dbm.Iterator
+	version    int64
+	start, end []byte
+	reverse    bool
+
+	key, value []byte
+	err        error
+}
+
+var _ types.Iterator = (*valueIterator)(nil)
+
+func newValueIterator(db dbm.DB, version int64, start, end []byte, reverse bool) (*valueIterator, error) {
+	lower := valuePrefix
+	if start != nil {
+		lower = append(append([]byte{}, valuePrefix...), escapeKey(start)...)
+	}
+	upper := types.PrefixEndBytes(valuePrefix)
+	if end != nil {
+		upper = append(append([]byte{}, valuePrefix...), escapeKey(end)...)
+	}
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		it, err = db.ReverseIterator(lower, upper)
+	} else {
+		it, err = db.Iterator(lower, upper)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vi := &valueIterator{it: it, version: version, start: start, end: end, reverse: reverse}
+	vi.Next()
+	return vi, nil
+}
+
+// Domain implements Iterator.
+func (vi *valueIterator) Domain() (start, end []byte) {
+	return vi.start, vi.end
+}
+
+// Valid implements Iterator.
+func (vi *valueIterator) Valid() bool {
+	return vi.key != nil
+}
+
+// Next implements Iterator. It moves to the next key whose value at the version isn't deleted. Within a key
+// the values are sorted by version, the value of the version is the last one written at or before it.
+func (vi *valueIterator) Next() {
+	vi.key, vi.value = nil, nil
+	for vi.err == nil && vi.it.Valid() {
+		key, _, err := decodeValueKey(vi.it.Key())
+		if err != nil {
+			vi.err = err
+			return
+		}
+
+		var value []byte
+		found := false
+		for ; vi.it.Valid(); vi.it.Next() {
+			k, version, err := decodeValueKey(vi.it.Key())
+			if err != nil {
+				vi.err = err
+				return
+			}
+			if !bytes.Equal(k, key) {
+				break
+			}
+			if version <= vi.version && (!found || !vi.reverse) {
+				value, found = bytes.Clone(decodeValue(vi.it.Value())), true
+			}
+		}
+
+		if value != nil {
+			vi.key, vi.value = key, value
+			return
+		}
+	}
+	if vi.err == nil {
+		vi.err = vi.it.Error()
+	}
+}
+
+// Key implements Iterator.
+func (vi *valueIterator) Key() []byte {
+	if !vi.Valid() {
+		panic("iterator is invalid")
+	}
+	return vi.key
+}
+
+// Value implements Iterator.
+func (vi *valueIterator) Value() []byte {
+	if !vi.Valid() {
+		panic("iterator is invalid")
+	}
+	return vi.value
+}
+
+// Error implements Iterator.
+func (vi *valueIterator) Error() error {
+	return vi.err
+}
+
+// Close implements Iterator.
+func (vi *valueIterator) Close() error {
+	return vi.it.Close()
+}
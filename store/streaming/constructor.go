@@ -8,15 +8,19 @@ import (
 	"sync"
 
 	"github.com/cometbft/cometbft/libs/log"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/cast"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/crosschain"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
 )
 
 // ServiceConstructor is used to construct a streaming service
@@ -28,6 +32,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	CrossChain
 )
 
 // Streaming option keys
@@ -37,6 +42,11 @@ const (
 	OptStreamersFileOutputMetadata  = "streamers.file.output-metadata"
 	OptStreamersFileStopNodeOnError = "streamers.file.stop-node-on-error"
 	OptStreamersFileFsync           = "streamers.file.fsync"
+	OptStreamersFileDeleteAcked     = "streamers.file.delete-acked"
+
+	OptStreamersCrossChainWriteDir        = "streamers.crosschain.write_dir"
+	OptStreamersCrossChainStopNodeOnError = "streamers.crosschain.stop-node-on-error"
+	OptStreamersCrossChainFsync           = "streamers.crosschain.fsync"
 
 	OptStoreStreamers = "store.streamers"
 )
@@ -48,6 +58,9 @@ func ServiceTypeFromString(name string) ServiceType {
 	case "file", "f":
 		return File
 
+	case "crosschain":
+		return CrossChain
+
 	default:
 		return Unknown
 	}
@@ -59,6 +72,9 @@ func (sst ServiceType) String() string {
 	case File:
 		return "file"
 
+	case CrossChain:
+		return "crosschain"
+
 	default:
 		return "unknown"
 	}
@@ -67,7 +83,8 @@ func (sst ServiceType) String() string {
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to
 // streaming.ServiceConstructors types.
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:       NewFileStreamingService,
+	CrossChain: NewCrossChainStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding
@@ -99,6 +116,7 @@ func NewFileStreamingService(
 	outputMetadata := cast.ToBool(opts.Get(OptStreamersFileOutputMetadata))
 	stopNodeOnErr := cast.ToBool(opts.Get(OptStreamersFileStopNodeOnError))
 	fsync := cast.ToBool(opts.Get(OptStreamersFileFsync))
+	deleteAcked := cast.ToBool(opts.Get(OptStreamersFileDeleteAcked))
 
 	// relative path is based on node home directory.
 	if !path.IsAbs(fileDir) {
@@ -112,7 +130,49 @@ func NewFileStreamingService(
 		}
 	}
 
-	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, logger, outputMetadata, stopNodeOnErr, fsync)
+	fss, err := file.NewStreamingService(fileDir, filePrefix, keys, marshaller, logger, outputMetadata, stopNodeOnErr, fsync)
+	if err != nil {
+		return nil, err
+	}
+	fss.SetDeleteAcked(deleteAcked)
+
+	return fss, nil
+}
+
+// NewCrossChainStreamingService is the streaming.ServiceConstructor function for
+// creating a crosschain.StreamingService, it listens to the crosschain store
+// which must be among the exposed keys.
+func NewCrossChainStreamingService(
+	opts servertypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+	logger log.Logger,
+) (baseapp.StreamingService, error) {
+	homePath := cast.ToString(opts.Get(flags.FlagHome))
+	writeDir := cast.ToString(opts.Get(OptStreamersCrossChainWriteDir))
+	stopNodeOnErr := cast.ToBool(opts.Get(OptStreamersCrossChainStopNodeOnError))
+	fsync := cast.ToBool(opts.Get(OptStreamersCrossChainFsync))
+
+	var storeKey types.StoreKey
+	for _, key := range keys {
+		if key.Name() == crosschaintypes.StoreKey {
+			storeKey = key
+		}
+	}
+	if storeKey == nil {
+		return nil, fmt.Errorf("the %s store is not exposed to the crosschain streaming service", crosschaintypes.StoreKey)
+	}
+
+	// relative path is based on node home directory.
+	if !path.IsAbs(writeDir) {
+		writeDir = path.Join(homePath, writeDir)
+	}
+
+	if err := os.MkdirAll(writeDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	return crosschain.NewStreamingService(writeDir, storeKey, logger, stopNodeOnErr, fsync)
 }
 
 // LoadStreamingServices is a function for loading StreamingServices onto the
@@ -185,6 +245,25 @@ func LoadStreamingServices(
 		// register the streaming service with the BaseApp
 		bApp.SetStreamingService(streamingService)
 
+		// let the streaming service regenerate the blocks it missed from the
+		// application DB, and keep the heights it needs from pruning
+		if s, ok := streamingService.(interface {
+			SetRecoveryStore(types.CommitMultiStore)
+		}); ok {
+			s.SetRecoveryStore(bApp.CommitMultiStore())
+		}
+		if s, ok := streamingService.(interface {
+			RetentionConstraint(sdk.Context) []pruningtypes.PinnedHeights
+		}); ok {
+			bApp.AddRetentionConstraint(s.RetentionConstraint)
+		}
+		// let the streaming service serve its output over the gRPC server of the node
+		if s, ok := streamingService.(interface {
+			RegisterGRPCServer(gogogrpc.Server)
+		}); ok {
+			s.RegisterGRPCServer(bApp.GRPCQueryRouter())
+		}
+
 		// kick off the background streaming service loop
 		streamingService.Stream(wg)
 
@@ -12,6 +12,7 @@ import (
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
+	"github.com/cosmos/cosmos-sdk/store/streaming/crosschain"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -55,6 +56,33 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+type crossChainOptions struct {
+	writeDir string
+}
+
+func (o crossChainOptions) Get(key string) interface{} {
+	if key == "streamers.crosschain.write_dir" {
+		return o.writeDir
+	}
+	return nil
+}
+
+func TestCrossChainStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("crosschain")
+	require.Nil(t, err)
+
+	opts := crossChainOptions{writeDir: t.TempDir()}
+	_, err = constructor(opts, mockKeys, testMarshaller, log.NewNopLogger())
+	require.Error(t, err)
+
+	key := sdk.NewKVStoreKey("crosschain")
+	serv, err := constructor(opts, append([]types.StoreKey{key}, mockKeys...), testMarshaller, log.NewNopLogger())
+	require.Nil(t, err)
+	require.IsType(t, &crosschain.StreamingService{}, serv)
+	require.Len(t, serv.Listeners(), 1)
+	require.Contains(t, serv.Listeners(), types.StoreKey(key))
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := testutil.MakeTestEncodingConfig()
@@ -0,0 +1,92 @@
+package crosschain
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxQueryRecords is the maximum number of records returned by a Records gRPC query.
+const MaxQueryRecords = 1000
+
+var _ node.CrossChainRecordsServer = queryServer{}
+
+// queryServer serves the records written to a directory over gRPC.
+type queryServer struct {
+	dir string
+}
+
+// NewQueryServer returns the gRPC server of the records written to dir.
+func NewQueryServer(dir string) node.CrossChainRecordsServer {
+	return queryServer{dir: dir}
+}
+
+// RegisterGRPCServer registers the gRPC service of the records of the service on a gRPC router, such as the gRPC
+// query router of the app, for the node to serve its own records.
+func (fss *StreamingService) RegisterGRPCServer(server gogogrpc.Server) {
+	node.RegisterCrossChainRecordsServer(server, NewQueryServer(fss.writeDir))
+}
+
+// Records implements the Records gRPC query, with the filters of ReadRecords.
+func (s queryServer) Records(_ context.Context, req *node.CrossChainRecordsRequest) (*node.CrossChainRecordsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	filter := Filter{
+		FromIndex: req.FromIndex,
+		Limit:     MaxQueryRecords,
+		MinHeight: req.MinHeight,
+		MaxHeight: req.MaxHeight,
+	}
+	if req.Limit > 0 && req.Limit < MaxQueryRecords {
+		filter.Limit = int(req.Limit)
+	}
+	for _, t := range req.RecordTypes {
+		if err := ValidateRecordType(RecordType(t)); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		filter.Types = append(filter.Types, RecordType(t))
+	}
+	if req.FilterChannel {
+		channelID := req.ChannelId
+		filter.ChannelID = &channelID
+	}
+
+	records, err := ReadRecords(s.dir, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &node.CrossChainRecordsResponse{NextIndex: req.FromIndex, Records: make([]*node.CrossChainRecord, len(records))}
+	for i, record := range records {
+		resp.Records[i] = &node.CrossChainRecord{
+			Index:            record.Index,
+			Height:           record.Height,
+			TxIndex:          int32(record.TxIndex),
+			RecordType:       string(record.Type),
+			Direction:        record.Direction,
+			SrcChainId:       record.SrcChainID,
+			DestChainId:      record.DestChainID,
+			ChannelId:        record.ChannelID,
+			Sequence:         record.Sequence,
+			PackageType:      record.PackageType,
+			Timestamp:        record.Timestamp,
+			Payload:          record.Payload,
+			RelayerFee:       record.RelayerFee,
+			AckRelayerFee:    record.AckRelayerFee,
+			Outcome:          record.Outcome,
+			Error:            record.Error,
+			AckPackageType:   record.AckPackageType,
+			ExpectedSequence: record.ExpectedSequence,
+		}
+		if record.AckSequence != nil {
+			resp.Records[i].AckSequence = *record.AckSequence
+		}
+		resp.NextIndex = record.Index + 1
+	}
+	return resp, nil
+}
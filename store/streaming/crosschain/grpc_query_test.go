@@ -0,0 +1,66 @@
+package crosschain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+)
+
+func TestQueryRecords(t *testing.T) {
+	dir := t.TempDir()
+	fss, err := NewStreamingService(dir, testStoreKey, log.NewNopLogger(), true, false)
+	require.NoError(t, err)
+	deliverTestBlock(t, fss, testBlock{
+		height: 1,
+		writes: []types.StoreKVPair{
+			packageWrite(sdk.SynCrossChainPackageType, 0, 7, 3),
+			packageWrite(sdk.SynCrossChainPackageType, 2, 0, 0),
+		},
+	})
+	deliverTestBlock(t, fss, testBlock{
+		height: 2,
+		writes: []types.StoreKVPair{sequenceWrite(crosschaintypes.PrefixForSendSequenceKey, testChannelID, 5)},
+	})
+
+	server := NewQueryServer(dir)
+	ctx := context.Background()
+
+	res, err := server.Records(ctx, &node.CrossChainRecordsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 5)
+	require.Equal(t, uint64(5), res.NextIndex)
+	require.Equal(t, &node.CrossChainRecord{
+		Index: 0, Height: 1, TxIndex: -1, RecordType: string(RecordPackageSent), Direction: DirectionSend,
+		SrcChainId: testSrcChainID, DestChainId: testDestChainID, ChannelId: testChannelID, Sequence: 0,
+		PackageType: "syn", Timestamp: 1000, Payload: "abcd", RelayerFee: "7", AckRelayerFee: "3",
+	}, res.Records[0])
+
+	// the filters of ReadRecords, and the paging with the next index
+	res, err = server.Records(ctx, &node.CrossChainRecordsRequest{
+		RecordTypes: []string{string(RecordSequenceGap)}, MinHeight: 2, ChannelId: testChannelID, FilterChannel: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, uint64(3), res.Records[0].ExpectedSequence)
+	require.Equal(t, uint64(5), res.NextIndex)
+
+	res, err = server.Records(ctx, &node.CrossChainRecordsRequest{FromIndex: 1, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 2)
+	require.Equal(t, uint64(3), res.NextIndex)
+
+	res, err = server.Records(ctx, &node.CrossChainRecordsRequest{ChannelId: 9, FilterChannel: true})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+
+	_, err = server.Records(ctx, &node.CrossChainRecordsRequest{RecordTypes: []string{"unknown"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
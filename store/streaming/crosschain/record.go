@@ -0,0 +1,184 @@
+package crosschain
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RecordsFileName is the name of the file the records are appended to, one JSON record per line.
+	RecordsFileName = "records.jsonl"
+	// CursorFileName is the name of the file holding the height of the last block recorded.
+	CursorFileName = "cursor"
+)
+
+// RecordType is the type of a cross-chain record.
+type RecordType string
+
+const (
+	// RecordPackageSent is a package written to the crosschain store to be relayed to a destination chain.
+	RecordPackageSent RecordType = "package_sent"
+	// RecordPackageReceived is a package claimed from a source chain through the oracle module.
+	RecordPackageReceived RecordType = "package_received"
+	// RecordRelayerFee is a relayer fee charged to the sender of a package, or paid to the relayers of a claim.
+	RecordRelayerFee RecordType = "relayer_fee"
+	// RecordSequenceGap is a sequence of a channel which doesn't follow the previous one.
+	RecordSequenceGap RecordType = "sequence_gap"
+)
+
+// ValidateRecordType returns an error if the record type is unknown.
+func ValidateRecordType(recordType RecordType) error {
+	switch recordType {
+	case RecordPackageSent, RecordPackageReceived, RecordRelayerFee, RecordSequenceGap:
+		return nil
+	default:
+		return fmt.Errorf("unknown record type %q", recordType)
+	}
+}
+
+// Directions of the packages, fees and sequences of the records.
+const (
+	DirectionSend    = "send"
+	DirectionReceive = "receive"
+)
+
+// Outcomes of the received packages.
+const (
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+	OutcomeCrash   = "crash"
+)
+
+// Record is a normalized cross-chain record. The fields which don't apply to the type of the record are omitted.
+type Record struct {
+	// Index is the position of the record in the stream, starting at 0.
+	Index uint64 `json:"index"`
+	// Height is the height of the block the record comes from.
+	Height int64 `json:"height"`
+	// TxIndex is the index of the tx of the record in the block, -1 if it doesn't come from a tx.
+	TxIndex int        `json:"tx_index"`
+	Type    RecordType `json:"type"`
+	// Direction is DirectionSend for the packages sent by this chain, DirectionReceive for the received ones.
+	Direction   string `json:"direction"`
+	SrcChainID  uint32 `json:"src_chain_id"`
+	DestChainID uint32 `json:"dest_chain_id"`
+	ChannelID   uint32 `json:"channel_id"`
+	Sequence    uint64 `json:"sequence"`
+	// PackageType is the type of the package: syn, ack or fail_ack.
+	PackageType string `json:"package_type,omitempty"`
+	Timestamp   uint64 `json:"timestamp,omitempty"`
+	// Payload is the hex encoded payload of a sent package, without its header.
+	Payload       string `json:"payload,omitempty"`
+	RelayerFee    string `json:"relayer_fee,omitempty"`
+	AckRelayerFee string `json:"ack_relayer_fee,omitempty"`
+	// Outcome is the outcome of the execution of a received package: success, error or crash.
+	Outcome string `json:"outcome,omitempty"`
+	Error   string `json:"error,omitempty"`
+	// AckPackageType is the type of the package sent back for a received syn package, ack or fail_ack, and
+	// AckSequence its sequence.
+	AckPackageType string  `json:"ack_package_type,omitempty"`
+	AckSequence    *uint64 `json:"ack_sequence,omitempty"`
+	// ExpectedSequence is the sequence a gap record expected instead of Sequence.
+	ExpectedSequence uint64 `json:"expected_sequence,omitempty"`
+}
+
+// packageTypeName returns the name of a package type in the records.
+func packageTypeName(packageType sdk.CrossChainPackageType) string {
+	switch packageType {
+	case sdk.SynCrossChainPackageType:
+		return "syn"
+	case sdk.AckCrossChainPackageType:
+		return "ack"
+	case sdk.FailAckCrossChainPackageType:
+		return "fail_ack"
+	default:
+		return fmt.Sprintf("unknown_%d", packageType)
+	}
+}
+
+// Filter selects the records returned by ReadRecords.
+type Filter struct {
+	// FromIndex is the index of the first record returned.
+	FromIndex uint64
+	// Limit is the maximum number of records returned, zero for no limit.
+	Limit int
+	// Types are the types of the records returned, all of them if empty.
+	Types []RecordType
+	// MinHeight and MaxHeight bound the heights of the records returned, zero for no bound.
+	MinHeight int64
+	MaxHeight int64
+	// ChannelID is the channel of the records returned, any channel if nil.
+	ChannelID *uint32
+}
+
+func (f Filter) match(record Record) bool {
+	if record.Index < f.FromIndex {
+		return false
+	}
+	if f.MinHeight > 0 && record.Height < f.MinHeight {
+		return false
+	}
+	if f.MaxHeight > 0 && record.Height > f.MaxHeight {
+		return false
+	}
+	if f.ChannelID != nil && record.ChannelID != *f.ChannelID {
+		return false
+	}
+	if len(f.Types) > 0 && !sdk.SliceContains(f.Types, record.Type) {
+		return false
+	}
+	return true
+}
+
+// ReadRecords returns the records written to dir which match the filter, in the order of the stream.
+func ReadRecords(dir string, filter Filter) ([]Record, error) {
+	var records []Record
+	_, err := walkRecords(filepath.Join(dir, RecordsFileName), func(record Record) bool {
+		if filter.match(record) {
+			records = append(records, record)
+		}
+		return filter.Limit <= 0 || len(records) < filter.Limit
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("no cross-chain records in %s", dir)
+	}
+	return records, err
+}
+
+// walkRecords calls fn with the complete records of the file until it returns false, and returns the size of
+// the lines read. A trailing partial line, left by an interrupted write, is ignored.
+func walkRecords(path string, fn func(Record) bool) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var size int64
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return size, nil
+		}
+		if err != nil {
+			return size, err
+		}
+
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			return size, fmt.Errorf("invalid cross-chain record %q: %w", line, err)
+		}
+		size += int64(len(line))
+		if !fn(record) {
+			return size, nil
+		}
+	}
+}
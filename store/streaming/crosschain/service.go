@@ -0,0 +1,438 @@
+package crosschain
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/streaming/internal/fileutil"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// packageKeyLength is the length of the keys of the packages in the crosschain store: the prefix, the source
+// and destination chain ids, the channel id and the sequence.
+const packageKeyLength = 1 + 2 + 2 + 1 + 8
+
+// channelKey identifies the channel of a chain the sequences are tracked for.
+type channelKey struct {
+	chainID   uint32
+	channelID uint32
+}
+
+// packageID identifies a package sent by this chain.
+type packageID struct {
+	destChainID uint32
+	channelID   uint32
+	sequence    uint64
+}
+
+// StreamingService is a StreamingService that turns the writes to the crosschain store and the cross-chain
+// events of the txs into normalized records, appended to a local file at every commit.
+//
+// The packages sent are read from the crosschain store, where they are written with their header, while the
+// received packages and their outcomes are read from the package claim events of the oracle module, whose
+// store only holds its params.
+type StreamingService struct {
+	listener *types.MemoryListener // listener of the crosschain store
+	writeDir string
+	logger   log.Logger
+
+	// stopNodeOnErr, if true, will panic and stop the node during ABCI Commit
+	// to ensure eventual consistency of the output, otherwise, any errors are
+	// logged and ignored which could yield data loss in streamed output.
+	stopNodeOnErr bool
+
+	// fsync, if true, will execute file Sync to make sure the data is persisted
+	// onto disk, otherwise there is a risk of data loss during any crash.
+	fsync bool
+
+	currentBlockNumber int64
+	txIndex            int
+	claims             []claim           // package claims of the block, in the order of the txs
+	sentTxs            map[packageID]int // index of the txs the packages of the block were sent by
+
+	nextIndex  uint64 // index of the next record
+	lastHeight int64  // height of the last block recorded
+	// next sequences of the channels, known from the records once a package or sequence of the channel is seen
+	nextSend    map[channelKey]uint64
+	nextReceive map[channelKey]uint64
+}
+
+// claim is a package claim event and the index of its tx.
+type claim struct {
+	txIndex int
+	event   *oracletypes.EventPackageClaim
+}
+
+// NewStreamingService returns a StreamingService writing the records to writeDir, which goes on with the
+// records already there. storeKey is the key of the crosschain store.
+func NewStreamingService(
+	writeDir string,
+	storeKey types.StoreKey,
+	logger log.Logger,
+	stopNodeOnErr, fsync bool,
+) (*StreamingService, error) {
+	if storeKey.Name() != crosschaintypes.StoreKey {
+		return nil, fmt.Errorf("invalid store key %s, expected %s", storeKey.Name(), crosschaintypes.StoreKey)
+	}
+
+	fss := &StreamingService{
+		listener:      types.NewMemoryListener(storeKey),
+		writeDir:      writeDir,
+		logger:        logger,
+		stopNodeOnErr: stopNodeOnErr,
+		fsync:         fsync,
+		nextSend:      make(map[channelKey]uint64),
+		nextReceive:   make(map[channelKey]uint64),
+	}
+	if err := fss.load(); err != nil {
+		return nil, err
+	}
+
+	return fss, nil
+}
+
+// load restores the state of the service from the cursor and the records of writeDir, and truncates the partial
+// record an interrupted write may have left.
+func (fss *StreamingService) load() error {
+	cursor, err := fileutil.ReadHeightFile(filepath.Join(fss.writeDir, CursorFileName))
+	if err != nil {
+		return err
+	}
+	fss.lastHeight = cursor
+
+	path := filepath.Join(fss.writeDir, RecordsFileName)
+	size, err := walkRecords(path, func(record Record) bool {
+		fss.nextIndex = record.Index + 1
+		// the records of a block are written before its cursor
+		if record.Height > fss.lastHeight {
+			fss.lastHeight = record.Height
+		}
+
+		next := fss.nextReceive
+		key := channelKey{chainID: record.SrcChainID, channelID: record.ChannelID}
+		if record.Direction == DirectionSend {
+			next = fss.nextSend
+			key.chainID = record.DestChainID
+		}
+		switch record.Type {
+		case RecordPackageSent, RecordPackageReceived:
+			next[key] = record.Sequence + 1
+		case RecordSequenceGap:
+			next[key] = record.Sequence
+		}
+		return true
+	})
+	if os.IsNotExist(err) {
+		return fileutil.IsDirWriteable(fss.writeDir)
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Truncate(path, size)
+}
+
+// Listeners satisfies the StreamingService interface. It returns the listener of the crosschain store.
+func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return map[types.StoreKey][]types.WriteListener{
+		fss.listener.StoreKey(): {fss.listener},
+	}
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It starts the records of the block.
+func (fss *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	fss.currentBlockNumber = req.Header.Height
+	fss.txIndex = 0
+	fss.claims = nil
+	fss.sentTxs = make(map[packageID]int)
+	return nil
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It collects the cross-chain events of the tx.
+func (fss *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	defer func() { fss.txIndex++ }()
+	if !res.IsOK() {
+		return nil
+	}
+
+	for _, event := range res.Events {
+		switch event.Type {
+		case proto.MessageName(&oracletypes.EventPackageClaim{}), proto.MessageName(&crosschaintypes.EventCrossChain{}):
+		default:
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			fss.logger.Error("invalid cross-chain event", "height", fss.currentBlockNumber, "type", event.Type, "err", err)
+			continue
+		}
+		switch msg := msg.(type) {
+		case *oracletypes.EventPackageClaim:
+			fss.claims = append(fss.claims, claim{txIndex: fss.txIndex, event: msg})
+		case *crosschaintypes.EventCrossChain:
+			fss.sentTxs[packageID{destChainID: msg.DestChainId, channelID: msg.ChannelId, sequence: msg.Sequence}] = fss.txIndex
+		}
+	}
+	return nil
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It performs a no-op.
+func (fss *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit satisfies the ABCIListener interface. It appends the records of the block to the records file.
+// It will only return a non-nil error when stopNodeOnErr is set.
+func (fss *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	if err := fss.doListenCommit(); err != nil {
+		fss.logger.Error("Listen commit failed", "height", fss.currentBlockNumber, "err", err)
+		if fss.stopNodeOnErr {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (fss *StreamingService) doListenCommit() error {
+	writes := fss.listener.PopStateCache()
+	// the blocks replayed after a restart were recorded already
+	if fss.currentBlockNumber <= fss.lastHeight {
+		return nil
+	}
+
+	records := fss.claimRecords()
+	records = append(records, fss.writeRecords(writes)...)
+
+	var buf bytes.Buffer
+	for i := range records {
+		records[i].Index = fss.nextIndex + uint64(i)
+		records[i].Height = fss.currentBlockNumber
+		buf.Write(mustMarshalRecord(records[i]))
+	}
+	if buf.Len() > 0 {
+		if err := fileutil.AppendFile(filepath.Join(fss.writeDir, RecordsFileName), buf.Bytes(), fss.fsync); err != nil {
+			return err
+		}
+	}
+	fss.nextIndex += uint64(len(records))
+
+	// the cursor records the blocks without records too, which aren't replayed after a restart either
+	if err := fileutil.WriteHeightFile(filepath.Join(fss.writeDir, CursorFileName), fss.currentBlockNumber, fss.fsync); err != nil {
+		return err
+	}
+	fss.lastHeight = fss.currentBlockNumber
+	return nil
+}
+
+// claimRecords returns the records of the packages received in the block.
+func (fss *StreamingService) claimRecords() []Record {
+	var records []Record
+	for _, c := range fss.claims {
+		ev := c.event
+		key := channelKey{chainID: ev.SrcChainId, channelID: ev.ChannelId}
+		records = fss.checkSequence(records, fss.nextReceive, key, Record{
+			TxIndex:     c.txIndex,
+			Direction:   DirectionReceive,
+			SrcChainID:  ev.SrcChainId,
+			DestChainID: ev.DestChainId,
+			ChannelID:   ev.ChannelId,
+		}, ev.ReceiveSequence)
+		fss.nextReceive[key] = ev.ReceiveSequence + 1
+
+		record := Record{
+			TxIndex:       c.txIndex,
+			Type:          RecordPackageReceived,
+			Direction:     DirectionReceive,
+			SrcChainID:    ev.SrcChainId,
+			DestChainID:   ev.DestChainId,
+			ChannelID:     ev.ChannelId,
+			Sequence:      ev.ReceiveSequence,
+			PackageType:   packageTypeName(sdk.CrossChainPackageType(ev.PackageType)),
+			RelayerFee:    ev.RelayerFee,
+			AckRelayerFee: ev.AckRelayerFee,
+			Outcome:       OutcomeSuccess,
+			Error:         ev.ErrorMsg,
+		}
+		switch {
+		case ev.Crash:
+			record.Outcome = OutcomeCrash
+		case ev.ErrorMsg != "":
+			record.Outcome = OutcomeError
+		}
+		if ev.SendSequence >= 0 {
+			ackSequence := uint64(ev.SendSequence)
+			record.AckSequence = &ackSequence
+			record.AckPackageType = packageTypeName(sdk.AckCrossChainPackageType)
+			if ev.Crash {
+				record.AckPackageType = packageTypeName(sdk.FailAckCrossChainPackageType)
+			}
+		}
+		records = append(records, record)
+
+		if isPositiveFee(ev.RelayerFee) {
+			records = append(records, Record{
+				TxIndex:     c.txIndex,
+				Type:        RecordRelayerFee,
+				Direction:   DirectionReceive,
+				SrcChainID:  ev.SrcChainId,
+				DestChainID: ev.DestChainId,
+				ChannelID:   ev.ChannelId,
+				Sequence:    ev.ReceiveSequence,
+				RelayerFee:  ev.RelayerFee,
+			})
+		}
+	}
+	return records
+}
+
+// writeRecords returns the records of the writes to the crosschain store of the block: the packages sent and
+// the sequences of the channels which don't follow their packages.
+func (fss *StreamingService) writeRecords(writes []types.StoreKVPair) []Record {
+	var records []Record
+	for _, write := range writes {
+		if write.Delete {
+			continue
+		}
+
+		switch {
+		case len(write.Key) == packageKeyLength && bytes.HasPrefix(write.Key, crosschaintypes.PrefixForIbcPackageKey):
+			record, err := decodeSentPackage(write.Key, write.Value)
+			if err != nil {
+				fss.logger.Error("invalid cross-chain package", "height", fss.currentBlockNumber, "key", hex.EncodeToString(write.Key), "err", err)
+				continue
+			}
+			record.TxIndex = -1
+			if txIndex, ok := fss.sentTxs[packageID{destChainID: record.DestChainID, channelID: record.ChannelID, sequence: record.Sequence}]; ok {
+				record.TxIndex = txIndex
+			}
+
+			key := channelKey{chainID: record.DestChainID, channelID: record.ChannelID}
+			records = fss.checkSequence(records, fss.nextSend, key, Record{
+				TxIndex:     record.TxIndex,
+				Direction:   DirectionSend,
+				SrcChainID:  record.SrcChainID,
+				DestChainID: record.DestChainID,
+				ChannelID:   record.ChannelID,
+			}, record.Sequence)
+			fss.nextSend[key] = record.Sequence + 1
+			records = append(records, record)
+
+			if isPositiveFee(record.RelayerFee) || isPositiveFee(record.AckRelayerFee) {
+				records = append(records, Record{
+					TxIndex:       record.TxIndex,
+					Type:          RecordRelayerFee,
+					Direction:     DirectionSend,
+					SrcChainID:    record.SrcChainID,
+					DestChainID:   record.DestChainID,
+					ChannelID:     record.ChannelID,
+					Sequence:      record.Sequence,
+					RelayerFee:    record.RelayerFee,
+					AckRelayerFee: record.AckRelayerFee,
+				})
+			}
+
+		case len(write.Key) == 4 && len(write.Value) == crosschaintypes.SequenceLength &&
+			(write.Key[0] == crosschaintypes.PrefixForSendSequenceKey[0] || write.Key[0] == crosschaintypes.PrefixForReceiveSequenceKey[0]):
+			chainID := uint32(binary.BigEndian.Uint16(write.Key[1:3]))
+			channelID := uint32(write.Key[3])
+			sequence := binary.BigEndian.Uint64(write.Value)
+
+			// the sequence of the channel of the relayed claims has no packages of its own
+			if write.Key[0] == crosschaintypes.PrefixForReceiveSequenceKey[0] && sdk.ChannelID(channelID) == oracletypes.RelayPackagesChannelId {
+				continue
+			}
+
+			next := fss.nextSend
+			gap := Record{TxIndex: -1, Direction: DirectionSend, DestChainID: chainID, ChannelID: channelID}
+			if write.Key[0] == crosschaintypes.PrefixForReceiveSequenceKey[0] {
+				next = fss.nextReceive
+				gap = Record{TxIndex: -1, Direction: DirectionReceive, SrcChainID: chainID, ChannelID: channelID}
+			}
+			key := channelKey{chainID: chainID, channelID: channelID}
+			records = fss.checkSequence(records, next, key, gap, sequence)
+			next[key] = sequence
+		}
+	}
+	return records
+}
+
+// checkSequence appends a gap record to the records if the next sequence of the channel is known and isn't
+// the given one.
+func (fss *StreamingService) checkSequence(records []Record, next map[channelKey]uint64, key channelKey, gap Record, sequence uint64) []Record {
+	expected, ok := next[key]
+	if !ok || expected == sequence {
+		return records
+	}
+
+	fss.logger.Error("cross-chain sequence gap", "height", fss.currentBlockNumber, "direction", gap.Direction,
+		"chain", key.chainID, "channel", key.channelID, "expected", expected, "sequence", sequence)
+	gap.Type = RecordSequenceGap
+	gap.Sequence = sequence
+	gap.ExpectedSequence = expected
+	return append(records, gap)
+}
+
+// decodeSentPackage returns the record of a package written to the crosschain store.
+func decodeSentPackage(key, value []byte) (Record, error) {
+	header, err := sdk.DecodePackageHeader(value)
+	if err != nil {
+		return Record{}, err
+	}
+
+	record := Record{
+		Type:        RecordPackageSent,
+		Direction:   DirectionSend,
+		SrcChainID:  uint32(binary.BigEndian.Uint16(key[1:3])),
+		DestChainID: uint32(binary.BigEndian.Uint16(key[3:5])),
+		ChannelID:   uint32(key[5]),
+		Sequence:    binary.BigEndian.Uint64(key[6:]),
+		PackageType: packageTypeName(header.PackageType),
+		Timestamp:   header.Timestamp,
+		Payload:     hex.EncodeToString(value[sdk.GetPackageHeaderLength(header.PackageType):]),
+		RelayerFee:  header.RelayerFee.String(),
+	}
+	if header.PackageType == sdk.SynCrossChainPackageType {
+		record.AckRelayerFee = header.AckRelayerFee.String()
+	}
+	return record, nil
+}
+
+// isPositiveFee returns whether the fee is a positive integer.
+func isPositiveFee(fee string) bool {
+	amount, ok := sdk.NewIntFromString(fee)
+	return ok && amount.IsPositive()
+}
+
+// mustMarshalRecord returns the line of a record in the records file.
+func mustMarshalRecord(record Record) []byte {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		panic(err)
+	}
+	return append(bz, '\n')
+}
+
+// Stream satisfies the StreamingService interface. It performs a no-op.
+func (fss *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+// Close satisfies the StreamingService interface. It performs a no-op.
+func (fss *StreamingService) Close() error { return nil }
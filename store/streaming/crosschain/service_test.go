@@ -0,0 +1,292 @@
+package crosschain
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming/internal/fileutil"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+const (
+	testSrcChainID  = 1
+	testDestChainID = 56
+	testChannelID   = 2
+)
+
+var testStoreKey = types.NewKVStoreKey(crosschaintypes.StoreKey)
+
+// testBlock is the input of a block to the streaming service.
+type testBlock struct {
+	height int64
+	txs    [][]proto.Message
+	writes []types.StoreKVPair
+}
+
+func deliverTestBlock(t *testing.T, fss *StreamingService, block testBlock) {
+	ctx := context.Background()
+	require.NoError(t, fss.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: block.height}}, abci.ResponseBeginBlock{}))
+	for _, msgs := range block.txs {
+		var res abci.ResponseDeliverTx
+		for _, msg := range msgs {
+			event, err := sdk.TypedEventToEvent(msg)
+			require.NoError(t, err)
+			res.Events = append(res.Events, abci.Event(event))
+		}
+		require.NoError(t, fss.ListenDeliverTx(ctx, abci.RequestDeliverTx{}, res))
+	}
+	// a failed tx
+	require.NoError(t, fss.ListenDeliverTx(ctx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{Code: 1}))
+	require.NoError(t, fss.ListenEndBlock(ctx, abci.RequestEndBlock{Height: block.height}, abci.ResponseEndBlock{}))
+
+	listener := fss.Listeners()[testStoreKey][0]
+	for _, write := range block.writes {
+		require.NoError(t, listener.OnWrite(testStoreKey, write.Key, write.Value, write.Delete))
+	}
+	require.NoError(t, fss.ListenCommit(ctx, abci.ResponseCommit{}))
+}
+
+func packageWrite(packageType sdk.CrossChainPackageType, sequence uint64, relayerFee, ackRelayerFee int64) types.StoreKVPair {
+	header := sdk.EncodePackageHeader(sdk.PackageHeader{
+		PackageType:   packageType,
+		Timestamp:     1000,
+		RelayerFee:    big.NewInt(relayerFee),
+		AckRelayerFee: big.NewInt(ackRelayerFee),
+	})
+	return types.StoreKVPair{
+		Key:   crosschaintypes.BuildCrossChainPackageKey(testSrcChainID, testDestChainID, testChannelID, sequence),
+		Value: append(header, 0xab, 0xcd),
+	}
+}
+
+func sequenceWrite(prefix []byte, channelID sdk.ChannelID, sequence uint64) types.StoreKVPair {
+	return types.StoreKVPair{
+		Key:   crosschaintypes.BuildChannelSequenceKey(testDestChainID, channelID, prefix),
+		Value: binary.BigEndian.AppendUint64(nil, sequence),
+	}
+}
+
+func claimEvent(sequence uint64, sendSequence int64, crash bool, relayerFee string) *oracletypes.EventPackageClaim {
+	return &oracletypes.EventPackageClaim{
+		SrcChainId:      testDestChainID,
+		DestChainId:     testSrcChainID,
+		ChannelId:       testChannelID,
+		PackageType:     uint32(sdk.SynCrossChainPackageType),
+		ReceiveSequence: sequence,
+		SendSequence:    sendSequence,
+		Crash:           crash,
+		RelayerFee:      relayerFee,
+		AckRelayerFee:   "0",
+	}
+}
+
+func sentEvent(sequence uint64) *crosschaintypes.EventCrossChain {
+	return &crosschaintypes.EventCrossChain{
+		SrcChainId:  testSrcChainID,
+		DestChainId: testDestChainID,
+		ChannelId:   testChannelID,
+		Sequence:    sequence,
+		RelayerFee:  "0",
+	}
+}
+
+func TestStreamingService(t *testing.T) {
+	dir := t.TempDir()
+	fss, err := NewStreamingService(dir, testStoreKey, log.NewNopLogger(), true, true)
+	require.NoError(t, err)
+
+	// two packages received, the first one acked and the second one crashed, then a syn package sent in the
+	// end block
+	block1 := testBlock{
+		height: 1,
+		txs: [][]proto.Message{{
+			claimEvent(0, 0, false, "10"),
+			claimEvent(1, 1, true, "0"),
+			sentEvent(0),
+			sentEvent(1),
+		}},
+		writes: []types.StoreKVPair{
+			packageWrite(sdk.AckCrossChainPackageType, 0, 5, 0),
+			packageWrite(sdk.FailAckCrossChainPackageType, 1, 0, 0),
+			packageWrite(sdk.SynCrossChainPackageType, 2, 7, 3),
+			sequenceWrite(crosschaintypes.PrefixForSendSequenceKey, testChannelID, 3),
+			sequenceWrite(crosschaintypes.PrefixForReceiveSequenceKey, testChannelID, 2),
+			sequenceWrite(crosschaintypes.PrefixForReceiveSequenceKey, oracletypes.RelayPackagesChannelId, 1),
+		},
+	}
+	// the received and sent sequences skip one
+	block2 := testBlock{
+		height: 2,
+		txs:    [][]proto.Message{{claimEvent(3, -1, false, "0")}},
+		writes: []types.StoreKVPair{
+			packageWrite(sdk.SynCrossChainPackageType, 4, 0, 0),
+			sequenceWrite(crosschaintypes.PrefixForSendSequenceKey, testChannelID, 5),
+			sequenceWrite(crosschaintypes.PrefixForReceiveSequenceKey, testChannelID, 4),
+		},
+	}
+	deliverTestBlock(t, fss, block1)
+	deliverTestBlock(t, fss, block2)
+
+	records, err := ReadRecords(dir, Filter{})
+	require.NoError(t, err)
+	ackSequence0, ackSequence1 := uint64(0), uint64(1)
+	expected := []Record{
+		{
+			Index: 0, Height: 1, TxIndex: 0, Type: RecordPackageReceived, Direction: DirectionReceive,
+			SrcChainID: testDestChainID, DestChainID: testSrcChainID, ChannelID: testChannelID, Sequence: 0,
+			PackageType: "syn", RelayerFee: "10", AckRelayerFee: "0", Outcome: OutcomeSuccess,
+			AckPackageType: "ack", AckSequence: &ackSequence0,
+		},
+		{
+			Index: 1, Height: 1, TxIndex: 0, Type: RecordRelayerFee, Direction: DirectionReceive,
+			SrcChainID: testDestChainID, DestChainID: testSrcChainID, ChannelID: testChannelID, Sequence: 0,
+			RelayerFee: "10",
+		},
+		{
+			Index: 2, Height: 1, TxIndex: 0, Type: RecordPackageReceived, Direction: DirectionReceive,
+			SrcChainID: testDestChainID, DestChainID: testSrcChainID, ChannelID: testChannelID, Sequence: 1,
+			PackageType: "syn", RelayerFee: "0", AckRelayerFee: "0", Outcome: OutcomeCrash,
+			AckPackageType: "fail_ack", AckSequence: &ackSequence1,
+		},
+		{
+			Index: 3, Height: 1, TxIndex: 0, Type: RecordPackageSent, Direction: DirectionSend,
+			SrcChainID: testSrcChainID, DestChainID: testDestChainID, ChannelID: testChannelID, Sequence: 0,
+			PackageType: "ack", Timestamp: 1000, Payload: "abcd", RelayerFee: "5",
+		},
+		{
+			Index: 4, Height: 1, TxIndex: 0, Type: RecordRelayerFee, Direction: DirectionSend,
+			SrcChainID: testSrcChainID, DestChainID: testDestChainID, ChannelID: testChannelID, Sequence: 0,
+			RelayerFee: "5",
+		},
+		{
+			Index: 5, Height: 1, TxIndex: 0, Type: RecordPackageSent, Direction: DirectionSend,
+			SrcChainID: testSrcChainID, DestChainID: testDestChainID, ChannelID: testChannelID, Sequence: 1,
+			PackageType: "fail_ack", Timestamp: 1000, Payload: "abcd", RelayerFee: "0",
+		},
+		{
+			Index: 6, Height: 1, TxIndex: -1, Type: RecordPackageSent, Direction: DirectionSend,
+			SrcChainID: testSrcChainID, DestChainID: testDestChainID, ChannelID: testChannelID, Sequence: 2,
+			PackageType: "syn", Timestamp: 1000, Payload: "abcd", RelayerFee: "7", AckRelayerFee: "3",
+		},
+		{
+			Index: 7, Height: 1, TxIndex: -1, Type: RecordRelayerFee, Direction: DirectionSend,
+			SrcChainID: testSrcChainID, DestChainID: testDestChainID, ChannelID: testChannelID, Sequence: 2,
+			RelayerFee: "7", AckRelayerFee: "3",
+		},
+		{
+			Index: 8, Height: 2, TxIndex: 0, Type: RecordSequenceGap, Direction: DirectionReceive,
+			SrcChainID: testDestChainID, DestChainID: testSrcChainID, ChannelID: testChannelID, Sequence: 3,
+			ExpectedSequence: 2,
+		},
+		{
+			Index: 9, Height: 2, TxIndex: 0, Type: RecordPackageReceived, Direction: DirectionReceive,
+			SrcChainID: testDestChainID, DestChainID: testSrcChainID, ChannelID: testChannelID, Sequence: 3,
+			PackageType: "syn", RelayerFee: "0", AckRelayerFee: "0", Outcome: OutcomeSuccess,
+		},
+		{
+			Index: 10, Height: 2, TxIndex: -1, Type: RecordSequenceGap, Direction: DirectionSend,
+			SrcChainID: testSrcChainID, DestChainID: testDestChainID, ChannelID: testChannelID, Sequence: 4,
+			ExpectedSequence: 3,
+		},
+		{
+			Index: 11, Height: 2, TxIndex: -1, Type: RecordPackageSent, Direction: DirectionSend,
+			SrcChainID: testSrcChainID, DestChainID: testDestChainID, ChannelID: testChannelID, Sequence: 4,
+			PackageType: "syn", Timestamp: 1000, Payload: "abcd", RelayerFee: "0", AckRelayerFee: "0",
+		},
+	}
+	require.Equal(t, expected, records)
+
+	channel := uint32(testChannelID)
+	records, err = ReadRecords(dir, Filter{FromIndex: 2, Limit: 2, Types: []RecordType{RecordPackageSent}, ChannelID: &channel})
+	require.NoError(t, err)
+	require.Equal(t, []Record{expected[3], expected[5]}, records)
+
+	records, err = ReadRecords(dir, Filter{MinHeight: 2, Types: []RecordType{RecordSequenceGap}})
+	require.NoError(t, err)
+	require.Equal(t, []Record{expected[8], expected[10]}, records)
+
+	// a restart after an interrupted write goes on with the records, without recording the replayed block
+	path := filepath.Join(dir, RecordsFileName)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"index":12,"hei`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	fss, err = NewStreamingService(dir, testStoreKey, log.NewNopLogger(), true, false)
+	require.NoError(t, err)
+	deliverTestBlock(t, fss, block2)
+	deliverTestBlock(t, fss, testBlock{
+		height: 3,
+		txs:    [][]proto.Message{{claimEvent(4, -1, false, "0")}, {sentEvent(5)}},
+		writes: []types.StoreKVPair{packageWrite(sdk.SynCrossChainPackageType, 5, 0, 0)},
+	})
+
+	records, err = ReadRecords(dir, Filter{FromIndex: 12})
+	require.NoError(t, err)
+	require.Equal(t, []Record{
+		{
+			Index: 12, Height: 3, TxIndex: 0, Type: RecordPackageReceived, Direction: DirectionReceive,
+			SrcChainID: testDestChainID, DestChainID: testSrcChainID, ChannelID: testChannelID, Sequence: 4,
+			PackageType: "syn", RelayerFee: "0", AckRelayerFee: "0", Outcome: OutcomeSuccess,
+		},
+		{
+			Index: 13, Height: 3, TxIndex: 1, Type: RecordPackageSent, Direction: DirectionSend,
+			SrcChainID: testSrcChainID, DestChainID: testDestChainID, ChannelID: testChannelID, Sequence: 5,
+			PackageType: "syn", Timestamp: 1000, Payload: "abcd", RelayerFee: "0", AckRelayerFee: "0",
+		},
+	}, records)
+}
+
+func TestNewStreamingServiceInvalidStoreKey(t *testing.T) {
+	_, err := NewStreamingService(t.TempDir(), types.NewKVStoreKey("bank"), log.NewNopLogger(), true, false)
+	require.Error(t, err)
+}
+
+func TestStreamingServiceCursor(t *testing.T) {
+	dir := t.TempDir()
+	fss, err := NewStreamingService(dir, testStoreKey, log.NewNopLogger(), true, false)
+	require.NoError(t, err)
+
+	deliverTestBlock(t, fss, testBlock{
+		height: 1,
+		writes: []types.StoreKVPair{packageWrite(sdk.SynCrossChainPackageType, 0, 0, 0)},
+	})
+	// a block without records moves the cursor only
+	deliverTestBlock(t, fss, testBlock{height: 2})
+	cursor, err := fileutil.ReadHeightFile(filepath.Join(dir, CursorFileName))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), cursor)
+
+	// the block replayed after a restart was recorded already, though it has no records
+	fss, err = NewStreamingService(dir, testStoreKey, log.NewNopLogger(), true, false)
+	require.NoError(t, err)
+	deliverTestBlock(t, fss, testBlock{
+		height: 2,
+		writes: []types.StoreKVPair{packageWrite(sdk.SynCrossChainPackageType, 1, 0, 0)},
+	})
+	deliverTestBlock(t, fss, testBlock{
+		height: 3,
+		writes: []types.StoreKVPair{packageWrite(sdk.SynCrossChainPackageType, 1, 0, 0)},
+	})
+
+	records, err := ReadRecords(dir, Filter{})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, int64(1), records[0].Height)
+	require.Equal(t, int64(3), records[1].Height)
+	require.Equal(t, uint64(1), records[1].Index)
+}
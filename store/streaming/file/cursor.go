@@ -0,0 +1,91 @@
+package file
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming/internal/fileutil"
+)
+
+// Cursor is the progress of the blocks of a file streaming service, and of their consumer.
+//
+// All the blocks up to Written have their data file written, regenerated from the application DB along with a
+// regenerated marker, or a missing marker if they couldn't be regenerated. Written never decreases. Acked is the last block the
+// consumer acknowledged with Ack, the consumer processes the blocks after it once.
+type Cursor struct {
+	Written int64 `json:"written"`
+	Acked   int64 `json:"acked"`
+}
+
+// fileName returns the name of a file of the service, with the prefix of the service.
+func fileName(prefix, name string) string {
+	if prefix != "" {
+		return fmt.Sprintf("%s-%s", prefix, name)
+	}
+	return name
+}
+
+// blockFileName returns the name of the file of a block of the service, kind is meta, data, regenerated or
+// missing.
+func blockFileName(prefix string, height int64, kind string) string {
+	return fileName(prefix, fmt.Sprintf("block-%d-%s", height, kind))
+}
+
+// parseBlockFileName returns the height of a file of a block of the service, false if it isn't one.
+func parseBlockFileName(prefix, name string) (int64, bool) {
+	if prefix != "" {
+		if !strings.HasPrefix(name, prefix+"-") {
+			return 0, false
+		}
+		name = strings.TrimPrefix(name, prefix+"-")
+	}
+	if !strings.HasPrefix(name, "block-") {
+		return 0, false
+	}
+	name = strings.TrimPrefix(name, "block-")
+
+	i := strings.IndexByte(name, '-')
+	if i < 0 {
+		return 0, false
+	}
+	switch name[i+1:] {
+	case "meta", "data", "regenerated", "missing":
+	default:
+		return 0, false
+	}
+	height, err := strconv.ParseInt(name[:i], 10, 64)
+	return height, err == nil
+}
+
+// ReadCursor returns the cursor of the file streaming service writing to dir with the prefix.
+func ReadCursor(dir, prefix string) (Cursor, error) {
+	written, err := fileutil.ReadHeightFile(path.Join(dir, fileName(prefix, "cursor")))
+	if err != nil {
+		return Cursor{}, err
+	}
+	acked, err := fileutil.ReadHeightFile(path.Join(dir, fileName(prefix, "ack")))
+	if err != nil {
+		return Cursor{}, err
+	}
+	return Cursor{Written: written, Acked: acked}, nil
+}
+
+// Ack acknowledges that the consumer processed the blocks up to the height, which must be written and not
+// before the last acknowledged one. The service deletes the files of the acknowledged blocks if it is
+// configured to.
+func Ack(dir, prefix string, height int64) error {
+	cursor, err := ReadCursor(dir, prefix)
+	if err != nil {
+		return err
+	}
+	if height > cursor.Written {
+		return fmt.Errorf("block %d isn't written yet, the blocks are written up to %d", height, cursor.Written)
+	}
+	if height < cursor.Acked {
+		return fmt.Errorf("block %d is before the last acknowledged block %d", height, cursor.Acked)
+	}
+
+	return fileutil.WriteHeightFile(path.Join(dir, fileName(prefix, "ack")), height, true)
+}
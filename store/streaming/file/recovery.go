@@ -0,0 +1,254 @@
+package file
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/smt"
+	"github.com/cosmos/cosmos-sdk/store/streaming/internal/fileutil"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxRecoveriesPerCommit bounds the number of missing blocks regenerated at each commit, so that a long gap
+// is recovered over several blocks instead of halting one commit.
+const maxRecoveriesPerCommit = 10
+
+// errUnrecoverable is returned when the change set of a block can't be regenerated from the application DB.
+var errUnrecoverable = errors.New("unrecoverable block")
+
+// regeneratedNote is the content of the marker of the regenerated blocks.
+const regeneratedNote = "regenerated from the application DB: the data file holds the net changes of the block, " +
+	"without the writes overwritten in the block, the writes of unchanged values and the meta file"
+
+// SetRecoveryStore sets the multistore the change sets of the missing blocks are regenerated from, by
+// comparing the versions of the listened stores before and after them.
+func (fss *StreamingService) SetRecoveryStore(cms types.CommitMultiStore) {
+	fss.recoveryStore = cms
+}
+
+// SetDeleteAcked sets whether the files of the blocks acknowledged by the consumer are deleted.
+func (fss *StreamingService) SetDeleteAcked(deleteAcked bool) {
+	fss.deleteAcked = deleteAcked
+}
+
+// RetentionConstraint pins the heights the missing blocks after the cursor are regenerated from, until they
+// are. It is registered with BaseApp.AddRetentionConstraint by LoadStreamingServices.
+func (fss *StreamingService) RetentionConstraint(ctx sdk.Context) []pruningtypes.PinnedHeights {
+	// the block being committed is written after the commit
+	end := ctx.BlockHeight() - 1
+	if fss.recoveryStore == nil || !fss.hasCursor || fss.cursor >= end {
+		return nil
+	}
+
+	return []pruningtypes.PinnedHeights{{
+		Start:  fss.cursor,
+		End:    end,
+		Reason: fmt.Sprintf("streaming: blocks %d to %d to regenerate", fss.cursor+1, end),
+	}}
+}
+
+// advanceCursor moves the cursor over the blocks up to the current one which are written, regenerating the
+// missing ones from the application DB or marking them missing if they can't be, and persists it.
+func (fss *StreamingService) advanceCursor() error {
+	if !fss.hasCursor {
+		fss.cursor = fss.currentBlockNumber - 1
+		fss.hasCursor = true
+	}
+
+	cursor := fss.cursor
+	defer func() {
+		if fss.cursor == cursor {
+			return
+		}
+		if err := fileutil.WriteHeightFile(path.Join(fss.writeDir, fileName(fss.filePrefix, "cursor")), fss.cursor, fss.fsync); err != nil {
+			fss.logger.Error("failed to write the streaming cursor", "height", fss.cursor, "err", err)
+		}
+	}()
+
+	recoveries := 0
+	for height := fss.cursor + 1; height <= fss.currentBlockNumber; height++ {
+		if !fss.isBlockWritten(height) {
+			if recoveries == maxRecoveriesPerCommit {
+				return nil
+			}
+			recoveries++
+
+			err := fss.recoverBlock(height)
+			if errors.Is(err, errUnrecoverable) {
+				fss.logger.Error("streamed block is missing", "height", height, "err", err)
+				err = writeLengthPrefixedFile(path.Join(fss.writeDir, blockFileName(fss.filePrefix, height, "missing")), []byte(err.Error()), fss.fsync)
+			}
+			if err != nil {
+				return err
+			}
+			fss.logger.Info("regenerated streamed block", "height", height)
+		}
+		fss.cursor = height
+	}
+	return nil
+}
+
+// isBlockWritten returns whether the data file of the block is complete, or the block is marked missing.
+func (fss *StreamingService) isBlockWritten(height int64) bool {
+	if _, err := os.Stat(path.Join(fss.writeDir, blockFileName(fss.filePrefix, height, "missing"))); err == nil {
+		return true
+	}
+
+	f, err := os.Open(path.Join(fss.writeDir, blockFileName(fss.filePrefix, height, "data")))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	prefix := make([]byte, 8)
+	if _, err := io.ReadFull(f, prefix); err != nil {
+		return false
+	}
+	return info.Size() == int64(sdk.BigEndianToUint64(prefix))+8
+}
+
+// recoverBlock regenerates the data file of a block from the versions of the listened stores before and after
+// it. The data file holds the net changes of the block, so it lacks the writes overwritten in the block and the
+// writes of unchanged values, and the block has no meta file. The regeneration is lossy, so the block is marked
+// with a regenerated file before its data file is written.
+func (fss *StreamingService) recoverBlock(height int64) error {
+	if fss.recoveryStore == nil {
+		return fmt.Errorf("%w: no application DB to regenerate it from", errUnrecoverable)
+	}
+
+	var buf bytes.Buffer
+	for _, listener := range fss.storeListeners {
+		pairs, err := fss.changeSet(listener.StoreKey(), height)
+		if err != nil {
+			return err
+		}
+		for i := range pairs {
+			bz, err := fss.codec.MarshalLengthPrefixed(&pairs[i])
+			if err != nil {
+				return err
+			}
+			buf.Write(bz)
+		}
+	}
+
+	if err := writeLengthPrefixedFile(path.Join(fss.writeDir, blockFileName(fss.filePrefix, height, "regenerated")), []byte(regeneratedNote), fss.fsync); err != nil {
+		return err
+	}
+	return writeLengthPrefixedFile(path.Join(fss.writeDir, blockFileName(fss.filePrefix, height, "data")), buf.Bytes(), fss.fsync)
+}
+
+// changeSet returns the writes of a block to a store, by comparing its versions before and after the block.
+// The versions are iterated in full unless their hashes show the store is unchanged, so regenerating a block
+// costs an iteration of every listened store the block changed.
+func (fss *StreamingService) changeSet(key types.StoreKey, height int64) ([]types.StoreKVPair, error) {
+	before, err := fss.storeVersion(key, height-1)
+	if err != nil {
+		return nil, err
+	}
+	after, err := fss.storeVersion(key, height)
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil && bytes.Equal(before.LastCommitID().Hash, after.LastCommitID().Hash) {
+		return nil, nil
+	}
+
+	var pairs []types.StoreKVPair
+	write := func(k, value []byte, delete bool) {
+		pairs = append(pairs, types.StoreKVPair{StoreKey: key.Name(), Key: k, Value: value, Delete: delete})
+	}
+
+	afterIt := after.Iterator(nil, nil)
+	defer afterIt.Close()
+	// the versions before the first block are empty
+	if before == nil {
+		for ; afterIt.Valid(); afterIt.Next() {
+			write(afterIt.Key(), afterIt.Value(), false)
+		}
+		return pairs, afterIt.Error()
+	}
+
+	beforeIt := before.Iterator(nil, nil)
+	defer beforeIt.Close()
+	for beforeIt.Valid() || afterIt.Valid() {
+		switch {
+		case !afterIt.Valid() || (beforeIt.Valid() && bytes.Compare(beforeIt.Key(), afterIt.Key()) < 0):
+			write(beforeIt.Key(), nil, true)
+			beforeIt.Next()
+		case !beforeIt.Valid() || bytes.Compare(beforeIt.Key(), afterIt.Key()) > 0:
+			write(afterIt.Key(), afterIt.Value(), false)
+			afterIt.Next()
+		default:
+			if !bytes.Equal(beforeIt.Value(), afterIt.Value()) {
+				write(afterIt.Key(), afterIt.Value(), false)
+			}
+			beforeIt.Next()
+			afterIt.Next()
+		}
+	}
+	if err := beforeIt.Error(); err != nil {
+		return nil, err
+	}
+	return pairs, afterIt.Error()
+}
+
+// storeVersion returns a store at a version, nil for the version 0 before the first block.
+func (fss *StreamingService) storeVersion(key types.StoreKey, version int64) (types.CommitKVStore, error) {
+	if version == 0 {
+		return nil, nil
+	}
+
+	switch store := fss.recoveryStore.GetCommitKVStore(key).(type) {
+	case *iavl.Store:
+		if !store.VersionExists(version) {
+			return nil, fmt.Errorf("%w: version %d of store %s is pruned", errUnrecoverable, version, key.Name())
+		}
+		return store.GetImmutable(version)
+	case *smt.Store:
+		if !store.VersionExists(version) {
+			return nil, fmt.Errorf("%w: version %d of store %s is pruned", errUnrecoverable, version, key.Name())
+		}
+		return store.GetImmutable(version)
+	default:
+		return nil, fmt.Errorf("%w: store %s isn't versioned", errUnrecoverable, key.Name())
+	}
+}
+
+// deleteAckedBlocks deletes the files of the blocks acknowledged by the consumer since the last call.
+func (fss *StreamingService) deleteAckedBlocks() {
+	acked, err := fileutil.ReadHeightFile(path.Join(fss.writeDir, fileName(fss.filePrefix, "ack")))
+	if err != nil {
+		fss.logger.Error("failed to read the streaming ack", "err", err)
+		return
+	}
+	if acked <= fss.deletedUpTo {
+		return
+	}
+
+	entries, err := os.ReadDir(fss.writeDir)
+	if err != nil {
+		fss.logger.Error("failed to list the streamed blocks", "err", err)
+		return
+	}
+	for _, entry := range entries {
+		if height, ok := parseBlockFileName(fss.filePrefix, entry.Name()); ok && height <= acked {
+			if err := os.Remove(path.Join(fss.writeDir, entry.Name())); err != nil {
+				fss.logger.Error("failed to delete an acknowledged block", "file", entry.Name(), "err", err)
+				return
+			}
+		}
+	}
+	fss.deletedUpTo = acked
+}
@@ -0,0 +1,207 @@
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recoveryTestChain commits blocks to a multistore, and streams them to a file streaming service unless it is
+// down.
+type recoveryTestChain struct {
+	t    *testing.T
+	rs   *rootmulti.Store
+	keys []types.StoreKey
+}
+
+func newRecoveryTestChain(t *testing.T) *recoveryTestChain {
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	rs.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	keys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
+	for _, key := range keys {
+		rs.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, rs.LoadLatestVersion())
+
+	return &recoveryTestChain{t: t, rs: rs, keys: keys}
+}
+
+// commit applies the writes to the stores and commits them, then streams the block if fss isn't nil.
+func (c *recoveryTestChain) commit(fss *StreamingService, writes ...types.StoreKVPair) {
+	height := c.rs.LastCommitID().Version + 1
+	for _, write := range writes {
+		store := c.rs.GetKVStore(c.rs.StoreKeysByName()[write.StoreKey])
+		if write.Delete {
+			store.Delete(write.Key)
+		} else {
+			store.Set(write.Key, write.Value)
+		}
+	}
+	c.rs.Commit()
+	if fss == nil {
+		return
+	}
+
+	ctx := context.Background()
+	require.NoError(c.t, fss.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+	for _, write := range writes {
+		for _, listener := range fss.storeListeners {
+			if listener.StoreKey().Name() == write.StoreKey {
+				require.NoError(c.t, listener.OnWrite(listener.StoreKey(), write.Key, write.Value, write.Delete))
+			}
+		}
+	}
+	require.NoError(c.t, fss.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+	require.NoError(c.t, fss.ListenCommit(ctx, abci.ResponseCommit{}))
+}
+
+func readBlockPairs(t *testing.T, dir string, height int64) []types.StoreKVPair {
+	bz, err := os.ReadFile(filepath.Join(dir, blockFileName("", height, "data")))
+	require.NoError(t, err)
+	require.Equal(t, int(sdk.BigEndianToUint64(bz[:8])), len(bz)-8)
+
+	segments, err := segmentBytes(bz[8:])
+	require.NoError(t, err)
+	pairs := make([]types.StoreKVPair, len(segments))
+	for i, segment := range segments {
+		require.NoError(t, testMarshaller.Unmarshal(segment, &pairs[i]))
+	}
+	return pairs
+}
+
+func TestStreamingServiceRecovery(t *testing.T) {
+	dir := t.TempDir()
+	chain := newRecoveryTestChain(t)
+
+	fss, err := NewStreamingService(dir, "", chain.keys, testMarshaller, log.NewNopLogger(), false, true, false)
+	require.NoError(t, err)
+	fss.SetRecoveryStore(chain.rs)
+
+	set := func(key types.StoreKey, k, v []byte) types.StoreKVPair {
+		return types.StoreKVPair{StoreKey: key.Name(), Key: k, Value: v}
+	}
+	del := func(key types.StoreKey, k []byte) types.StoreKVPair {
+		return types.StoreKVPair{StoreKey: key.Name(), Key: k, Delete: true}
+	}
+
+	chain.commit(fss, set(mockStoreKey1, mockKey1, mockValue1), set(mockStoreKey1, mockKey2, mockValue2))
+	cursor, err := ReadCursor(dir, "")
+	require.NoError(t, err)
+	require.Equal(t, Cursor{Written: 1}, cursor)
+
+	// the service misses the block 2, it pins the heights to regenerate it from until it is regenerated
+	chain.commit(nil,
+		set(mockStoreKey1, mockKey1, mockValue2), del(mockStoreKey1, mockKey2), set(mockStoreKey1, mockKey3, mockValue3),
+		set(mockStoreKey2, mockKey1, mockValue1),
+		// written with an unchanged value, which isn't regenerated
+		set(mockStoreKey1, mockKey3, mockValue3),
+	)
+	require.Equal(t, []pruningtypes.PinnedHeights{{Start: 1, End: 2, Reason: "streaming: blocks 2 to 2 to regenerate"}},
+		fss.RetentionConstraint(sdk.Context{}.WithBlockHeight(3)))
+
+	chain.commit(fss, set(mockStoreKey2, mockKey2, mockValue2))
+	require.Empty(t, fss.RetentionConstraint(sdk.Context{}.WithBlockHeight(4)))
+	cursor, err = ReadCursor(dir, "")
+	require.NoError(t, err)
+	require.Equal(t, Cursor{Written: 3}, cursor)
+
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue2},
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey2, Delete: true},
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey3, Value: mockValue3},
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey1, Value: mockValue1},
+	}, readBlockPairs(t, dir, 2))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Value: mockValue2},
+	}, readBlockPairs(t, dir, 3))
+	// the regenerated block is marked as such, its writes being lossy
+	bz, err := os.ReadFile(filepath.Join(dir, blockFileName("", 2, "regenerated")))
+	require.NoError(t, err)
+	require.Equal(t, regeneratedNote, string(bz[8:]))
+	require.NoFileExists(t, filepath.Join(dir, blockFileName("", 3, "regenerated")))
+
+	// after a restart without the application DB, the replayed block is skipped and the missed block 4 is
+	// marked missing
+	fss, err = NewStreamingService(dir, "", chain.keys, testMarshaller, log.NewNopLogger(), false, true, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), fss.cursor)
+
+	require.NoError(t, fss.ListenBeginBlock(context.Background(), abci.RequestBeginBlock{Header: tmproto.Header{Height: 3}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, fss.ListenCommit(context.Background(), abci.ResponseCommit{}))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Value: mockValue2},
+	}, readBlockPairs(t, dir, 3))
+
+	chain.commit(nil, set(mockStoreKey1, mockKey1, mockValue3))
+	chain.commit(fss, set(mockStoreKey1, mockKey2, mockValue3))
+	require.FileExists(t, filepath.Join(dir, blockFileName("", 4, "missing")))
+	require.NoFileExists(t, filepath.Join(dir, blockFileName("", 4, "data")))
+	cursor, err = ReadCursor(dir, "")
+	require.NoError(t, err)
+	require.Equal(t, Cursor{Written: 5}, cursor)
+
+	// the consumer acknowledges the blocks up to 4, whose files are deleted
+	require.Error(t, Ack(dir, "", 6))
+	require.NoError(t, Ack(dir, "", 4))
+	require.Error(t, Ack(dir, "", 3))
+	cursor, err = ReadCursor(dir, "")
+	require.NoError(t, err)
+	require.Equal(t, Cursor{Written: 5, Acked: 4}, cursor)
+
+	fss.SetDeleteAcked(true)
+	chain.commit(fss, set(mockStoreKey1, mockKey3, mockValue1))
+	for height := int64(1); height <= 4; height++ {
+		require.False(t, fss.isBlockWritten(height))
+	}
+	require.NoFileExists(t, filepath.Join(dir, blockFileName("", 2, "regenerated")))
+	require.True(t, fss.isBlockWritten(5))
+	require.True(t, fss.isBlockWritten(6))
+}
+
+func TestStreamingServiceRecoveryUnchangedStore(t *testing.T) {
+	dir := t.TempDir()
+	chain := newRecoveryTestChain(t)
+
+	fss, err := NewStreamingService(dir, "", chain.keys, testMarshaller, log.NewNopLogger(), false, true, false)
+	require.NoError(t, err)
+	fss.SetRecoveryStore(chain.rs)
+
+	chain.commit(fss, types.StoreKVPair{StoreKey: mockStoreKey2.Name(), Key: mockKey1, Value: mockValue1})
+	// the missed block only changes the first store, the second one is skipped by its hash
+	chain.commit(nil, types.StoreKVPair{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue2})
+	chain.commit(fss)
+
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue2},
+	}, readBlockPairs(t, dir, 2))
+	require.FileExists(t, filepath.Join(dir, blockFileName("", 2, "regenerated")))
+}
+
+func TestParseBlockFileName(t *testing.T) {
+	height, ok := parseBlockFileName("prefix", "prefix-block-12-data")
+	require.True(t, ok)
+	require.Equal(t, int64(12), height)
+
+	height, ok = parseBlockFileName("", "block-7-regenerated")
+	require.True(t, ok)
+	require.Equal(t, int64(7), height)
+
+	_, ok = parseBlockFileName("prefix", "block-12-data")
+	require.False(t, ok)
+	_, ok = parseBlockFileName("", "block-12-other")
+	require.False(t, ok)
+	_, ok = parseBlockFileName("", "cursor")
+	require.False(t, ok)
+}
@@ -3,7 +3,6 @@ package file
 import (
 	"bytes"
 	"context"
-	"fmt"
 	"io"
 	"os"
 	"path"
@@ -15,6 +14,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/streaming/internal/fileutil"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -45,6 +45,20 @@ type StreamingService struct {
 	// fsync, if true, will execute file Sync to make sure the data is persisted
 	// onto disk, otherwise there is a risk of data loss during any crash.
 	fsync bool
+
+	// cursor is the height up to which all the blocks are written, it is
+	// persisted in the cursor file and hasCursor is false until it is known.
+	cursor    int64
+	hasCursor bool
+
+	// recoveryStore is the multistore the missing blocks are regenerated from,
+	// they are marked missing if it is nil.
+	recoveryStore types.CommitMultiStore
+
+	// deleteAcked, if true, deletes the files of the blocks acknowledged by the
+	// consumer, up to deletedUpTo.
+	deleteAcked bool
+	deletedUpTo int64
 }
 
 func NewStreamingService(
@@ -68,7 +82,12 @@ func NewStreamingService(
 	// Check that the writeDir exists and is writable so that we can catch the
 	// error here at initialization. If it is not we don't open a dstFile until we
 	// receive our first ABCI message.
-	if err := isDirWriteable(writeDir); err != nil {
+	if err := fileutil.IsDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+
+	cursor, err := fileutil.ReadHeightFile(path.Join(writeDir, fileName(filePrefix, "cursor")))
+	if err != nil {
 		return nil, err
 	}
 
@@ -81,6 +100,8 @@ func NewStreamingService(
 		outputMetadata: outputMetadata,
 		stopNodeOnErr:  stopNodeOnErr,
 		fsync:          fsync,
+		cursor:         cursor,
+		hasCursor:      cursor > 0,
 	}, nil
 }
 
@@ -101,6 +122,7 @@ func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListene
 // not written to file until ListenCommit is executed and outputMetadata is set,
 // after which it will be reset again on the next block.
 func (fss *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	fss.blockMetadata = types.BlockMetadata{}
 	fss.blockMetadata.RequestBeginBlock = &req
 	fss.blockMetadata.ResponseBeginBlock = &res
 	fss.currentBlockNumber = req.Header.Height
@@ -131,9 +153,18 @@ func (fss *StreamingService) ListenEndBlock(ctx context.Context, req abci.Reques
 }
 
 // ListenCommit satisfies the ABCIListener interface. It is executed during the
-// ABCI Commit request and is responsible for writing all staged data to files.
-// It will only return a non-nil error when stopNodeOnErr is set.
+// ABCI Commit request and is responsible for writing all staged data to files,
+// then advancing the cursor over the written blocks, regenerating the missing
+// ones. It will only return a non-nil error when stopNodeOnErr is set.
 func (fss *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	// the blocks up to the cursor were written before a restart
+	if fss.hasCursor && fss.currentBlockNumber <= fss.cursor {
+		for _, listener := range fss.storeListeners {
+			listener.PopStateCache()
+		}
+		return nil
+	}
+
 	if err := fss.doListenCommit(ctx, res); err != nil {
 		fss.logger.Error("Listen commit failed", "height", fss.currentBlockNumber, "err", err)
 		if fss.stopNodeOnErr {
@@ -141,6 +172,17 @@ func (fss *StreamingService) ListenCommit(ctx context.Context, res abci.Response
 		}
 	}
 
+	if err := fss.advanceCursor(); err != nil {
+		fss.logger.Error("Advance cursor failed", "height", fss.currentBlockNumber, "cursor", fss.cursor, "err", err)
+		if fss.stopNodeOnErr {
+			return err
+		}
+	}
+
+	if fss.deleteAcked {
+		fss.deleteAckedBlocks()
+	}
+
 	return nil
 }
 
@@ -149,13 +191,8 @@ func (fss *StreamingService) doListenCommit(ctx context.Context, res abci.Respon
 
 	// Write to target files, the file size is written at the beginning, which can
 	// be used to detect completeness.
-	metaFileName := fmt.Sprintf("block-%d-meta", fss.currentBlockNumber)
-	dataFileName := fmt.Sprintf("block-%d-data", fss.currentBlockNumber)
-
-	if fss.filePrefix != "" {
-		metaFileName = fmt.Sprintf("%s-%s", fss.filePrefix, metaFileName)
-		dataFileName = fmt.Sprintf("%s-%s", fss.filePrefix, dataFileName)
-	}
+	metaFileName := blockFileName(fss.filePrefix, fss.currentBlockNumber, "meta")
+	dataFileName := blockFileName(fss.filePrefix, fss.currentBlockNumber, "data")
 
 	if fss.outputMetadata {
 		bz, err := fss.codec.Marshal(&fss.blockMetadata)
@@ -201,18 +238,6 @@ func (fss *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
 // Close satisfies the StreamingService interface. It performs a no-op.
 func (fss *StreamingService) Close() error { return nil }
 
-// isDirWriteable checks if dir is writable by writing and removing a file
-// to dir. It returns nil if dir is writable. We have to do this as there is no
-// platform-independent way of determining if a directory is writeable.
-func isDirWriteable(dir string) error {
-	f := path.Join(dir, ".touch")
-	if err := os.WriteFile(f, []byte(""), 0o600); err != nil {
-		return err
-	}
-
-	return os.Remove(f)
-}
-
 func writeLengthPrefixedFile(path string, data []byte, fsync bool) (err error) {
 	var f *os.File
 	f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
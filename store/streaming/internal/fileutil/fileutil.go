@@ -0,0 +1,104 @@
+// Package fileutil implements the file operations shared by the streaming services writing to local files.
+package fileutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IsDirWriteable checks if dir is writable by writing and removing a file
+// to dir. It returns nil if dir is writable. We have to do this as there is no
+// platform-independent way of determining if a directory is writeable.
+func IsDirWriteable(dir string) error {
+	f := filepath.Join(dir, ".touch")
+	if err := os.WriteFile(f, []byte(""), 0o600); err != nil {
+		return err
+	}
+
+	return os.Remove(f)
+}
+
+// AppendFile appends data to the file at path, which is created if it doesn't exist, and syncs it if fsync is
+// set.
+func AppendFile(path string, data []byte, fsync bool) (err error) {
+	var f *os.File
+	f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "open file failed: %s", path)
+	}
+
+	defer func() {
+		// avoid overriding the real error with file close error
+		if err1 := f.Close(); err1 != nil && err == nil {
+			err = sdkerrors.Wrapf(err1, "close file failed: %s", path)
+		}
+	}()
+
+	if _, err = f.Write(data); err != nil {
+		return sdkerrors.Wrapf(err, "write file failed: %s", path)
+	}
+
+	if fsync {
+		if err = f.Sync(); err != nil {
+			return sdkerrors.Wrapf(err, "fsync failed: %s", path)
+		}
+	}
+
+	return nil
+}
+
+// heightFile is the content of a height file.
+type heightFile struct {
+	Height int64 `json:"height"`
+}
+
+// ReadHeightFile returns the height of a height file, such as a cursor, 0 if it doesn't exist.
+func ReadHeightFile(path string) (int64, error) {
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var f heightFile
+	if err := json.Unmarshal(bz, &f); err != nil {
+		return 0, fmt.Errorf("invalid height file %s: %w", path, err)
+	}
+	return f.Height, nil
+}
+
+// WriteHeightFile writes a height file atomically, through a temporary file renamed over it.
+func WriteHeightFile(path string, height int64, fsync bool) error {
+	bz, err := json.Marshal(heightFile{Height: height})
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(bz); err != nil {
+		f.Close()
+		return err
+	}
+	if fsync {
+		if err := f.Sync(); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -16,6 +17,15 @@ import (
 // metrics emitted using the telemetry package function wrappers.
 var globalLabels = []metrics.Label{}
 
+// telemetryEnabled is set once the global metrics are set up, so that the metrics which are costly to measure
+// are only measured if they are emitted.
+var telemetryEnabled atomic.Bool
+
+// IsTelemetryEnabled returns true if the metrics are emitted.
+func IsTelemetryEnabled() bool {
+	return telemetryEnabled.Load()
+}
+
 // Metrics supported format types.
 const (
 	FormatDefault    = ""
@@ -52,6 +62,22 @@ type Config struct {
 	// Example:
 	// [["chain_id", "cosmoshub-1"]]
 	GlobalLabels [][]string `mapstructure:"global-labels"`
+
+	// TracingEnabled enables the OpenTelemetry spans of the ABCI calls, ante
+	// decorators, message handlers and gRPC queries.
+	TracingEnabled bool `mapstructure:"tracing-enabled"`
+
+	// TracingExporter defines where the spans are exported: "otlp" to export
+	// them to an OTLP collector over gRPC, or "file".
+	TracingExporter string `mapstructure:"tracing-exporter"`
+
+	// TracingEndpoint is the endpoint of the OTLP collector, or the path of the
+	// file the spans are appended to.
+	TracingEndpoint string `mapstructure:"tracing-endpoint"`
+
+	// TracingSampleRate defines the share of the blocks and queries whose
+	// spans are exported, between 0 and 1.
+	TracingSampleRate float64 `mapstructure:"tracing-sample-rate"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -117,6 +143,7 @@ func New(cfg Config) (_ *Metrics, rerr error) {
 	if _, err := metrics.NewGlobal(metricsConf, fanout); err != nil {
 		return nil, err
 	}
+	telemetryEnabled.Store(true)
 
 	return m, nil
 }
@@ -0,0 +1,152 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing exporter types.
+const (
+	TracingExporterOTLP = "otlp"
+	TracingExporterFile = "file"
+)
+
+const (
+	// DefaultTracingEndpoint is the endpoint of a local OTLP collector receiving spans over gRPC.
+	DefaultTracingEndpoint = "localhost:4317"
+
+	tracerName = "github.com/cosmos/cosmos-sdk"
+)
+
+// tracingEnabled is set while a tracer provider of the app spans is set up, so that the spans are not started at
+// all otherwise.
+var tracingEnabled atomic.Bool
+
+// ValidateTracing checks the tracing options of a telemetry configuration.
+func ValidateTracing(cfg Config) error {
+	if !cfg.TracingEnabled {
+		return nil
+	}
+	switch cfg.TracingExporter {
+	case TracingExporterOTLP:
+	case TracingExporterFile:
+		if cfg.TracingEndpoint == "" {
+			return fmt.Errorf("the %s tracing exporter needs the path of the file as endpoint", TracingExporterFile)
+		}
+	default:
+		return fmt.Errorf("invalid tracing exporter %q, must be %s or %s", cfg.TracingExporter, TracingExporterOTLP, TracingExporterFile)
+	}
+	if cfg.TracingSampleRate < 0 || cfg.TracingSampleRate > 1 {
+		return fmt.Errorf("invalid tracing sample rate %v, must be between 0 and 1", cfg.TracingSampleRate)
+	}
+	return nil
+}
+
+// EnableTracing sets up the OpenTelemetry tracer provider of the app spans as configured, exporting them to an OTLP
+// collector over gRPC or to a file. It returns a function shutting the provider down, which exports the spans not
+// exported yet. Spans are sampled per trace, so that the spans of a block are all exported or none.
+func EnableTracing(cfg Config) (func(context.Context) error, error) {
+	if err := ValidateTracing(cfg); err != nil {
+		return nil, err
+	}
+	if !cfg.TracingEnabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var (
+		exporter sdktrace.SpanExporter
+		file     *os.File
+		err      error
+	)
+	switch cfg.TracingExporter {
+	case TracingExporterOTLP:
+		endpoint := cfg.TracingEndpoint
+		if endpoint == "" {
+			endpoint = DefaultTracingEndpoint
+		}
+		exporter, err = otlptracegrpc.New(context.Background(),
+			otlptracegrpc.WithEndpoint(endpoint),
+			otlptracegrpc.WithInsecure(),
+		)
+	case TracingExporterFile:
+		file, err = os.OpenFile(cfg.TracingEndpoint, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the %s tracing exporter: %w", cfg.TracingExporter, err)
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = "cosmos-sdk"
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TracingSampleRate))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+	SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		SetTracerProvider(nil)
+		err := provider.Shutdown(ctx)
+		if file != nil {
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+		}
+		return err
+	}, nil
+}
+
+// SetTracerProvider sets the tracer provider of the app spans, enabling tracing, or disables tracing if nil.
+func SetTracerProvider(provider trace.TracerProvider) {
+	if provider == nil {
+		tracingEnabled.Store(false)
+		return
+	}
+	otel.SetTracerProvider(provider)
+	tracingEnabled.Store(true)
+}
+
+// IsTracingEnabled returns true if the app spans are exported.
+func IsTracingEnabled() bool {
+	return tracingEnabled.Load()
+}
+
+// Tracer returns the OpenTelemetry tracer of the app spans.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// StartSpan starts a span as a child of the span of a context, if tracing is enabled. It returns the context of
+// the span, the context itself with a no-op span otherwise.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !IsTracingEnabled() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends a span, setting its status to an error if any.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
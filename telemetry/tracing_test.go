@@ -0,0 +1,70 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTracing(t *testing.T) {
+	require.NoError(t, ValidateTracing(Config{TracingExporter: "unknown"}))
+	require.NoError(t, ValidateTracing(Config{TracingEnabled: true, TracingExporter: TracingExporterOTLP, TracingSampleRate: 1}))
+	require.Error(t, ValidateTracing(Config{TracingEnabled: true, TracingExporter: "unknown"}))
+	require.Error(t, ValidateTracing(Config{TracingEnabled: true, TracingExporter: TracingExporterFile}))
+	require.Error(t, ValidateTracing(Config{TracingEnabled: true, TracingExporter: TracingExporterOTLP, TracingSampleRate: 1.5}))
+}
+
+func TestTracing_Disabled(t *testing.T) {
+	stop, err := EnableTracing(Config{})
+	require.NoError(t, err)
+	require.False(t, IsTracingEnabled())
+	require.NoError(t, stop(context.Background()))
+
+	_, span := StartSpan(context.Background(), "span")
+	require.False(t, span.IsRecording())
+}
+
+func TestTracing_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spans.json")
+	stop, err := EnableTracing(Config{
+		ServiceName:       "test",
+		TracingEnabled:    true,
+		TracingExporter:   TracingExporterFile,
+		TracingEndpoint:   path,
+		TracingSampleRate: 1,
+	})
+	require.NoError(t, err)
+	require.True(t, IsTracingEnabled())
+
+	ctx, parent := StartSpan(context.Background(), "parent")
+	require.True(t, parent.IsRecording())
+	_, child := StartSpan(ctx, "child")
+	EndSpan(child, errors.New("failure"))
+	EndSpan(parent, nil)
+	require.NoError(t, stop(context.Background()))
+	require.False(t, IsTracingEnabled())
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	var names []string
+	for dec.More() {
+		var span struct {
+			Name   string
+			Parent struct{ SpanID string }
+			Status struct{ Code string }
+		}
+		require.NoError(t, dec.Decode(&span))
+		names = append(names, span.Name)
+		if span.Name == "child" {
+			require.Equal(t, "Error", span.Status.Code)
+		}
+	}
+	require.ElementsMatch(t, []string{"parent", "child"}, names)
+}
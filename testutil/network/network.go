@@ -243,6 +243,8 @@ type (
 		Address    sdk.AccAddress
 		ValAddress sdk.AccAddress
 		RPCClient  tmclient.Client
+		// BlsKey is the BLS key of the validator as a relayer, voting for the cross chain claims.
+		BlsKey bls.SecretKey
 
 		tmNode  *node.Node
 		api     *api.Server
@@ -579,6 +581,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			APIAddress: apiAddr,
 			Address:    addr,
 			ValAddress: addr,
+			BlsKey:     blsSecretKey,
 		}
 	}
 
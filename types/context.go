@@ -78,6 +78,11 @@ func (c Context) IsUpgraded(name string) bool {
 	return c.upgradeChecker(c, name)
 }
 
+// IsFeatureEnabled returns true if the upgrade activating the feature has been applied.
+func (c Context) IsFeatureEnabled(f Feature) bool {
+	return c.IsUpgraded(f.Upgrade)
+}
+
 func (c Context) IsEnableUnsafeQuery() bool {
 	return c.enableUnsafeQuery
 }
@@ -320,7 +325,7 @@ func (c Context) KVStoreWithZeroRead(key storetypes.StoreKey) storetypes.KVStore
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	if c.upgradeChecker != nil && c.upgradeChecker(c, Nagqu) {
+	if c.IsFeatureEnabled(FeatureNagquGasConfig) {
 		return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.gasMeter, storetypes.KVGasConfigAfterNagqu())
 	}
 	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.gasMeter, c.kvGasConfig)
@@ -328,7 +333,7 @@ func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
-	if c.upgradeChecker != nil && c.upgradeChecker(c, Nagqu) {
+	if c.IsFeatureEnabled(FeatureNagquGasConfig) {
 		return c.MultiStore().GetKVStore(key)
 	}
 	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.gasMeter, c.kvGasConfig)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"math/big"
 )
@@ -26,6 +27,19 @@ const (
 	ChannelForbidden ChannelPermission = 0
 )
 
+func (t CrossChainPackageType) String() string {
+	switch t {
+	case SynCrossChainPackageType:
+		return "syn"
+	case AckCrossChainPackageType:
+		return "ack"
+	case FailAckCrossChainPackageType:
+		return "fail_ack"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
 func IsValidCrossChainPackageType(packageType CrossChainPackageType) bool {
 	return packageType == SynCrossChainPackageType || packageType == AckCrossChainPackageType || packageType == FailAckCrossChainPackageType
 }
@@ -37,6 +51,12 @@ type CrossChainApplication interface {
 	ExecuteFailAckPackage(ctx Context, header *CrossChainAppContext, payload []byte) ExecuteResult
 }
 
+// CrossChainPayloadDecoder is implemented by the cross chain apps able to decode the payloads of their packages
+// into human-readable JSON. DecodePayload returns a nil JSON for the payloads it doesn't decode.
+type CrossChainPayloadDecoder interface {
+	DecodePayload(packageType CrossChainPackageType, payload []byte) (json.RawMessage, error)
+}
+
 type CrossChainAppContext struct {
 	SrcChainId ChainID
 	Sequence   uint64
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Feature is a named behavior change of a module which is activated by an upgrade.
+// Features are declared once at package level with RegisterFeature, so that every
+// behavior gated by a hardfork can be audited from a single registry.
+type Feature struct {
+	// Name is the unique name of the feature.
+	Name string
+	// Module is the name of the module which declares the feature.
+	Module string
+	// Upgrade is the name of the upgrade plan which activates the feature.
+	Upgrade string
+	// Description describes the behavior change.
+	Description string
+}
+
+// String implements fmt.Stringer.
+func (f Feature) String() string {
+	return fmt.Sprintf("%s/%s", f.Module, f.Name)
+}
+
+var featureRegistry = struct {
+	sync.RWMutex
+	features map[string]Feature
+}{features: make(map[string]Feature)}
+
+// RegisterFeature declares a feature of a module activated by the given upgrade.
+// Registering an identical feature again returns the registered one, it panics if
+// another feature with the same name is already registered.
+func RegisterFeature(module, name, upgrade, description string) Feature {
+	if name == "" || upgrade == "" {
+		panic("feature name and upgrade cannot be empty")
+	}
+
+	f := Feature{
+		Name:        name,
+		Module:      module,
+		Upgrade:     upgrade,
+		Description: description,
+	}
+
+	featureRegistry.Lock()
+	defer featureRegistry.Unlock()
+
+	if registered, ok := featureRegistry.features[name]; ok {
+		if registered == f {
+			return registered
+		}
+		panic(fmt.Sprintf("feature %s is already registered by module %s", name, registered.Module))
+	}
+	featureRegistry.features[name] = f
+
+	return f
+}
+
+// UnregisterFeature removes the feature with the given name from the registry. It is
+// meant for tests registering features of their own, the features of the modules are
+// registered for the lifetime of the process.
+func UnregisterFeature(name string) {
+	featureRegistry.Lock()
+	defer featureRegistry.Unlock()
+
+	delete(featureRegistry.features, name)
+}
+
+// GetFeature returns the registered feature with the given name.
+func GetFeature(name string) (Feature, bool) {
+	featureRegistry.RLock()
+	defer featureRegistry.RUnlock()
+
+	f, ok := featureRegistry.features[name]
+	return f, ok
+}
+
+// RegisteredFeatures returns all registered features sorted by name.
+func RegisteredFeatures() []Feature {
+	featureRegistry.RLock()
+	defer featureRegistry.RUnlock()
+
+	features := make([]Feature, 0, len(featureRegistry.features))
+	for _, f := range featureRegistry.features {
+		features = append(features, f)
+	}
+	sort.Slice(features, func(i, j int) bool {
+		return features[i].Name < features[j].Name
+	})
+
+	return features
+}
+
+// FeatureNagquGasConfig makes reads of KVStores free of gas and removes gas metering
+// from transient stores.
+var FeatureNagquGasConfig = RegisterFeature("sdk", "NagquGasConfig", Nagqu,
+	"KVStore reads are free of gas and transient stores are not gas metered")
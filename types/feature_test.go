@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRegisterFeature(t *testing.T) {
+	name := t.Name()
+	t.Cleanup(func() { types.UnregisterFeature(name) })
+
+	f := types.RegisterFeature("test", name, types.Savanna, "a test feature")
+	require.Equal(t, "test/"+name, f.String())
+
+	got, ok := types.GetFeature(name)
+	require.True(t, ok)
+	require.Equal(t, f, got)
+
+	features := types.RegisteredFeatures()
+	require.Contains(t, features, f)
+	require.Contains(t, features, types.FeatureNagquGasConfig)
+	for i := 1; i < len(features); i++ {
+		require.Less(t, features[i-1].Name, features[i].Name)
+	}
+
+	// an identical feature can be registered again
+	require.Equal(t, f, types.RegisterFeature("test", name, types.Savanna, "a test feature"))
+	require.Panics(t, func() {
+		types.RegisterFeature("other", name, types.Savanna, "a duplicated feature")
+	})
+	require.Panics(t, func() {
+		types.RegisterFeature("test", name+"WithoutUpgrade", "", "a feature without upgrade")
+	})
+
+	types.UnregisterFeature(name)
+	_, ok = types.GetFeature(name)
+	require.False(t, ok)
+}
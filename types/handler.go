@@ -1,5 +1,7 @@
 package types
 
+import "github.com/cosmos/cosmos-sdk/telemetry"
+
 // Handler defines the core of the state transition function of an application.
 type Handler func(ctx Context, msg Msg) (*Result, error)
 
@@ -46,7 +48,15 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 
 	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
-		return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+		if !telemetry.IsTracingEnabled() || (chain[0] == Terminator{}) {
+			return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+		}
+
+		// the span of a decorator includes the spans of the decorators further along the chain
+		spanCtx, span := StartChildSpan(ctx, spanName(chain[0]))
+		newCtx, err := chain[0].AnteHandle(spanCtx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+		EndSpan(spanCtx, span, err)
+		return restoreSpanParent(ctx, spanCtx, newCtx), err
 	}
 }
 
@@ -0,0 +1,144 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// PendingTx describes a tx of a mempool by its first signer, the one the mempools order the txs of.
+type PendingTx struct {
+	// Hash is the hash of the tx bytes, the one of the tx in CometBFT.
+	Hash   []byte `json:"hash"`
+	Sender string `json:"sender"`
+	Nonce  uint64 `json:"nonce"`
+	// Priority is the priority of the tx in the mempools ordering by priority, 0 in the other ones.
+	Priority int64 `json:"priority"`
+	// Size is the length of the tx bytes.
+	Size int `json:"size"`
+	// Tx holds the tx bytes in the lookups of a tx.
+	Tx []byte `json:"tx,omitempty"`
+}
+
+// NonceGaps are the nonces missing between the pending txs of a sender, which keep the txs after them from
+// being included.
+type NonceGaps struct {
+	Sender string `json:"sender"`
+	// FirstNonce is the lowest nonce of the pending txs, which is expected to be the sequence of the account.
+	FirstNonce uint64       `json:"first_nonce"`
+	Missing    []NonceRange `json:"missing"`
+}
+
+// NonceRange is a range of nonces, from start to end included.
+type NonceRange struct {
+	Start uint64 `json:"start"`
+	End   uint64 `json:"end"`
+}
+
+// TxPrioritizer is implemented by the mempools ordering the txs by priority.
+type TxPrioritizer interface {
+	// TxPriority returns the priority of the tx of a sender and nonce, and whether it's in the mempool.
+	TxPriority(sender string, nonce uint64) (int64, bool)
+}
+
+var _ TxPrioritizer = (*PriorityNonceMempool)(nil)
+
+// TxPriority returns the priority of the tx of a sender and nonce, and whether it's in the mempool.
+func (mp *PriorityNonceMempool) TxPriority(sender string, nonce uint64) (int64, bool) {
+	score, ok := mp.scores[txMeta{nonce: nonce, sender: sender}]
+	return score.priority, ok
+}
+
+// PendingTxs returns the txs of a mempool in their selection order, with their description. The txs are
+// selected for no proposal, so the mempool must not be modified meanwhile.
+func PendingTxs(ctx context.Context, mp Mempool, txEncoder sdk.TxEncoder) ([]sdk.Tx, []PendingTx, error) {
+	var (
+		txs     []sdk.Tx
+		pending []PendingTx
+	)
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		tx := it.Tx()
+		desc, err := describeTx(mp, tx, txEncoder)
+		if err != nil {
+			return nil, nil, err
+		}
+		txs = append(txs, tx)
+		pending = append(pending, desc)
+	}
+	return txs, pending, nil
+}
+
+// FindTx returns the tx of a mempool with a hash and its description with the tx bytes, or ErrTxNotFound.
+func FindTx(ctx context.Context, mp Mempool, txEncoder sdk.TxEncoder, hash []byte) (sdk.Tx, PendingTx, error) {
+	txs, pending, err := PendingTxs(ctx, mp, txEncoder)
+	if err != nil {
+		return nil, PendingTx{}, err
+	}
+	for i, desc := range pending {
+		if bytes.Equal(desc.Hash, hash) {
+			if desc.Tx, err = txEncoder(txs[i]); err != nil {
+				return nil, PendingTx{}, err
+			}
+			return txs[i], desc, nil
+		}
+	}
+	return nil, PendingTx{}, ErrTxNotFound
+}
+
+func describeTx(mp Mempool, tx sdk.Tx, txEncoder sdk.TxEncoder) (PendingTx, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return PendingTx{}, fmt.Errorf("tx must be a SigVerifiableTx")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return PendingTx{}, err
+	}
+	if len(sigs) == 0 {
+		return PendingTx{}, fmt.Errorf("tx must have at least one signer")
+	}
+	bz, err := txEncoder(tx)
+	if err != nil {
+		return PendingTx{}, err
+	}
+
+	hash := sha256.Sum256(bz)
+	desc := PendingTx{
+		Hash:   hash[:],
+		Sender: sdk.AccAddress(sigs[0].PubKey.Address()).String(),
+		Nonce:  sigs[0].Sequence,
+		Size:   len(bz),
+	}
+	if prioritizer, ok := mp.(TxPrioritizer); ok {
+		desc.Priority, _ = prioritizer.TxPriority(desc.Sender, desc.Nonce)
+	}
+	return desc, nil
+}
+
+// PendingNonceGaps returns the first nonce and the nonce gaps of each sender of pending txs, by sender.
+func PendingNonceGaps(pending []PendingTx) []NonceGaps {
+	nonces := make(map[string][]uint64)
+	for _, desc := range pending {
+		nonces[desc.Sender] = append(nonces[desc.Sender], desc.Nonce)
+	}
+
+	var gaps []NonceGaps
+	for sender, senderNonces := range nonces {
+		sort.Slice(senderNonces, func(i, j int) bool { return senderNonces[i] < senderNonces[j] })
+
+		senderGaps := NonceGaps{Sender: sender, FirstNonce: senderNonces[0]}
+		for i := 1; i < len(senderNonces); i++ {
+			if senderNonces[i] > senderNonces[i-1]+1 {
+				senderGaps.Missing = append(senderGaps.Missing, NonceRange{Start: senderNonces[i-1] + 1, End: senderNonces[i] - 1})
+			}
+		}
+		gaps = append(gaps, senderGaps)
+	}
+	sort.Slice(gaps, func(i, j int) bool { return gaps[i].Sender < gaps[j].Sender })
+	return gaps
+}
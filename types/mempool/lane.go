@@ -0,0 +1,413 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/huandu/skiplist"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var (
+	_ Mempool  = (*LaneMempool)(nil)
+	_ Iterator = (*laneMempoolIterator)(nil)
+)
+
+// DefaultLaneName is the name of the lane of the txs which aren't in any configured lane.
+const DefaultLaneName = "default"
+
+// Lane is a class of txs selected before the txs of the lanes after it, and of the default lane.
+type Lane struct {
+	// Name identifies the lane in the configuration.
+	Name string
+	// MsgTypeURLs are the type URLs of the msgs of the lane. A tx is in the first lane all its msgs are of.
+	MsgTypeURLs []string
+	// MaxTxs bounds the number of txs in the lane, 0 for no bound other than the mempool's one.
+	MaxTxs int
+}
+
+// DefaultLanes returns the lanes of the system msgs: the oracle claims of the relayers, then the governance
+// msgs.
+func DefaultLanes() []Lane {
+	return []Lane{
+		{
+			Name:        "oracle",
+			MsgTypeURLs: []string{"/cosmos.oracle.v1.MsgClaim"},
+			MaxTxs:      1_000,
+		},
+		{
+			Name: "governance",
+			MsgTypeURLs: []string{
+				"/cosmos.gov.v1.MsgSubmitProposal",
+				"/cosmos.gov.v1.MsgVote",
+				"/cosmos.gov.v1.MsgVoteWeighted",
+				"/cosmos.gov.v1.MsgDeposit",
+				"/cosmos.gov.v1beta1.MsgSubmitProposal",
+				"/cosmos.gov.v1beta1.MsgVote",
+				"/cosmos.gov.v1beta1.MsgVoteWeighted",
+				"/cosmos.gov.v1beta1.MsgDeposit",
+			},
+			MaxTxs: 1_000,
+		},
+	}
+}
+
+// ValidateLanes returns an error if the lanes have no or duplicate names, no msgs, or a negative bound.
+func ValidateLanes(lanes []Lane) error {
+	names := map[string]bool{DefaultLaneName: true}
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return fmt.Errorf("lane without a name")
+		}
+		if names[lane.Name] {
+			return fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = true
+		if len(lane.MsgTypeURLs) == 0 {
+			return fmt.Errorf("lane %s has no msg type urls", lane.Name)
+		}
+		if lane.MaxTxs < 0 {
+			return fmt.Errorf("lane %s has a negative max-txs %d", lane.Name, lane.MaxTxs)
+		}
+	}
+	return nil
+}
+
+// LaneMempool is a mempool for the fixed per-msg gas of gashub, under which the fee per gas of the txs doesn't
+// tell their value. It orders the txs by:
+//
+// 1) Lanes: the txs of the system msgs, such as the oracle claims of the relayers and the governance msgs, are
+// selected before the other txs, so that they aren't stuck behind spam
+// 2) Fair per sender scheduling: within a lane, the senders are selected round robin, each round selecting the
+// tx of the lowest nonce of every sender, by decreasing fee density
+//
+// The fee density of a tx is its fee per byte. When the mempool or a lane is full, the tx of the lowest fee
+// density which is the last tx of its sender is evicted from the last non empty lane from the one of the inserted
+// tx, if that's a lane after it or the inserted tx has a higher fee density. Otherwise the inserted tx is
+// rejected.
+type LaneMempool struct {
+	lanes    []*lane
+	txs      map[txKey]*laneTx
+	maxTx    int
+	feeDenom string
+}
+
+type LaneMempoolOption func(mp *LaneMempool)
+
+type lane struct {
+	Lane
+	index       int
+	msgTypeURLs map[string]bool
+	// senders holds the nonce ordered txs of each sender.
+	senders map[string]*skiplist.SkipList
+	count   int
+}
+
+type laneTx struct {
+	tx      sdk.Tx
+	lane    *lane
+	key     txKey
+	density math.LegacyDec
+}
+
+// NewLaneMempool creates a new mempool with the default lanes.
+func NewLaneMempool(opts ...LaneMempoolOption) *LaneMempool {
+	mp := &LaneMempool{
+		txs:   make(map[txKey]*laneTx),
+		maxTx: DefaultMaxTx,
+	}
+	mp.setLanes(DefaultLanes())
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+// LaneMaxTxOpt Option To set limit of max tx when calling the constructor NewLaneMempool. A negative value
+// disables the mempool.
+//
+// Example:
+//
+//	NewLaneMempool(LaneMaxTxOpt(100))
+func LaneMaxTxOpt(maxTx int) LaneMempoolOption {
+	return func(mp *LaneMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// LanesOpt Option To set the lanes when calling the constructor NewLaneMempool, in their order. The lanes must
+// be valid for ValidateLanes.
+//
+// Example:
+//
+//	NewLaneMempool(LanesOpt(Lane{Name: "oracle", MsgTypeURLs: []string{"/cosmos.oracle.v1.MsgClaim"}}))
+func LanesOpt(lanes ...Lane) LaneMempoolOption {
+	return func(mp *LaneMempool) {
+		mp.setLanes(lanes)
+	}
+}
+
+// LaneFeeDenomOpt Option To set the denom of the fees the fee density is computed on when calling the
+// constructor NewLaneMempool. When it's empty, the amounts of all the denoms of a fee are added up.
+//
+// Example:
+//
+//	NewLaneMempool(LaneFeeDenomOpt("BNB"))
+func LaneFeeDenomOpt(denom string) LaneMempoolOption {
+	return func(mp *LaneMempool) {
+		mp.feeDenom = denom
+	}
+}
+
+func (mp *LaneMempool) setLanes(lanes []Lane) {
+	mp.lanes = make([]*lane, 0, len(lanes)+1)
+	for _, l := range append(lanes, Lane{Name: DefaultLaneName}) {
+		msgTypeURLs := make(map[string]bool, len(l.MsgTypeURLs))
+		for _, url := range l.MsgTypeURLs {
+			msgTypeURLs[url] = true
+		}
+		mp.lanes = append(mp.lanes, &lane{
+			Lane:        l,
+			index:       len(mp.lanes),
+			msgTypeURLs: msgTypeURLs,
+			senders:     make(map[string]*skiplist.SkipList),
+		})
+	}
+	mp.txs = make(map[txKey]*laneTx)
+}
+
+// laneOf returns the first lane all the msgs of the tx are of, or the default lane.
+func (mp *LaneMempool) laneOf(tx sdk.Tx) *lane {
+	msgs := tx.GetMsgs()
+	for _, l := range mp.lanes[:len(mp.lanes)-1] {
+		matches := len(msgs) > 0
+		for _, msg := range msgs {
+			if !l.msgTypeURLs[sdk.MsgTypeURL(msg)] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return l
+		}
+	}
+	return mp.lanes[len(mp.lanes)-1]
+}
+
+// feeDensity returns the fee per byte of a tx. A tx without its bytes in the context is given a size of 1.
+func (mp *LaneMempool) feeDensity(ctx context.Context, tx sdk.Tx) (math.LegacyDec, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return math.LegacyDec{}, fmt.Errorf("tx must be a FeeTx")
+	}
+
+	fee := math.ZeroInt()
+	if mp.feeDenom != "" {
+		fee = feeTx.GetFee().AmountOf(mp.feeDenom)
+	} else {
+		for _, coin := range feeTx.GetFee() {
+			fee = fee.Add(coin.Amount)
+		}
+	}
+
+	size := int64(len(sdk.UnwrapSDKContext(ctx).TxBytes()))
+	if size == 0 {
+		size = 1
+	}
+	return math.LegacyNewDecFromInt(fee).QuoInt64(size), nil
+}
+
+func txSender(tx sdk.Tx) (txKey, error) {
+	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return txKey{}, err
+	}
+	if len(sigs) == 0 {
+		return txKey{}, fmt.Errorf("tx must have at least one signer")
+	}
+
+	sig := sigs[0]
+	return txKey{address: sdk.AccAddress(sig.PubKey.Address()).String(), nonce: sig.Sequence}, nil
+}
+
+// Insert adds a tx to its lane, replacing the tx of the same sender and nonce. It returns an error if the tx
+// does not have at least one signer or isn't a FeeTx, or ErrMempoolTxMaxCapacity if the mempool or the lane is
+// full and no tx can be evicted for it.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	if mp.maxTx < 0 {
+		return nil
+	}
+
+	key, err := txSender(tx)
+	if err != nil {
+		return err
+	}
+	density, err := mp.feeDensity(ctx, tx)
+	if err != nil {
+		return err
+	}
+	ltx := &laneTx{tx: tx, lane: mp.laneOf(tx), key: key, density: density}
+
+	// the replaced tx is restored if the new one can't be inserted
+	replaced, found := mp.txs[key]
+	if found {
+		mp.remove(replaced)
+	}
+
+	if err := mp.makeRoom(ltx); err != nil {
+		if found {
+			mp.add(replaced)
+		}
+		return err
+	}
+	mp.add(ltx)
+	return nil
+}
+
+// makeRoom evicts a tx if the mempool or the lane of the tx is full.
+func (mp *LaneMempool) makeRoom(ltx *laneTx) error {
+	if ltx.lane.MaxTxs > 0 && ltx.lane.count >= ltx.lane.MaxTxs {
+		return mp.evict(ltx, ltx.lane)
+	}
+	if mp.maxTx > 0 && len(mp.txs) >= mp.maxTx {
+		for i := len(mp.lanes) - 1; i >= ltx.lane.index; i-- {
+			if mp.lanes[i].count > 0 && mp.evict(ltx, mp.lanes[i]) == nil {
+				return nil
+			}
+		}
+		return ErrMempoolTxMaxCapacity
+	}
+	return nil
+}
+
+// evict evicts the tx of the lowest fee density of a lane, among the last txs of the senders, for a tx of the
+// same lane with a higher fee density, or for a tx of a lane before it. The txs of the sender of the tx are never
+// evicted for it, which would leave a nonce gap before it.
+func (mp *LaneMempool) evict(ltx *laneTx, l *lane) error {
+	var victim *laneTx
+	for address, senderTxs := range l.senders {
+		if address == ltx.key.address {
+			continue
+		}
+		last := senderTxs.Back().Value.(*laneTx)
+		if victim == nil || last.density.LT(victim.density) ||
+			(last.density.Equal(victim.density) && last.key.address > victim.key.address) {
+			victim = last
+		}
+	}
+
+	if victim == nil || (l == ltx.lane && !ltx.density.GT(victim.density)) {
+		return ErrMempoolTxMaxCapacity
+	}
+	mp.remove(victim)
+	return nil
+}
+
+func (mp *LaneMempool) add(ltx *laneTx) {
+	senderTxs, found := ltx.lane.senders[ltx.key.address]
+	if !found {
+		senderTxs = skiplist.New(skiplist.Uint64)
+		ltx.lane.senders[ltx.key.address] = senderTxs
+	}
+	senderTxs.Set(ltx.key.nonce, ltx)
+	ltx.lane.count++
+	mp.txs[ltx.key] = ltx
+}
+
+func (mp *LaneMempool) remove(ltx *laneTx) {
+	senderTxs := ltx.lane.senders[ltx.key.address]
+	senderTxs.Remove(ltx.key.nonce)
+	if senderTxs.Len() == 0 {
+		delete(ltx.lane.senders, ltx.key.address)
+	}
+	ltx.lane.count--
+	delete(mp.txs, ltx.key)
+}
+
+// Select returns an iterator over the txs of the lanes in their order, selecting the senders of a lane round
+// robin. The iterator works on a snapshot of the mempool, so txs can be removed while using it.
+func (mp *LaneMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	var txs []sdk.Tx
+	for _, l := range mp.lanes {
+		cursors := make([]*skiplist.Element, 0, len(l.senders))
+		for _, senderTxs := range l.senders {
+			cursors = append(cursors, senderTxs.Front())
+		}
+
+		for len(cursors) > 0 {
+			sort.Slice(cursors, func(i, j int) bool {
+				a, b := cursors[i].Value.(*laneTx), cursors[j].Value.(*laneTx)
+				if !a.density.Equal(b.density) {
+					return a.density.GT(b.density)
+				}
+				return a.key.address < b.key.address
+			})
+
+			next := cursors[:0]
+			for _, cursor := range cursors {
+				txs = append(txs, cursor.Value.(*laneTx).tx)
+				if cursor = cursor.Next(); cursor != nil {
+					next = append(next, cursor)
+				}
+			}
+			cursors = next
+		}
+	}
+
+	if len(txs) == 0 {
+		return nil
+	}
+	return &laneMempoolIterator{txs: txs}
+}
+
+// CountTx returns the total count of txs in the mempool.
+func (mp *LaneMempool) CountTx() int {
+	return len(mp.txs)
+}
+
+// LaneCountTx returns the count of txs in each lane, by name.
+func (mp *LaneMempool) LaneCountTx() map[string]int {
+	counts := make(map[string]int, len(mp.lanes))
+	for _, l := range mp.lanes {
+		counts[l.Name] = l.count
+	}
+	return counts
+}
+
+// Remove removes a tx from the mempool. It returns an error if the tx does not
+// have at least one signer or the tx was not found in the pool.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	key, err := txSender(tx)
+	if err != nil {
+		return err
+	}
+
+	ltx, found := mp.txs[key]
+	if !found {
+		return ErrTxNotFound
+	}
+	mp.remove(ltx)
+	return nil
+}
+
+type laneMempoolIterator struct {
+	txs []sdk.Tx
+}
+
+func (i *laneMempoolIterator) Next() Iterator {
+	if len(i.txs) <= 1 {
+		return nil
+	}
+	return &laneMempoolIterator{txs: i.txs[1:]}
+}
+
+func (i *laneMempoolIterator) Tx() sdk.Tx {
+	return i.txs[0]
+}
@@ -0,0 +1,199 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// laneTestTx is a testTx with msgs and a fee.
+type laneTestTx struct {
+	testTx
+	msgs []sdk.Msg
+	fee  int64
+}
+
+var _ sdk.FeeTx = (*laneTestTx)(nil)
+
+func (tx laneTestTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx laneTestTx) GetGas() uint64 { return 1 }
+
+func (tx laneTestTx) GetFee() sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("BNB", tx.fee)) }
+
+func (tx laneTestTx) FeePayer() sdk.AccAddress { return tx.address }
+
+func (tx laneTestTx) FeeGranter() sdk.AccAddress { return nil }
+
+type laneTxSpec struct {
+	a   sdk.AccAddress
+	n   int
+	msg sdk.Msg
+	fee int64
+}
+
+func insertLaneTxs(t *testing.T, mp mempool.Mempool, specs []laneTxSpec) []error {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil, log.NewNopLogger()).WithTxBytes(make([]byte, 10))
+	errs := make([]error, len(specs))
+	for i, spec := range specs {
+		tx := laneTestTx{
+			testTx: testTx{id: i, nonce: uint64(spec.n), address: spec.a},
+			msgs:   []sdk.Msg{spec.msg},
+			fee:    spec.fee,
+		}
+		errs[i] = mp.Insert(ctx, tx)
+	}
+	return errs
+}
+
+func selectLaneTxIDs(mp mempool.Mempool) []int {
+	var ids []int
+	for it := mp.Select(sdk.Context{}, nil); it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(laneTestTx).id)
+	}
+	return ids
+}
+
+func TestLaneMempoolOrder(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+	claim := &oracletypes.MsgClaim{}
+	vote := &govv1.MsgVote{}
+	send := &banktypes.MsgSend{}
+
+	mp := mempool.NewLaneMempool()
+	errs := insertLaneTxs(t, mp, []laneTxSpec{
+		{a: sa, n: 1, msg: send, fee: 1000},
+		{a: sa, n: 2, msg: send, fee: 1000},
+		{a: sa, n: 3, msg: send, fee: 1000},
+		{a: sb, n: 1, msg: send, fee: 10},
+		{a: sb, n: 2, msg: send, fee: 20},
+		{a: sc, n: 1, msg: vote, fee: 10},
+		{a: sc, n: 2, msg: claim, fee: 1},
+	})
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, 7, mp.CountTx())
+	require.Equal(t, map[string]int{"oracle": 1, "governance": 1, mempool.DefaultLaneName: 5}, mp.LaneCountTx())
+
+	// the system lanes first, then the senders of the default lane round robin by fee density
+	require.Equal(t, []int{6, 5, 0, 3, 1, 4, 2}, selectLaneTxIDs(mp))
+
+	require.NoError(t, mp.Remove(laneTestTx{testTx: testTx{nonce: 2, address: sc}}))
+	require.ErrorIs(t, mp.Remove(laneTestTx{testTx: testTx{nonce: 2, address: sc}}), mempool.ErrTxNotFound)
+	require.Equal(t, []int{5, 0, 3, 1, 4, 2}, selectLaneTxIDs(mp))
+}
+
+func TestLaneMempoolEviction(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+	claim := &oracletypes.MsgClaim{}
+	send := &banktypes.MsgSend{}
+
+	mp := mempool.NewLaneMempool(mempool.LaneMaxTxOpt(3), mempool.LanesOpt(mempool.Lane{
+		Name:        "oracle",
+		MsgTypeURLs: []string{sdk.MsgTypeURL(claim)},
+		MaxTxs:      1,
+	}))
+	errs := insertLaneTxs(t, mp, []laneTxSpec{
+		{a: sa, n: 1, msg: send, fee: 100},
+		{a: sa, n: 2, msg: send, fee: 10},
+		{a: sb, n: 1, msg: send, fee: 50},
+		// full: a lower fee density than the last txs of the senders is rejected
+		{a: sc, n: 1, msg: send, fee: 5},
+		// the last tx of sa has the lowest fee density
+		{a: sc, n: 1, msg: send, fee: 20},
+		// a system tx evicts the lowest fee density tx of the default lane of another sender
+		{a: sc, n: 2, msg: claim, fee: 1},
+		// the oracle lane is full
+		{a: sa, n: 3, msg: claim, fee: 1},
+	})
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.NoError(t, errs[2])
+	require.ErrorIs(t, errs[3], mempool.ErrMempoolTxMaxCapacity)
+	require.NoError(t, errs[4])
+	require.NoError(t, errs[5])
+	require.ErrorIs(t, errs[6], mempool.ErrMempoolTxMaxCapacity)
+
+	require.Equal(t, []int{5, 0, 4}, selectLaneTxIDs(mp))
+}
+
+func TestLaneMempoolEvictionSameSender(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+	claim := &oracletypes.MsgClaim{}
+	send := &banktypes.MsgSend{}
+
+	mp := mempool.NewLaneMempool(mempool.LaneMaxTxOpt(2))
+	errs := insertLaneTxs(t, mp, []laneTxSpec{
+		{a: sa, n: 1, msg: send, fee: 10},
+		{a: sb, n: 1, msg: send, fee: 50},
+		// the last tx of sa has the lowest fee density, but evicting it would leave a nonce gap
+		{a: sa, n: 2, msg: send, fee: 100},
+		// only txs of sa are left
+		{a: sa, n: 3, msg: send, fee: 1000},
+	})
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.NoError(t, errs[2])
+	require.ErrorIs(t, errs[3], mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, []int{0, 2}, selectLaneTxIDs(mp))
+
+	// a tx of a lane before it goes on evicting from the lanes before the last one, which only holds its own txs
+	mp = mempool.NewLaneMempool(mempool.LaneMaxTxOpt(2), mempool.LanesOpt(
+		mempool.Lane{Name: "oracle", MsgTypeURLs: []string{sdk.MsgTypeURL(claim)}},
+		mempool.Lane{Name: mempool.DefaultLaneName},
+	))
+	errs = insertLaneTxs(t, mp, []laneTxSpec{
+		{a: sa, n: 1, msg: send, fee: 10},
+		{a: sb, n: 1, msg: claim, fee: 1},
+		{a: sa, n: 2, msg: claim, fee: 5},
+	})
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, []int{2, 0}, selectLaneTxIDs(mp))
+}
+
+func TestLaneMempoolReplace(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+	send := &banktypes.MsgSend{}
+
+	mp := mempool.NewLaneMempool(mempool.LaneMaxTxOpt(2))
+	errs := insertLaneTxs(t, mp, []laneTxSpec{
+		{a: sa, n: 1, msg: send, fee: 10},
+		{a: sb, n: 1, msg: send, fee: 20},
+		// replaces the tx of the same sender and nonce
+		{a: sa, n: 1, msg: send, fee: 30},
+	})
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []int{2, 1}, selectLaneTxIDs(mp))
+}
+
+func TestValidateLanes(t *testing.T) {
+	require.NoError(t, mempool.ValidateLanes(mempool.DefaultLanes()))
+	require.Error(t, mempool.ValidateLanes([]mempool.Lane{{MsgTypeURLs: []string{"/a"}}}))
+	require.Error(t, mempool.ValidateLanes([]mempool.Lane{{Name: mempool.DefaultLaneName, MsgTypeURLs: []string{"/a"}}}))
+	require.Error(t, mempool.ValidateLanes([]mempool.Lane{{Name: "a"}}))
+	require.Error(t, mempool.ValidateLanes([]mempool.Lane{{Name: "a", MsgTypeURLs: []string{"/a"}, MaxTxs: -1}}))
+	require.Error(t, mempool.ValidateLanes([]mempool.Lane{
+		{Name: "a", MsgTypeURLs: []string{"/a"}},
+		{Name: "a", MsgTypeURLs: []string{"/b"}},
+	}))
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Span attribute keys of the store accesses made in a span.
+const (
+	SpanAttributeGasUsed       = "gas.used"
+	SpanAttributeStoreReads    = "store.reads"
+	SpanAttributeStoreWrites   = "store.writes"
+	SpanAttributeStoreHas      = "store.has"
+	SpanAttributeStoreDeletes  = "store.deletes"
+	SpanAttributeStoreIterNext = "store.iterator_next"
+)
+
+// StartSpan starts a span as a child of the span of a context, if tracing is enabled. It returns the context of the
+// span, whose gas meter counts the store accesses made with the context while the span is recording.
+func StartSpan(ctx Context, name string, attrs ...attribute.KeyValue) (Context, trace.Span) {
+	goCtx, span := telemetry.StartSpan(ctx.Context(), name, attrs...)
+	if !span.IsRecording() {
+		return ctx, span
+	}
+	ctx = ctx.WithContext(goCtx)
+	if ctx.GasMeter() != nil {
+		ctx = ctx.WithGasMeter(&storeAccessGasMeter{GasMeter: ctx.GasMeter(), start: ctx.GasMeter().GasConsumed()})
+	}
+	return ctx, span
+}
+
+// StartChildSpan starts a span as StartSpan does, only if the span of a context is recording, so that the spans of
+// ante decorators and message handlers are recorded only within the spans of the ABCI calls running them.
+func StartChildSpan(ctx Context, name string, attrs ...attribute.KeyValue) (Context, trace.Span) {
+	if !trace.SpanFromContext(ctx.Context()).IsRecording() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return StartSpan(ctx, name, attrs...)
+}
+
+// EndSpan ends a span started with StartSpan from a context, setting the store accesses made with the context as
+// attributes of the span, and its status to an error if any.
+func EndSpan(ctx Context, span trace.Span, err error) {
+	if meter, ok := ctx.GasMeter().(*storeAccessGasMeter); ok && span.IsRecording() {
+		span.SetAttributes(
+			attribute.Int64(SpanAttributeGasUsed, int64(meter.GasConsumed()-meter.start)),
+			attribute.Int(SpanAttributeStoreReads, meter.reads),
+			attribute.Int(SpanAttributeStoreWrites, meter.writes),
+			attribute.Int(SpanAttributeStoreHas, meter.has),
+			attribute.Int(SpanAttributeStoreDeletes, meter.deletes),
+			attribute.Int(SpanAttributeStoreIterNext, meter.iterNext),
+		)
+	}
+	telemetry.EndSpan(span, err)
+}
+
+// restoreSpanParent returns a context returned by a call made with the context of a span started from a parent
+// context, with the Go context and the gas meter of the parent if the call did not replace them.
+func restoreSpanParent(parent, spanCtx, ctx Context) Context {
+	if ctx.Context() == spanCtx.Context() {
+		ctx = ctx.WithContext(parent.Context())
+	}
+	if meter, ok := spanCtx.GasMeter().(*storeAccessGasMeter); ok && ctx.GasMeter() == meter {
+		ctx = ctx.WithGasMeter(meter.GasMeter)
+	}
+	return ctx
+}
+
+// spanName returns the name of the span of a value such as an ante decorator, the name of its type.
+func spanName(v interface{}) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", v), "*")
+}
+
+// storeAccessGasMeter is a gas meter counting the store accesses charged to it.
+type storeAccessGasMeter struct {
+	storetypes.GasMeter
+
+	start    storetypes.Gas
+	reads    int
+	writes   int
+	has      int
+	deletes  int
+	iterNext int
+}
+
+func (g *storeAccessGasMeter) ConsumeRw(amount storetypes.Gas, descriptor string) {
+	switch descriptor {
+	case storetypes.GasReadCostFlatDesc:
+		g.reads++
+	case storetypes.GasWriteCostFlatDesc:
+		g.writes++
+	case storetypes.GasHasDesc:
+		g.has++
+	case storetypes.GasDeleteDesc:
+		g.deletes++
+	case storetypes.GasIterNextCostFlatDesc:
+		g.iterNext++
+	}
+	g.GasMeter.ConsumeRw(amount, descriptor)
+}
+
+func (g *storeAccessGasMeter) String() string {
+	return fmt.Sprintf("storeAccessGasMeter:\n  %s", g.GasMeter.String())
+}
@@ -114,13 +114,7 @@ func (cmfg ConsumeMsgGasDecorator) getMsgGas(ctx sdk.Context, tx sdk.Tx) (uint64
 	totalGas := uint64(0)
 	for _, msg := range msgs {
 		mgp := cmfg.ghk.GetMsgGasParams(ctx, sdk.MsgTypeURL(msg))
-		feeCalcGen, err := types.GetGasCalculatorGen(mgp)
-		if err != nil {
-			return 0, errors.Wrapf(err, "unrecognized msg type: %s", sdk.MsgTypeURL(msg))
-		}
-		feeCalc := feeCalcGen(mgp)
-
-		gas, err := feeCalc(msg)
+		gas, err := types.CalculateMsgGas(mgp, msg)
 		if err != nil {
 			return 0, err
 		}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+const flagSignature = "signature"
+
+// eip712Inspection is the output of the eip712 command, the EIP-712 sign bytes of a tx for both domains.
+type eip712Inspection struct {
+	Signer    string                 `json:"signer"`
+	Signature hexutil.Bytes          `json:"signature,omitempty"`
+	Legacy    eip712DomainInspection `json:"legacy"`
+	Altai     eip712DomainInspection `json:"altai"`
+}
+
+// eip712DomainInspection is the EIP-712 sign bytes of a tx for a domain, with the signer recovered from the
+// signature if any. A signature of the sign bytes of the other domain may not be recoverable.
+type eip712DomainInspection struct {
+	*authtx.EIP712SignBytes
+	RecoveredSigner string `json:"recovered_signer,omitempty"`
+	RecoveryError   string `json:"recovery_error,omitempty"`
+	SignerMatches   *bool  `json:"signer_matches,omitempty"`
+}
+
+// GetEIP712Command returns the command printing the EIP-712 sign bytes of a transaction offline.
+func GetEIP712Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eip712 [file]",
+		Short: "Print the EIP-712 typed data and hashes a transaction is signed over",
+		Long: `Print the EIP-712 typed data of the sign bytes of an unsigned or signed transaction, with its
+domain, domain separator, struct hash and final digest, for the given --chain-id, --account-number
+and --sequence. The sign bytes are printed both for the legacy domain and for the domain used since
+the Altai upgrade, which has the greenfield verifying contract address.
+
+The signer is recovered from the signature given with --signature, or else from the EIP-712 signature
+of the transaction if it is signed, and compared with the signer of the transaction.
+No RPC communication with a full node is performed.
+`,
+		Example: fmt.Sprintf("$ %s tx eip712 tx.json --chain-id greenfield_9000-1 --account-number 1 --sequence 0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("the --%s flag is required", flags.FlagChainID)
+			}
+			accNum, _ := cmd.Flags().GetUint64(flags.FlagAccountNumber)
+			seq, _ := cmd.Flags().GetUint64(flags.FlagSequence)
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			sigTx, ok := stdTx.(authsigning.SigVerifiableTx)
+			if !ok {
+				return fmt.Errorf("expected a tx with signatures, got %T", stdTx)
+			}
+			signers := sigTx.GetSigners()
+			if len(signers) != 1 {
+				return fmt.Errorf("expected a tx with a single signer, got %d", len(signers))
+			}
+
+			sig, err := eip712Signature(cmd, sigTx)
+			if err != nil {
+				return err
+			}
+
+			signerData := authsigning.SignerData{
+				Address:       signers[0].String(),
+				ChainID:       clientCtx.ChainID,
+				AccountNumber: accNum,
+				Sequence:      seq,
+			}
+			inspection := eip712Inspection{
+				Signer:    signers[0].String(),
+				Signature: sig,
+			}
+			if inspection.Legacy, err = inspectEIP712Domain(signerData, stdTx, false, signers[0], sig); err != nil {
+				return err
+			}
+			if inspection.Altai, err = inspectEIP712Domain(signerData, stdTx, true, signers[0], sig); err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(inspection)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().String(flagSignature, "", "The hex [R||S||V] EIP-712 signature to recover the signer from, instead of the one of the tx")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// eip712Signature returns the signature given with the signature flag, else the EIP-712 signature of a tx if any.
+func eip712Signature(cmd *cobra.Command, sigTx authsigning.SigVerifiableTx) ([]byte, error) {
+	if sigHex, _ := cmd.Flags().GetString(flagSignature); sigHex != "" {
+		sig, err := hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature: %w", err)
+		}
+		return sig, nil
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) == 0 {
+		return nil, nil
+	}
+	data, ok := sigs[0].Data.(*signing.SingleSignatureData)
+	if !ok || data.SignMode != signing.SignMode_SIGN_MODE_EIP_712 || len(data.Signature) == 0 {
+		return nil, nil
+	}
+	return data.Signature, nil
+}
+
+// inspectEIP712Domain returns the EIP-712 sign bytes of a tx for a domain, with the signer recovered from a
+// signature if any.
+func inspectEIP712Domain(
+	signerData authsigning.SignerData, tx sdk.Tx, verifyingContract bool, signer sdk.AccAddress, sig []byte,
+) (eip712DomainInspection, error) {
+	signBytes, err := authtx.InspectEIP712SignBytes(signerData, tx, verifyingContract)
+	if err != nil {
+		return eip712DomainInspection{}, err
+	}
+	inspection := eip712DomainInspection{EIP712SignBytes: signBytes}
+	if len(sig) == 0 {
+		return inspection, nil
+	}
+
+	matches := false
+	inspection.SignerMatches = &matches
+	pubKey, err := authsigning.RecoverEip712PubKey(sig, signBytes.Digest)
+	if err != nil {
+		inspection.RecoveryError = err.Error()
+		return inspection, nil
+	}
+	recovered := sdk.AccAddress(pubKey.Address())
+	matches = recovered.Equals(signer)
+	inspection.RecoveredSigner = recovered.String()
+	return inspection, nil
+}
@@ -103,3 +103,34 @@ func verifyEip712Signature(pubKey cryptotypes.PubKey, sig []byte, msg []byte) er
 
 	return nil
 }
+
+// RecoverEip712PubKey recovers the public key of the signer of EIP-712 sign bytes from a [R||S||V] signature,
+// with or without the recovery offset of Metamask. It checks the length of the signature and doesn't modify it,
+// unlike verifyEip712Signature, whose handling of the malformed signatures is part of the consensus, so it's
+// only meant for the clients.
+func RecoverEip712PubKey(sig []byte, msg []byte) (*ethsecp256k1.PubKey, error) {
+	if len(sig) != ethcrypto.SignatureLength {
+		return nil, errorsmod.Wrap(sdkerrors.ErrorInvalidSigner, "signature length doesn't match typical [R||S||V] signature 65 bytes")
+	}
+
+	// remove the recovery offset if needed (ie. Metamask eip712 signature)
+	sig = append([]byte(nil), sig...)
+	if sig[ethcrypto.RecoveryIDOffset] == 27 || sig[ethcrypto.RecoveryIDOffset] == 28 {
+		sig[ethcrypto.RecoveryIDOffset] -= 27
+	}
+
+	// recover the pubkey from the signature
+	signerPubkey, err := secp256k1.RecoverPubkey(msg, sig)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to recover signer from sig")
+	}
+
+	ecPubKey, err := ethcrypto.UnmarshalPubkey(signerPubkey)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unmarshal recovered signer pubkey")
+	}
+
+	return &ethsecp256k1.PubKey{
+		Key: ethcrypto.CompressPubkey(ecPubKey),
+	}, nil
+}
@@ -15,6 +15,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
@@ -30,7 +31,6 @@ import (
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 var (
@@ -44,6 +44,10 @@ var (
 	gnfdVerifyingContract = "0x71e835aff094655dEF897fbc85534186DbeaB75d" // keccak256("greenfield")[12:]
 )
 
+// FeatureEIP712VerifyingContract uses the greenfield verifying contract address in the EIP-712 domain.
+var FeatureEIP712VerifyingContract = sdk.RegisterFeature("auth", "EIP712VerifyingContract", sdk.Altai,
+	"the EIP-712 domain of sign bytes uses the greenfield verifying contract address")
+
 // signModeEip712Handler defines the SIGN_MODE_DIRECT SignModeHandler
 type signModeEip712Handler struct{}
 
@@ -66,7 +70,7 @@ func (h signModeEip712Handler) GetSignBytes(mode signingtypes.SignMode, signerDa
 
 // GetSignBytesRuntime implements SignModeHandler.GetSignBytesRuntime
 func (h signModeEip712Handler) GetSignBytesRuntime(ctx sdk.Context, mode signingtypes.SignMode, signerData signing.SignerData, tx sdk.Tx) ([]byte, error) {
-	if !ctx.IsUpgraded(upgradetypes.Altai) {
+	if !ctx.IsFeatureEnabled(FeatureEIP712VerifyingContract) {
 		return h.GetSignBytes(mode, signerData, tx)
 	}
 	return getSignBytes(mode, signerData, tx, true)
@@ -77,14 +81,23 @@ func getSignBytes(mode signingtypes.SignMode, signerData signing.SignerData, tx
 		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_EIP_712, mode)
 	}
 
+	typedData, err := getTypedData(signerData, tx, isAltai)
+	if err != nil {
+		return nil, err
+	}
+
+	return ComputeTypedDataHash(typedData)
+}
+
+func getTypedData(signerData signing.SignerData, tx sdk.Tx, isAltai bool) (apitypes.TypedData, error) {
 	chainID, err := sdk.ParseChainID(signerData.ChainID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse chainID: %s", signerData.ChainID)
+		return apitypes.TypedData{}, fmt.Errorf("failed to parse chainID: %s", signerData.ChainID)
 	}
 
 	msgTypes, signDoc, err := GetMsgTypes(signerData, tx, chainID)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to get msg types")
+		return apitypes.TypedData{}, errorsmod.Wrap(err, "failed to get msg types")
 	}
 
 	typedDataDomain := *domain
@@ -95,10 +108,50 @@ func getSignBytes(mode signingtypes.SignMode, signerData signing.SignerData, tx
 
 	typedData, err := WrapTxToTypedData(signDoc, msgTypes, typedDataDomain)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to pack tx data in EIP712 object")
+		return apitypes.TypedData{}, errorsmod.Wrap(err, "failed to pack tx data in EIP712 object")
 	}
+	return typedData, nil
+}
 
-	return ComputeTypedDataHash(typedData)
+// EIP712SignBytes is the EIP-712 typed data of the sign bytes of a tx, with the hashes they are computed from.
+type EIP712SignBytes struct {
+	TypedData       apitypes.TypedData       `json:"typed_data"`
+	Domain          apitypes.TypedDataDomain `json:"domain"`
+	DomainSeparator hexutil.Bytes            `json:"domain_separator"`
+	StructHash      hexutil.Bytes            `json:"struct_hash"`
+	// Digest is the hash of the domain separator and the struct hash, the sign bytes of the tx.
+	Digest hexutil.Bytes `json:"digest"`
+}
+
+// InspectEIP712SignBytes returns the EIP-712 sign bytes of a tx for a signer, with their typed data and hashes.
+// The domain uses the greenfield verifying contract address if verifyingContract, as it does since the Altai
+// upgrade.
+func InspectEIP712SignBytes(signerData signing.SignerData, tx sdk.Tx, verifyingContract bool) (*EIP712SignBytes, error) {
+	typedData, err := getTypedData(signerData, tx, verifyingContract)
+	if err != nil {
+		return nil, err
+	}
+	// the typed data are marshalled before being hashed, since hashing them converts their values
+	bz, err := json.Marshal(typedData)
+	if err != nil {
+		return nil, err
+	}
+	var inspected apitypes.TypedData
+	if err := json.Unmarshal(bz, &inspected); err != nil {
+		return nil, err
+	}
+
+	domainSeparator, structHash, err := hashTypedData(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return &EIP712SignBytes{
+		TypedData:       inspected,
+		Domain:          inspected.Domain,
+		DomainSeparator: domainSeparator,
+		StructHash:      structHash,
+		Digest:          typedDataDigest(domainSeparator, structHash),
+	}, nil
 }
 
 func GetMsgTypes(signerData signing.SignerData, tx sdk.Tx, typedChainID *big.Int) (apitypes.Types, *types.SignDocEip712, error) {
@@ -202,20 +255,32 @@ func GetMsgTypes(signerData signing.SignerData, tx sdk.Tx, typedChainID *big.Int
 
 // ComputeTypedDataHash computes keccak hash of typed data for signing.
 func ComputeTypedDataHash(typedData apitypes.TypedData) ([]byte, error) {
+	domainSeparator, typedDataHash, err := hashTypedData(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return typedDataDigest(domainSeparator, typedDataHash), nil
+}
+
+// hashTypedData returns the domain separator and the hash of the primary type struct of typed data.
+func hashTypedData(typedData apitypes.TypedData) ([]byte, []byte, error) {
 	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
 	if err != nil {
 		err = errorsmod.Wrap(err, "failed to pack and hash typedData EIP712Domain")
-		return nil, err
+		return nil, nil, err
 	}
 
 	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
 	if err != nil {
 		err = errorsmod.Wrap(err, "failed to pack and hash typedData primary type")
-		return nil, err
+		return nil, nil, err
 	}
+	return domainSeparator, typedDataHash, nil
+}
 
+func typedDataDigest(domainSeparator, typedDataHash []byte) []byte {
 	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-	return crypto.Keccak256(rawData), nil
+	return crypto.Keccak256(rawData)
 }
 
 func WrapTxToTypedData(
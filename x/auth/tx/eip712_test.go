@@ -2,6 +2,7 @@ package tx
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -327,3 +328,60 @@ func TestMultiMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, signBytes)
 }
+
+func TestInspectEIP712SignBytes(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddrEthSecp256k1(require.New(t))
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &banktypes.MsgSend{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712})
+	txBuilder := txConfig.NewTxBuilder()
+	testMsg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))))
+	require.NoError(t, txBuilder.SetMsgs(testMsg))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "greenfield_9000-1",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+	modeHandler := signModeEip712Handler{}
+
+	t.Log("verify the digest of the legacy domain is the sign bytes")
+	inspection, err := InspectEIP712SignBytes(signingData, txBuilder.GetTx(), false)
+	require.NoError(t, err)
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, []byte(inspection.Digest))
+	require.Equal(t, "greenfield", inspection.Domain.VerifyingContract)
+	require.Equal(t, int64(9000), (*big.Int)(inspection.Domain.ChainId).Int64())
+	require.Equal(t, "Tx", inspection.TypedData.PrimaryType)
+	require.Len(t, inspection.DomainSeparator, 32)
+	require.Len(t, inspection.StructHash, 32)
+
+	t.Log("verify the digest of the Altai domain is the runtime sign bytes")
+	altaiInspection, err := InspectEIP712SignBytes(signingData, txBuilder.GetTx(), true)
+	require.NoError(t, err)
+	altaiSignBytes, err := getSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx(), true)
+	require.NoError(t, err)
+	require.Equal(t, altaiSignBytes, []byte(altaiInspection.Digest))
+	require.Equal(t, gnfdVerifyingContract, altaiInspection.Domain.VerifyingContract)
+	require.NotEqual(t, inspection.DomainSeparator, altaiInspection.DomainSeparator)
+	require.Equal(t, inspection.StructHash, altaiInspection.StructHash)
+
+	t.Log("verify the signer is recovered from the signature of the digest")
+	sig, err := privKey.Sign(altaiInspection.Digest)
+	require.NoError(t, err)
+	recovered, err := signing.RecoverEip712PubKey(sig, altaiInspection.Digest)
+	require.NoError(t, err)
+	require.True(t, pubkey.Equals(recovered))
+	recovered, err = signing.RecoverEip712PubKey(sig, inspection.Digest)
+	require.NoError(t, err)
+	require.False(t, pubkey.Equals(recovered))
+	_, err = signing.RecoverEip712PubKey(sig[:64], inspection.Digest)
+	require.Error(t, err)
+}
@@ -0,0 +1,7 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// FeaturePaymentAccountRecipient forbids payment accounts as recipients of MsgSend and MsgMultiSend.
+var FeaturePaymentAccountRecipient = sdk.RegisterFeature(ModuleName, "PaymentAccountRecipient", sdk.Nagqu,
+	"MsgSend and MsgMultiSend reject payment accounts as recipients")
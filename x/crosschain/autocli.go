@@ -0,0 +1,72 @@
+package crosschain
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	crosschainv1 "cosmossdk.io/api/cosmos/crosschain/v1"
+)
+
+// channelFlagOptions are the flag options of the chain and channel IDs identifying a channel.
+var channelFlagOptions = map[string]*autocliv1.FlagOptions{
+	"dest_chain_id": {Usage: "chain ID of the destination chain of the channel"},
+	"channel_id":    {Usage: "ID of the cross-chain channel"},
+}
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: crosschainv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current crosschain parameters",
+				},
+				{
+					RpcMethod:      "CrossChainPackage",
+					Use:            "package [sequence] --dest-chain-id [id] --channel-id [id]",
+					Short:          "Query the cross-chain package sent with a sequence in a channel",
+					Example:        "package 10 --dest-chain-id 56 --channel-id 1",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sequence"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"dest_chain_id": channelFlagOptions["dest_chain_id"],
+						"channel_id":    channelFlagOptions["channel_id"],
+						"decode":        {Usage: "also return the package decoded in JSON"},
+					},
+				},
+				{
+					RpcMethod:      "DecodeCrossChainPackage",
+					Use:            "decode [package] --channel-id [id]",
+					Short:          "Decode a hex cross-chain package of a channel",
+					Long:           "Decode a hex cross-chain package of a channel. A package sent in a channel is decoded by its sequence with the package command and its --decode flag.",
+					Example:        "decode 0x00... --channel-id 3",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "package"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"channel_id": channelFlagOptions["channel_id"],
+					},
+				},
+				{
+					RpcMethod:   "SendSequence",
+					Use:         "send-sequence --dest-chain-id [id] --channel-id [id]",
+					Short:       "Query the sequence of the next package sent in a channel",
+					FlagOptions: channelFlagOptions,
+				},
+				{
+					RpcMethod:   "ReceiveSequence",
+					Use:         "receive-sequence --dest-chain-id [id] --channel-id [id]",
+					Short:       "Query the sequence of the next package received in a channel",
+					FlagOptions: channelFlagOptions,
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: crosschainv1.Msg_ServiceDesc.ServiceName,
+			// the msgs are all submitted by governance proposals
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{RpcMethod: "UpdateParams", Skip: true},
+				{RpcMethod: "UpdateChannelPermissions", Skip: true},
+				{RpcMethod: "MintModuleTokens", Skip: true},
+			},
+		},
+	}
+}
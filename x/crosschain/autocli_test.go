@@ -0,0 +1,15 @@
+package crosschain_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/client/v2/autocli"
+	autoclitestutil "cosmossdk.io/client/v2/autocli/testutil"
+
+	"github.com/cosmos/cosmos-sdk/x/crosschain"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+)
+
+func TestAutoCLIOptions(t *testing.T) {
+	autoclitestutil.AssertGolden(t, &autocli.Builder{}, types.ModuleName, crosschain.AppModule{}.AutoCLIOptions())
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -22,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		QueryParamsCmd(),
+		DecodePackageCmd(),
 	)
 
 	return cmd
@@ -57,3 +61,87 @@ $ <appd> query crosschain params
 
 	return cmd
 }
+
+const (
+	FlagDestChainID = "dest-chain-id"
+	FlagChannelID   = "channel-id"
+	FlagSequence    = "sequence"
+)
+
+// DecodePackageCmd returns the command handler for decoding a cross chain package.
+func DecodePackageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode [package-hex] --channel-id [id]",
+		Short: "Decode a cross chain package given in hex or by its chain, channel and sequence",
+		Args:  cobra.MaximumNArgs(1),
+		Long: strings.TrimSpace(`Decode the header and the payload of a cross chain package, the payload being decoded by
+the cross chain app of the channel. The package is either given in hex, as the package load of the
+cross chain events with its header, or queried by its destination chain, channel and sequence:
+
+$ <appd> query crosschain decode 0x00... --channel-id 3
+$ <appd> query crosschain decode --dest-chain-id 56 --channel-id 3 --sequence 10
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			channelID, err := cmd.Flags().GetUint32(FlagChannelID)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var decoded string
+			if len(args) == 1 {
+				pack, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+				if err != nil {
+					return fmt.Errorf("invalid package hex: %w", err)
+				}
+				res, err := queryClient.DecodeCrossChainPackage(cmd.Context(), &types.QueryDecodeCrossChainPackageRequest{
+					ChannelId: channelID,
+					Package:   pack,
+				})
+				if err != nil {
+					return err
+				}
+				decoded = res.Decoded
+			} else {
+				if !cmd.Flags().Changed(FlagDestChainID) || !cmd.Flags().Changed(FlagSequence) {
+					return fmt.Errorf("either the package hex or the --%s and --%s flags are required", FlagDestChainID, FlagSequence)
+				}
+				destChainID, err := cmd.Flags().GetUint32(FlagDestChainID)
+				if err != nil {
+					return err
+				}
+				sequence, err := cmd.Flags().GetUint64(FlagSequence)
+				if err != nil {
+					return err
+				}
+				res, err := queryClient.CrossChainPackage(cmd.Context(), &types.QueryCrossChainPackageRequest{
+					DestChainId: destChainID,
+					ChannelId:   channelID,
+					Sequence:    sequence,
+					Decode:      true,
+				})
+				if err != nil {
+					return err
+				}
+				if len(res.Package) == 0 {
+					return fmt.Errorf("no package with sequence %d in channel %d to chain %d", sequence, channelID, destChainID)
+				}
+				decoded = res.Decoded
+			}
+
+			return clientCtx.PrintRaw(json.RawMessage(decoded))
+		},
+	}
+
+	cmd.Flags().Uint32(FlagChannelID, 0, "ID of the cross chain channel of the package")
+	cmd.Flags().Uint32(FlagDestChainID, 0, "chain ID of the destination chain of the package")
+	cmd.Flags().Uint64(FlagSequence, 0, "sequence of the package in the channel")
+	_ = cmd.MarkFlagRequired(FlagChannelID)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,9 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+)
 
 type crossChainConfig struct {
 	srcChainID     sdk.ChainID
@@ -10,6 +13,8 @@ type crossChainConfig struct {
 	nameToChannelID map[string]sdk.ChannelID
 	channelIDToName map[sdk.ChannelID]string
 	channelIDToApp  map[sdk.ChannelID]sdk.CrossChainApplication
+
+	packageProofRetention int64
 }
 
 func newCrossChainCfg() *crossChainConfig {
@@ -20,6 +25,8 @@ func newCrossChainCfg() *crossChainConfig {
 		nameToChannelID: make(map[string]sdk.ChannelID),
 		channelIDToName: make(map[sdk.ChannelID]string),
 		channelIDToApp:  make(map[sdk.ChannelID]sdk.CrossChainApplication),
+
+		packageProofRetention: types.DefaultPackageProofRetention,
 	}
 	return config
 }
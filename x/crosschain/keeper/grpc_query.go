@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
@@ -30,11 +31,45 @@ func (k Keeper) CrossChainPackage(c context.Context, req *types.QueryCrossChainP
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryCrossChainPackageResponse{
+	res := &types.QueryCrossChainPackageResponse{
 		Package: pack,
+	}
+	if req.Decode && len(pack) > 0 {
+		if res.Decoded, err = k.decodeCrossChainPackage(sdk.ChannelID(req.ChannelId), pack); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// DecodeCrossChainPackage decodes a cross chain package of a channel
+func (k Keeper) DecodeCrossChainPackage(c context.Context, req *types.QueryDecodeCrossChainPackageRequest) (*types.QueryDecodeCrossChainPackageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	decoded, err := k.decodeCrossChainPackage(sdk.ChannelID(req.ChannelId), req.Package)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDecodeCrossChainPackageResponse{
+		Decoded: decoded,
 	}, nil
 }
 
+// decodeCrossChainPackage returns the JSON of a decoded cross chain package
+func (k Keeper) decodeCrossChainPackage(channelID sdk.ChannelID, pack []byte) (string, error) {
+	decoded, err := k.DecodePackage(channelID, pack)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	bz, err := json.Marshal(decoded)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
 // SendSequence returns the send sequence of the channel
 func (k Keeper) SendSequence(c context.Context, req *types.QuerySendSequenceRequest) (*types.QuerySendSequenceResponse, error) {
 	if req == nil {
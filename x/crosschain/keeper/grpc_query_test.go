@@ -1,9 +1,17 @@
 package keeper_test
 
 import (
+	"bytes"
 	gocontext "context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func (s *TestSuite) TestQueryParams() {
@@ -12,3 +20,57 @@ func (s *TestSuite) TestQueryParams() {
 	s.Require().NotNil(res)
 	s.Require().Equal(s.crossChainKeeper.GetParams(s.ctx), res.GetParams())
 }
+
+func (s *TestSuite) TestQueryDecodeCrossChainPackage() {
+	s.crossChainKeeper.SetDestBscChainID(1)
+	s.crossChainKeeper.SetChannelSendPermission(s.ctx, sdk.ChainID(1), govtypes.SyncParamsChannelID, sdk.ChannelAllow)
+	s.Require().NoError(s.crossChainKeeper.RegisterChannel(govtypes.SyncParamsChannel, govtypes.SyncParamsChannelID, govkeeper.SyncParamsApp{}))
+
+	target := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.EthAddressLength))
+	payload, err := govtypes.SyncParamsPackage{Key: "batchSizeForOracle", Value: []byte{0x32}, Target: target}.Serialize()
+	s.Require().NoError(err)
+	sequence, err := s.crossChainKeeper.CreateRawIBCPackageWithFee(s.ctx, sdk.ChainID(1), govtypes.SyncParamsChannelID,
+		sdk.SynCrossChainPackageType, payload, big.NewInt(1), big.NewInt(2))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.CrossChainPackage(gocontext.Background(), &types.QueryCrossChainPackageRequest{
+		DestChainId: 1,
+		ChannelId:   uint32(govtypes.SyncParamsChannelID),
+		Sequence:    sequence,
+		Decode:      true,
+	})
+	s.Require().NoError(err)
+
+	var decoded types.DecodedPackage
+	s.Require().NoError(json.Unmarshal([]byte(res.Decoded), &decoded))
+	s.Require().Equal("syn", decoded.PackageType)
+	s.Require().Equal("1", decoded.RelayerFee)
+	s.Require().Equal("2", decoded.AckRelayerFee)
+	s.Require().Equal(hex.EncodeToString(payload), decoded.Payload)
+	s.Require().JSONEq(fmt.Sprintf(`{"key":"batchSizeForOracle","value":"0x32","targets":["%s"]}`, target), string(decoded.DecodedPayload))
+
+	// the same package decoded from its bytes
+	decodeRes, err := s.queryClient.DecodeCrossChainPackage(gocontext.Background(), &types.QueryDecodeCrossChainPackageRequest{
+		ChannelId: uint32(govtypes.SyncParamsChannelID),
+		Package:   res.Package,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(res.Decoded, decodeRes.Decoded)
+
+	// the payloads of the channels without a decoder are only in hex
+	decodeRes, err = s.queryClient.DecodeCrossChainPackage(gocontext.Background(), &types.QueryDecodeCrossChainPackageRequest{
+		ChannelId: 100,
+		Package:   res.Package,
+	})
+	s.Require().NoError(err)
+	var undecoded types.DecodedPackage
+	s.Require().NoError(json.Unmarshal([]byte(decodeRes.Decoded), &undecoded))
+	s.Require().Equal(decoded.Payload, undecoded.Payload)
+	s.Require().Nil(undecoded.DecodedPayload)
+
+	_, err = s.queryClient.DecodeCrossChainPackage(gocontext.Background(), &types.QueryDecodeCrossChainPackageRequest{
+		ChannelId: uint32(govtypes.SyncParamsChannelID),
+		Package:   []byte{0x09},
+	})
+	s.Require().Error(err)
+}
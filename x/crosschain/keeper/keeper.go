@@ -244,6 +244,17 @@ func (k Keeper) GetDestOpChainID() sdk.ChainID {
 	return k.cfg.destOpChainId
 }
 
+// SetPackageProofRetention sets the number of last heights pinned against pruning for the relayers to prove the
+// cross-chain packages sent, zero pins none
+func (k Keeper) SetPackageProofRetention(blocks int64) {
+	k.cfg.packageProofRetention = blocks
+}
+
+// GetPackageProofRetention gets the number of last heights pinned for the proofs of the cross-chain packages
+func (k Keeper) GetPackageProofRetention() int64 {
+	return k.cfg.packageProofRetention
+}
+
 // GetCrossChainPackage returns the ibc package by sequence
 func (k Keeper) GetCrossChainPackage(ctx sdk.Context, destChainId sdk.ChainID, channelId sdk.ChannelID, sequence uint64) ([]byte, error) {
 	kvStore := ctx.KVStore(k.storeKey)
@@ -302,6 +313,13 @@ func (k Keeper) GetCrossChainApp(channelID sdk.ChannelID) sdk.CrossChainApplicat
 	return k.cfg.channelIDToApp[channelID]
 }
 
+// DecodePackage decodes a cross chain package of a channel, with the payload decoder of the cross chain
+// app of the channel if any
+func (k Keeper) DecodePackage(channelID sdk.ChannelID, pack []byte) (types.DecodedPackage, error) {
+	decoder, _ := k.GetCrossChainApp(channelID).(sdk.CrossChainPayloadDecoder)
+	return types.DecodePackage(pack, decoder)
+}
+
 func (k Keeper) MintModuleAccountTokens(ctx sdk.Context, amount math.Int) error {
 	bondDenom := k.stakingKeeper.BondDenom(ctx)
 	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{sdk.Coin{
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RetentionConstraint pins the last heights of the package proof retention, at which the relayers query the
+// cross-chain packages sent with their proofs. It is meant to be registered with BaseApp.AddRetentionConstraint.
+func (k Keeper) RetentionConstraint(ctx sdk.Context) []pruningtypes.PinnedHeights {
+	retention := k.GetPackageProofRetention()
+	if retention <= 0 {
+		return nil
+	}
+
+	start := ctx.BlockHeight() - retention + 1
+	if start < 1 {
+		start = 1
+	}
+	return []pruningtypes.PinnedHeights{{
+		Start:  start,
+		End:    ctx.BlockHeight(),
+		Reason: "crosschain: proofs of the cross-chain packages sent",
+	}}
+}
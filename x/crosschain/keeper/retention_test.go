@@ -0,0 +1,22 @@
+package keeper_test
+
+import (
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+)
+
+func (s *TestSuite) TestRetentionConstraint() {
+	reason := "crosschain: proofs of the cross-chain packages sent"
+	s.Require().Equal(types.DefaultPackageProofRetention, s.crossChainKeeper.GetPackageProofRetention())
+
+	s.crossChainKeeper.SetPackageProofRetention(10)
+	s.Require().Equal([]pruningtypes.PinnedHeights{{Start: 91, End: 100, Reason: reason}},
+		s.crossChainKeeper.RetentionConstraint(s.ctx.WithBlockHeight(100)))
+	// the pinned heights start at the first block
+	s.Require().Equal([]pruningtypes.PinnedHeights{{Start: 1, End: 5, Reason: reason}},
+		s.crossChainKeeper.RetentionConstraint(s.ctx.WithBlockHeight(5)))
+
+	// nothing is pinned without retention
+	s.crossChainKeeper.SetPackageProofRetention(0)
+	s.Require().Empty(s.crossChainKeeper.RetentionConstraint(s.ctx.WithBlockHeight(100)))
+}
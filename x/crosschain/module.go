@@ -21,9 +21,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/keeper"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
-	"github.com/cosmos/cosmos-sdk/x/params/client/cli"
 )
 
 var (
@@ -69,9 +69,9 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 // GetTxCmd returns no root tx command for the params module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command { return nil }
 
-// GetQueryCmd returns no root query command for the params module.
+// GetQueryCmd returns the root query command for the cross chain module.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return cli.NewQueryCmd()
+	return cli.GetQueryCmd()
 }
 
 func (am AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DecodedPackage is the human-readable form of a cross chain package.
+type DecodedPackage struct {
+	PackageType   string `json:"package_type"`
+	Timestamp     uint64 `json:"timestamp"`
+	RelayerFee    string `json:"relayer_fee"`
+	AckRelayerFee string `json:"ack_relayer_fee,omitempty"`
+	// Payload is the hex payload of the package.
+	Payload string `json:"payload"`
+	// DecodedPayload is the payload decoded by the cross chain app of the channel, if it's a payload decoder.
+	DecodedPayload json.RawMessage `json:"decoded_payload,omitempty"`
+}
+
+// DecodePackage decodes the header of a cross chain package, and its payload with a decoder if not nil.
+func DecodePackage(pack []byte, decoder sdk.CrossChainPayloadDecoder) (DecodedPackage, error) {
+	header, err := sdk.DecodePackageHeader(pack)
+	if err != nil {
+		return DecodedPackage{}, err
+	}
+	payload := pack[sdk.GetPackageHeaderLength(header.PackageType):]
+
+	decoded := DecodedPackage{
+		PackageType: header.PackageType.String(),
+		Timestamp:   header.Timestamp,
+		RelayerFee:  header.RelayerFee.String(),
+		Payload:     hex.EncodeToString(payload),
+	}
+	// only syn packages pay an ack relayer fee
+	if header.PackageType == sdk.SynCrossChainPackageType {
+		decoded.AckRelayerFee = header.AckRelayerFee.String()
+	}
+	if decoder != nil {
+		if decoded.DecodedPayload, err = decoder.DecodePayload(header.PackageType, payload); err != nil {
+			return DecodedPackage{}, fmt.Errorf("failed to decode the payload: %w", err)
+		}
+	}
+	return decoded, nil
+}
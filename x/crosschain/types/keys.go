@@ -23,6 +23,10 @@ const (
 
 	MaxSideChainIdLength = 20
 	SequenceLength       = 8
+
+	// DefaultPackageProofRetention is the default number of last heights pinned against pruning for the
+	// relayers to prove the cross-chain packages sent.
+	DefaultPackageProofRetention int64 = 1000
 )
 
 var (
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -120,6 +121,8 @@ type QueryCrossChainPackageRequest struct {
 	ChannelId uint32 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	// sequence of the cross chain package
 	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// decode defines whether to also return the decoded package
+	Decode bool `protobuf:"varint,4,opt,name=decode,proto3" json:"decode,omitempty"`
 }
 
 func (m *QueryCrossChainPackageRequest) Reset()         { *m = QueryCrossChainPackageRequest{} }
@@ -176,10 +179,19 @@ func (m *QueryCrossChainPackageRequest) GetSequence() uint64 {
 	return 0
 }
 
+func (m *QueryCrossChainPackageRequest) GetDecode() bool {
+	if m != nil {
+		return m.Decode
+	}
+	return false
+}
+
 // QueryCrossChainPackageResponse is the response type for the Query/CrossChainPackage RPC method.
 type QueryCrossChainPackageResponse struct {
 	// content of the cross chain package
 	Package []byte `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// decoded package in JSON, if requested and the package exists
+	Decoded string `protobuf:"bytes,2,opt,name=decoded,proto3" json:"decoded,omitempty"`
 }
 
 func (m *QueryCrossChainPackageResponse) Reset()         { *m = QueryCrossChainPackageResponse{} }
@@ -222,6 +234,114 @@ func (m *QueryCrossChainPackageResponse) GetPackage() []byte {
 	return nil
 }
 
+func (m *QueryCrossChainPackageResponse) GetDecoded() string {
+	if m != nil {
+		return m.Decoded
+	}
+	return ""
+}
+
+// QueryDecodeCrossChainPackageRequest is the request type for the Query/DecodeCrossChainPackage RPC method.
+type QueryDecodeCrossChainPackageRequest struct {
+	// channel id of the cross chain package
+	ChannelId uint32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// content of the cross chain package, with its header
+	Package []byte `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+}
+
+func (m *QueryDecodeCrossChainPackageRequest) Reset()         { *m = QueryDecodeCrossChainPackageRequest{} }
+func (m *QueryDecodeCrossChainPackageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodeCrossChainPackageRequest) ProtoMessage()    {}
+func (*QueryDecodeCrossChainPackageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{4}
+}
+func (m *QueryDecodeCrossChainPackageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecodeCrossChainPackageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodeCrossChainPackageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDecodeCrossChainPackageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodeCrossChainPackageRequest.Merge(m, src)
+}
+func (m *QueryDecodeCrossChainPackageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecodeCrossChainPackageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodeCrossChainPackageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecodeCrossChainPackageRequest proto.InternalMessageInfo
+
+func (m *QueryDecodeCrossChainPackageRequest) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *QueryDecodeCrossChainPackageRequest) GetPackage() []byte {
+	if m != nil {
+		return m.Package
+	}
+	return nil
+}
+
+// QueryDecodeCrossChainPackageResponse is the response type for the Query/DecodeCrossChainPackage RPC method.
+type QueryDecodeCrossChainPackageResponse struct {
+	// decoded package in JSON
+	Decoded string `protobuf:"bytes,1,opt,name=decoded,proto3" json:"decoded,omitempty"`
+}
+
+func (m *QueryDecodeCrossChainPackageResponse) Reset()         { *m = QueryDecodeCrossChainPackageResponse{} }
+func (m *QueryDecodeCrossChainPackageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDecodeCrossChainPackageResponse) ProtoMessage()    {}
+func (*QueryDecodeCrossChainPackageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{5}
+}
+func (m *QueryDecodeCrossChainPackageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecodeCrossChainPackageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecodeCrossChainPackageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDecodeCrossChainPackageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecodeCrossChainPackageResponse.Merge(m, src)
+}
+func (m *QueryDecodeCrossChainPackageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecodeCrossChainPackageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecodeCrossChainPackageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecodeCrossChainPackageResponse proto.InternalMessageInfo
+
+func (m *QueryDecodeCrossChainPackageResponse) GetDecoded() string {
+	if m != nil {
+		return m.Decoded
+	}
+	return ""
+}
+
 // QuerySendSequenceRequest is the request type for the Query/SendSequence RPC method.
 type QuerySendSequenceRequest struct {
 	// destination chain id
@@ -234,7 +354,7 @@ func (m *QuerySendSequenceRequest) Reset()         { *m = QuerySendSequenceReque
 func (m *QuerySendSequenceRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySendSequenceRequest) ProtoMessage()    {}
 func (*QuerySendSequenceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c0bc65cbea0cca3, []int{4}
+	return fileDescriptor_3c0bc65cbea0cca3, []int{6}
 }
 func (m *QuerySendSequenceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,7 +407,7 @@ func (m *QuerySendSequenceResponse) Reset()         { *m = QuerySendSequenceResp
 func (m *QuerySendSequenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySendSequenceResponse) ProtoMessage()    {}
 func (*QuerySendSequenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c0bc65cbea0cca3, []int{5}
+	return fileDescriptor_3c0bc65cbea0cca3, []int{7}
 }
 func (m *QuerySendSequenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -335,7 +455,7 @@ func (m *QueryReceiveSequenceRequest) Reset()         { *m = QueryReceiveSequenc
 func (m *QueryReceiveSequenceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryReceiveSequenceRequest) ProtoMessage()    {}
 func (*QueryReceiveSequenceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c0bc65cbea0cca3, []int{6}
+	return fileDescriptor_3c0bc65cbea0cca3, []int{8}
 }
 func (m *QueryReceiveSequenceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +508,7 @@ func (m *QueryReceiveSequenceResponse) Reset()         { *m = QueryReceiveSequen
 func (m *QueryReceiveSequenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryReceiveSequenceResponse) ProtoMessage()    {}
 func (*QueryReceiveSequenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c0bc65cbea0cca3, []int{7}
+	return fileDescriptor_3c0bc65cbea0cca3, []int{9}
 }
 func (m *QueryReceiveSequenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,6 +549,8 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.crosschain.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryCrossChainPackageRequest)(nil), "cosmos.crosschain.v1.QueryCrossChainPackageRequest")
 	proto.RegisterType((*QueryCrossChainPackageResponse)(nil), "cosmos.crosschain.v1.QueryCrossChainPackageResponse")
+	proto.RegisterType((*QueryDecodeCrossChainPackageRequest)(nil), "cosmos.crosschain.v1.QueryDecodeCrossChainPackageRequest")
+	proto.RegisterType((*QueryDecodeCrossChainPackageResponse)(nil), "cosmos.crosschain.v1.QueryDecodeCrossChainPackageResponse")
 	proto.RegisterType((*QuerySendSequenceRequest)(nil), "cosmos.crosschain.v1.QuerySendSequenceRequest")
 	proto.RegisterType((*QuerySendSequenceResponse)(nil), "cosmos.crosschain.v1.QuerySendSequenceResponse")
 	proto.RegisterType((*QueryReceiveSequenceRequest)(nil), "cosmos.crosschain.v1.QueryReceiveSequenceRequest")
@@ -438,41 +560,49 @@ func init() {
 func init() { proto.RegisterFile("cosmos/crosschain/v1/query.proto", fileDescriptor_3c0bc65cbea0cca3) }
 
 var fileDescriptor_3c0bc65cbea0cca3 = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0x73, 0xa5, 0x0d, 0xf0, 0xda, 0x0a, 0xf1, 0xc8, 0x10, 0x4c, 0x6a, 0x22, 0x43, 0x51,
-	0xaa, 0x0a, 0x9f, 0x92, 0x22, 0x21, 0x65, 0x6c, 0xa7, 0x6c, 0xad, 0xbb, 0x21, 0xa1, 0xe0, 0xda,
-	0x27, 0xc7, 0x6a, 0x73, 0xe7, 0xfa, 0x9c, 0x88, 0x2e, 0x0c, 0x30, 0xb2, 0x20, 0xb1, 0xf2, 0x07,
-	0xb0, 0xf2, 0x5f, 0x74, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0xc2, 0x1f, 0x82, 0x7c, 0xbe, 0x86, 0xd0,
-	0x5e, 0x42, 0x2b, 0xc1, 0x94, 0xf8, 0xe5, 0x7b, 0xdf, 0xf7, 0x3b, 0xdd, 0x17, 0x43, 0x3d, 0x10,
-	0xb2, 0x2f, 0x24, 0x0d, 0x52, 0x21, 0x65, 0xd0, 0xf3, 0x63, 0x4e, 0x87, 0x4d, 0x7a, 0x3c, 0x60,
-	0xe9, 0x89, 0x9b, 0xa4, 0x22, 0x13, 0x58, 0x29, 0x14, 0xee, 0x6f, 0x85, 0x3b, 0x6c, 0x5a, 0x95,
-	0x48, 0x44, 0x42, 0x09, 0x68, 0xfe, 0xad, 0xd0, 0x5a, 0xb5, 0x48, 0x88, 0xe8, 0x88, 0x51, 0x3f,
-	0x89, 0xa9, 0xcf, 0xb9, 0xc8, 0xfc, 0x2c, 0x16, 0x5c, 0xea, 0x5f, 0xd7, 0x8d, 0x59, 0x53, 0xbe,
-	0x4a, 0xe6, 0x54, 0x00, 0xf7, 0xf2, 0xfc, 0x5d, 0x3f, 0xf5, 0xfb, 0xd2, 0x63, 0xc7, 0x03, 0x26,
-	0x33, 0x67, 0x0f, 0xee, 0xfd, 0x31, 0x95, 0x89, 0xe0, 0x92, 0x61, 0x1b, 0xca, 0x89, 0x9a, 0x54,
-	0x49, 0x9d, 0x34, 0x96, 0x5b, 0x35, 0xd7, 0x84, 0xeb, 0x16, 0x5b, 0xdb, 0x8b, 0xa7, 0xdf, 0x1f,
-	0x96, 0x3c, 0xbd, 0xe1, 0xbc, 0x81, 0x35, 0x65, 0xb9, 0x93, 0x4b, 0x77, 0x72, 0xe9, 0xae, 0x1f,
-	0x1c, 0xfa, 0x11, 0xd3, 0x99, 0xe8, 0xc0, 0x6a, 0xc8, 0x64, 0xd6, 0x55, 0x36, 0xdd, 0x38, 0x54,
-	0x19, 0xab, 0xde, 0x72, 0x3e, 0x54, 0xfa, 0x4e, 0x88, 0x6b, 0x00, 0x41, 0xcf, 0xe7, 0x9c, 0x1d,
-	0xe5, 0x82, 0x05, 0x25, 0xb8, 0xad, 0x27, 0x9d, 0x10, 0x2d, 0xb8, 0x25, 0x73, 0x37, 0x1e, 0xb0,
-	0xea, 0x8d, 0x3a, 0x69, 0x2c, 0x7a, 0x93, 0x67, 0xa7, 0x0d, 0xf6, 0xac, 0x7c, 0x7d, 0xba, 0x2a,
-	0xdc, 0x4c, 0x8a, 0x91, 0x8a, 0x5e, 0xf1, 0xce, 0x1f, 0x9d, 0x97, 0x50, 0x55, 0xbb, 0xfb, 0x8c,
-	0x87, 0xfb, 0xda, 0xf0, 0xdf, 0x61, 0x3b, 0xcf, 0xe1, 0xbe, 0xc1, 0x5e, 0x53, 0x4d, 0x9f, 0x89,
-	0x5c, 0x38, 0xd3, 0x2b, 0x78, 0xa0, 0x16, 0x3d, 0x16, 0xb0, 0x78, 0xc8, 0xfe, 0x03, 0x5a, 0x1b,
-	0x6a, 0xe6, 0x84, 0xbf, 0xd3, 0xb5, 0xde, 0x2f, 0xc1, 0x92, 0x5a, 0xc6, 0x77, 0x04, 0xca, 0x45,
-	0x29, 0xb0, 0x61, 0xae, 0xcc, 0xe5, 0x0e, 0x5a, 0x1b, 0x57, 0x50, 0x16, 0x14, 0xce, 0xe3, 0xb7,
-	0x5f, 0x7f, 0x7e, 0x5c, 0xb0, 0xb1, 0x46, 0x8d, 0xa5, 0x2f, 0x1a, 0x88, 0x5f, 0x08, 0xdc, 0xbd,
-	0x74, 0xfb, 0xb8, 0x35, 0x27, 0x66, 0x56, 0x57, 0xad, 0x67, 0xd7, 0x5b, 0xd2, 0x98, 0x4d, 0x85,
-	0xb9, 0x89, 0x1b, 0x74, 0xf6, 0x7f, 0x53, 0x5f, 0x96, 0x6e, 0x1e, 0x7e, 0x22, 0xb0, 0x32, 0x5d,
-	0x0b, 0x74, 0xe7, 0x24, 0x1b, 0xea, 0x69, 0xd1, 0x2b, 0xeb, 0x35, 0xe4, 0xa6, 0x82, 0x5c, 0xc7,
-	0x47, 0x66, 0x48, 0xc9, 0x78, 0xd8, 0x3d, 0xbf, 0x62, 0xfc, 0x4c, 0xe0, 0xce, 0x85, 0x6a, 0x60,
-	0x73, 0x4e, 0xa2, 0xb9, 0xa8, 0x56, 0xeb, 0x3a, 0x2b, 0x9a, 0xd3, 0x55, 0x9c, 0x0d, 0x7c, 0x62,
-	0xe6, 0x4c, 0x8b, 0xb5, 0x09, 0xea, 0x76, 0xe7, 0x74, 0x64, 0x93, 0xb3, 0x91, 0x4d, 0x7e, 0x8c,
-	0x6c, 0xf2, 0x61, 0x6c, 0x97, 0xce, 0xc6, 0x76, 0xe9, 0xdb, 0xd8, 0x2e, 0xbd, 0xa0, 0x51, 0x9c,
-	0xf5, 0x06, 0x07, 0x6e, 0x20, 0xfa, 0x13, 0x2f, 0xf5, 0xf1, 0x54, 0x86, 0x87, 0xf4, 0xf5, 0xb4,
-	0x71, 0x76, 0x92, 0x30, 0x79, 0x50, 0x56, 0xaf, 0xce, 0xad, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xcd, 0x14, 0x46, 0x66, 0xcf, 0x05, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0xfc, 0xf8, 0x55, 0x78, 0x80, 0x10, 0x07, 0xa2, 0x65, 0x2d, 0x6b, 0xb3, 0x80,
+	0x29, 0x21, 0xec, 0xda, 0x62, 0xa2, 0x72, 0x32, 0xc5, 0x83, 0xdc, 0x60, 0xf1, 0x64, 0x62, 0xea,
+	0xb2, 0x3b, 0x59, 0x36, 0xd0, 0x9d, 0xa5, 0xb3, 0x6d, 0xe8, 0x55, 0xdf, 0x80, 0x89, 0x89, 0x27,
+	0x5f, 0x80, 0x57, 0x13, 0x5f, 0x04, 0xf1, 0x44, 0xf4, 0xc2, 0xc9, 0x98, 0xd6, 0x17, 0x62, 0x76,
+	0x66, 0xfa, 0x0f, 0xa6, 0x2b, 0x18, 0x3d, 0xb5, 0xcf, 0xd3, 0xef, 0xf3, 0x3c, 0x9f, 0xef, 0xce,
+	0x3c, 0x5b, 0x28, 0xb8, 0x94, 0xd5, 0x28, 0xb3, 0xdc, 0x3a, 0x65, 0xcc, 0x3d, 0x70, 0x82, 0xd0,
+	0x6a, 0x96, 0xac, 0xe3, 0x06, 0xa9, 0xb7, 0xcc, 0xa8, 0x4e, 0x63, 0x8a, 0xe7, 0x85, 0xc2, 0xec,
+	0x2b, 0xcc, 0x66, 0x49, 0x5b, 0x10, 0xd9, 0x2a, 0xd7, 0x58, 0x52, 0xc2, 0x03, 0x6d, 0xde, 0xa7,
+	0x3e, 0x15, 0xf9, 0xe4, 0x9b, 0xcc, 0xe6, 0x7d, 0x4a, 0xfd, 0x23, 0x62, 0x39, 0x51, 0x60, 0x39,
+	0x61, 0x48, 0x63, 0x27, 0x0e, 0x68, 0xd8, 0xad, 0x59, 0x51, 0x62, 0x0c, 0x8c, 0xe4, 0x32, 0x63,
+	0x1e, 0xf0, 0x6e, 0x82, 0xb6, 0xe3, 0xd4, 0x9d, 0x1a, 0xb3, 0xc9, 0x71, 0x83, 0xb0, 0xd8, 0xd8,
+	0x85, 0xb9, 0xa1, 0x2c, 0x8b, 0x68, 0xc8, 0x08, 0xde, 0x84, 0x6c, 0xc4, 0x33, 0x39, 0x54, 0x40,
+	0xc5, 0xa9, 0x72, 0xde, 0x54, 0x39, 0x31, 0x45, 0x55, 0x65, 0xfc, 0xf4, 0xfb, 0xdd, 0x8c, 0x2d,
+	0x2b, 0x8c, 0xf7, 0x08, 0x16, 0x79, 0xcf, 0xad, 0x44, 0xbb, 0x95, 0x68, 0x77, 0x1c, 0xf7, 0xd0,
+	0xf1, 0x89, 0x1c, 0x8a, 0x0d, 0x98, 0xf1, 0x08, 0x8b, 0xab, 0xbc, 0x4f, 0x35, 0xf0, 0xf8, 0x90,
+	0x19, 0x7b, 0x2a, 0x49, 0x72, 0xfd, 0xb6, 0x87, 0x17, 0x01, 0xdc, 0x03, 0x27, 0x0c, 0xc9, 0x51,
+	0x22, 0x18, 0xe3, 0x82, 0x49, 0x99, 0xd9, 0xf6, 0xb0, 0x06, 0x13, 0x2c, 0xe9, 0x16, 0xba, 0x24,
+	0xf7, 0x5f, 0x01, 0x15, 0xc7, 0xed, 0x5e, 0x8c, 0x6f, 0x41, 0xd6, 0x23, 0x2e, 0xf5, 0x48, 0x6e,
+	0xbc, 0x80, 0x8a, 0x13, 0xb6, 0x8c, 0x8c, 0xe7, 0xa0, 0x8f, 0xe2, 0x92, 0xb6, 0x73, 0x70, 0x23,
+	0x12, 0x29, 0x8e, 0x34, 0x6d, 0x77, 0xc3, 0xe4, 0x17, 0xd1, 0x45, 0xb0, 0x4c, 0xda, 0xdd, 0xd0,
+	0x60, 0xb0, 0xc4, 0xbb, 0x3e, 0xe5, 0xf1, 0x48, 0xcf, 0xc3, 0x7e, 0xd0, 0x45, 0x3f, 0xeb, 0xfd,
+	0xc9, 0x49, 0xff, 0xe9, 0xca, 0xdc, 0xd7, 0xcf, 0xeb, 0xb3, 0xf2, 0xa1, 0x3f, 0x23, 0x27, 0x95,
+	0x56, 0x4c, 0x58, 0x0f, 0xc7, 0x78, 0x02, 0xcb, 0xe9, 0x43, 0xfb, 0x86, 0xba, 0xd8, 0x68, 0x18,
+	0xfb, 0x25, 0xe4, 0x78, 0x87, 0x3d, 0x12, 0x7a, 0x7b, 0xf2, 0xc9, 0xfd, 0xbd, 0xf3, 0x31, 0x1e,
+	0xc2, 0x82, 0xa2, 0xbd, 0xa4, 0x1a, 0x3c, 0x3c, 0x34, 0x7c, 0x78, 0xc6, 0x2b, 0xb8, 0xc3, 0x0b,
+	0x6d, 0xe2, 0x92, 0xa0, 0x49, 0xfe, 0x01, 0xda, 0x26, 0xe4, 0xd5, 0x13, 0x7e, 0x4f, 0x57, 0x3e,
+	0xcf, 0xc2, 0xff, 0xbc, 0x18, 0xbf, 0x41, 0x90, 0x15, 0xd7, 0x1f, 0x17, 0xd5, 0xcb, 0x71, 0x79,
+	0xdb, 0xb4, 0xd5, 0x2b, 0x28, 0x05, 0x85, 0xb1, 0xfc, 0xfa, 0xdb, 0xcf, 0x77, 0x63, 0x3a, 0xce,
+	0x5b, 0xca, 0xf5, 0x16, 0xbb, 0x86, 0x3f, 0x21, 0xb8, 0x79, 0xe9, 0xf4, 0xf1, 0x46, 0xca, 0x98,
+	0x51, 0x17, 0x54, 0x7b, 0x70, 0xbd, 0x22, 0x89, 0x59, 0xe2, 0x98, 0x6b, 0x78, 0xd5, 0x1a, 0xfd,
+	0x16, 0x92, 0x87, 0xd5, 0x5d, 0xa5, 0x2f, 0x08, 0x6e, 0x8f, 0xb8, 0xb7, 0xf8, 0x71, 0x0a, 0x44,
+	0xfa, 0x82, 0x69, 0x9b, 0x7f, 0x52, 0x2a, 0x5d, 0x3c, 0xe2, 0x2e, 0xca, 0xf8, 0xbe, 0xda, 0x85,
+	0xd8, 0x99, 0xaa, 0xca, 0xcc, 0x07, 0x04, 0xd3, 0x83, 0x77, 0x1c, 0x9b, 0x29, 0x18, 0x8a, 0x5d,
+	0xd3, 0xac, 0x2b, 0xeb, 0x25, 0xeb, 0x1a, 0x67, 0x5d, 0xc1, 0x4b, 0x6a, 0x56, 0x46, 0x42, 0xaf,
+	0xda, 0x7b, 0x15, 0x7e, 0x44, 0x30, 0x7b, 0xe1, 0x9e, 0xe3, 0x52, 0xca, 0x44, 0xf5, 0xd6, 0x69,
+	0xe5, 0xeb, 0x94, 0x48, 0x4e, 0x93, 0x73, 0x16, 0xf1, 0x3d, 0x35, 0x67, 0x5d, 0x94, 0xf5, 0x50,
+	0x2b, 0xdb, 0xa7, 0x6d, 0x1d, 0x9d, 0xb5, 0x75, 0xf4, 0xa3, 0xad, 0xa3, 0xb7, 0x1d, 0x3d, 0x73,
+	0xd6, 0xd1, 0x33, 0xe7, 0x1d, 0x3d, 0xf3, 0xc2, 0xf2, 0x83, 0xf8, 0xa0, 0xb1, 0x6f, 0xba, 0xb4,
+	0xd6, 0xeb, 0xc5, 0x3f, 0xd6, 0x99, 0x77, 0x68, 0x9d, 0x0c, 0x36, 0x8e, 0x5b, 0x11, 0x61, 0xfb,
+	0x59, 0xfe, 0x8f, 0xb7, 0xf1, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x06, 0x5d, 0xee, 0x6c, 0xa1, 0x07,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -491,6 +621,8 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// CrossChainPackage returns the specified cross chain package
 	CrossChainPackage(ctx context.Context, in *QueryCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryCrossChainPackageResponse, error)
+	// DecodeCrossChainPackage decodes a cross chain package of a channel
+	DecodeCrossChainPackage(ctx context.Context, in *QueryDecodeCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryDecodeCrossChainPackageResponse, error)
 	// SendSequence returns the send sequence of the channel
 	SendSequence(ctx context.Context, in *QuerySendSequenceRequest, opts ...grpc.CallOption) (*QuerySendSequenceResponse, error)
 	// ReceiveSequence returns the receive sequence of the channel
@@ -523,6 +655,15 @@ func (c *queryClient) CrossChainPackage(ctx context.Context, in *QueryCrossChain
 	return out, nil
 }
 
+func (c *queryClient) DecodeCrossChainPackage(ctx context.Context, in *QueryDecodeCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryDecodeCrossChainPackageResponse, error) {
+	out := new(QueryDecodeCrossChainPackageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/DecodeCrossChainPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SendSequence(ctx context.Context, in *QuerySendSequenceRequest, opts ...grpc.CallOption) (*QuerySendSequenceResponse, error) {
 	out := new(QuerySendSequenceResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/SendSequence", in, out, opts...)
@@ -547,6 +688,8 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// CrossChainPackage returns the specified cross chain package
 	CrossChainPackage(context.Context, *QueryCrossChainPackageRequest) (*QueryCrossChainPackageResponse, error)
+	// DecodeCrossChainPackage decodes a cross chain package of a channel
+	DecodeCrossChainPackage(context.Context, *QueryDecodeCrossChainPackageRequest) (*QueryDecodeCrossChainPackageResponse, error)
 	// SendSequence returns the send sequence of the channel
 	SendSequence(context.Context, *QuerySendSequenceRequest) (*QuerySendSequenceResponse, error)
 	// ReceiveSequence returns the receive sequence of the channel
@@ -563,6 +706,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) CrossChainPackage(ctx context.Context, req *QueryCrossChainPackageRequest) (*QueryCrossChainPackageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CrossChainPackage not implemented")
 }
+func (*UnimplementedQueryServer) DecodeCrossChainPackage(ctx context.Context, req *QueryDecodeCrossChainPackageRequest) (*QueryDecodeCrossChainPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeCrossChainPackage not implemented")
+}
 func (*UnimplementedQueryServer) SendSequence(ctx context.Context, req *QuerySendSequenceRequest) (*QuerySendSequenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendSequence not implemented")
 }
@@ -610,6 +756,24 @@ func _Query_CrossChainPackage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DecodeCrossChainPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecodeCrossChainPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecodeCrossChainPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crosschain.v1.Query/DecodeCrossChainPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecodeCrossChainPackage(ctx, req.(*QueryDecodeCrossChainPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SendSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySendSequenceRequest)
 	if err := dec(in); err != nil {
@@ -658,6 +822,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CrossChainPackage",
 			Handler:    _Query_CrossChainPackage_Handler,
 		},
+		{
+			MethodName: "DecodeCrossChainPackage",
+			Handler:    _Query_DecodeCrossChainPackage_Handler,
+		},
 		{
 			MethodName: "SendSequence",
 			Handler:    _Query_SendSequence_Handler,
@@ -747,6 +915,16 @@ func (m *QueryCrossChainPackageRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Decode {
+		i--
+		if m.Decode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
 		i--
@@ -781,6 +959,43 @@ func (m *QueryCrossChainPackageResponse) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *QueryCrossChainPackageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decoded) > 0 {
+		i -= len(m.Decoded)
+		copy(dAtA[i:], m.Decoded)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Decoded)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Package) > 0 {
+		i -= len(m.Package)
+		copy(dAtA[i:], m.Package)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Package)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecodeCrossChainPackageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodeCrossChainPackageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodeCrossChainPackageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -790,6 +1005,41 @@ func (m *QueryCrossChainPackageResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		copy(dAtA[i:], m.Package)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.Package)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecodeCrossChainPackageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecodeCrossChainPackageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecodeCrossChainPackageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decoded) > 0 {
+		i -= len(m.Decoded)
+		copy(dAtA[i:], m.Decoded)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Decoded)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -963,6 +1213,9 @@ func (m *QueryCrossChainPackageRequest) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovQuery(uint64(m.Sequence))
 	}
+	if m.Decode {
+		n += 2
+	}
 	return n
 }
 
@@ -976,6 +1229,39 @@ func (m *QueryCrossChainPackageResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Decoded)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecodeCrossChainPackageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		n += 1 + sovQuery(uint64(m.ChannelId))
+	}
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDecodeCrossChainPackageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Decoded)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1258,6 +1544,26 @@ func (m *QueryCrossChainPackageRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decode = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1342,6 +1648,223 @@ func (m *QueryCrossChainPackageResponse) Unmarshal(dAtA []byte) error {
 				m.Package = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decoded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecodeCrossChainPackageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecodeCrossChainPackageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecodeCrossChainPackageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Package = append(m.Package[:0], dAtA[iNdEx:postIndex]...)
+			if m.Package == nil {
+				m.Package = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecodeCrossChainPackageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecodeCrossChainPackageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecodeCrossChainPackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decoded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
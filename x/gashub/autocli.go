@@ -0,0 +1,39 @@
+package gashub
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	gashubv1beta1 "cosmossdk.io/api/cosmos/gashub/v1beta1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: gashubv1beta1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current gashub parameters",
+				},
+				{
+					RpcMethod: "MsgGasParams",
+					Use:       "msg-gas-params [msg-type-url...]",
+					Short:     "Query the gas params of msg types, all of them if none is given",
+					Example:   "msg-gas-params /cosmos.bank.v1beta1.MsgSend /cosmos.oracle.v1.MsgClaim",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_type_urls", Varargs: true},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: gashubv1beta1.Msg_ServiceDesc.ServiceName,
+			// the msgs are all submitted by governance proposals
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{RpcMethod: "UpdateParams", Skip: true},
+				{RpcMethod: "SetMsgGasParams", Skip: true},
+			},
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package gashub_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/client/v2/autocli"
+	autoclitestutil "cosmossdk.io/client/v2/autocli/testutil"
+
+	"github.com/cosmos/cosmos-sdk/x/gashub"
+	"github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+func TestAutoCLIOptions(t *testing.T) {
+	autoclitestutil.AssertGolden(t, &autocli.Builder{}, types.ModuleName, gashub.AppModule{}.AutoCLIOptions())
+}
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gashub/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gashub/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -131,6 +132,31 @@ func (suite *KeeperTestSuite) TestSetMsgGasParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgGas() {
+	ctx, gashubKeeper := suite.ctx, suite.gashubKeeper
+	require := suite.Require()
+
+	send := &banktypes.MsgSend{}
+	multiSend := &banktypes.MsgMultiSend{Inputs: make([]banktypes.Input, 1), Outputs: make([]banktypes.Output, 3)}
+	gashubKeeper.SetMsgGasParams(ctx, *types.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(send), 1200))
+	gashubKeeper.SetMsgGasParams(ctx, *types.NewMsgGasParamsWithDynamicGas(
+		sdk.MsgTypeURL(multiSend),
+		&types.MsgGasParams_MultiSendType{MultiSendType: &types.MsgGasParams_DynamicGasParams{FixedGas: 800, GasPerItem: 100}},
+	))
+
+	gas, err := gashubKeeper.MsgGas(ctx, send)
+	require.NoError(err)
+	require.Equal(uint64(1200), gas)
+
+	gas, err = gashubKeeper.MsgGas(ctx, multiSend)
+	require.NoError(err)
+	require.Equal(uint64(800+3*100), gas)
+
+	// the msgs of a type without params can't be charged
+	_, err = gashubKeeper.MsgGas(ctx, &banktypes.MsgSetSendEnabled{})
+	require.Error(err)
+}
+
 func (suite *KeeperTestSuite) TestSetAllMsgGasParams() {
 	ctx, gashubKeeper := suite.ctx, suite.gashubKeeper
 	require := suite.Require()
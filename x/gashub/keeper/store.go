@@ -16,6 +16,12 @@ func (k Keeper) GetMsgGasParams(ctx sdk.Context, msgTypeUrl string) types.MsgGas
 	return mgp
 }
 
+// MsgGas returns the gas charged for a msg by the MsgGasParams of its type, which excludes the gas charged by
+// tx size.
+func (k Keeper) MsgGas(ctx sdk.Context, msg sdk.Msg) (uint64, error) {
+	return types.CalculateMsgGas(k.GetMsgGasParams(ctx, sdk.MsgTypeURL(msg)), msg)
+}
+
 // SetMsgGasParams set the provided MsgGasParams in the gashub store
 func (k Keeper) SetMsgGasParams(ctx sdk.Context, mgp types.MsgGasParams) {
 	store := ctx.KVStore(k.storeKey)
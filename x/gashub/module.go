@@ -7,14 +7,16 @@ import (
 
 	modulev1 "cosmossdk.io/api/cosmos/gashub/module/v1"
 	abci "github.com/cometbft/cometbft/abci/types"
-	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -66,7 +68,7 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the gashub module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
 	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
@@ -187,8 +189,9 @@ type GashubInputs struct {
 type GashubOutputs struct {
 	depinject.Out
 
-	GashubKeeper keeper.Keeper
-	Module       appmodule.AppModule
+	GashubKeeper  keeper.Keeper
+	Module        appmodule.AppModule
+	BaseAppOption runtime.BaseAppOption
 }
 
 func ProvideModule(in GashubInputs) GashubOutputs {
@@ -205,6 +208,9 @@ func ProvideModule(in GashubInputs) GashubOutputs {
 	)
 
 	m := NewAppModule(k)
+	baseappOpt := func(app *baseapp.BaseApp) {
+		app.SetMsgGasFunc(k.MsgGas)
+	}
 
-	return GashubOutputs{GashubKeeper: k, Module: m}
+	return GashubOutputs{GashubKeeper: k, Module: m, BaseAppOption: baseappOpt}
 }
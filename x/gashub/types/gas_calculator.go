@@ -61,6 +61,15 @@ func GetGasCalculatorGen(mgp MsgGasParams) (GasCalculatorGenerator, error) {
 	}
 }
 
+// CalculateMsgGas returns the gas charged for a msg by the MsgGasParams of its type.
+func CalculateMsgGas(mgp MsgGasParams, msg types.Msg) (uint64, error) {
+	feeCalcGen, err := GetGasCalculatorGen(mgp)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "unrecognized msg type: %s", types.MsgTypeURL(msg))
+	}
+	return feeCalcGen(mgp)(msg)
+}
+
 func FixedGasCalculator(amount uint64) GasCalculator {
 	return func(msg types.Msg) (uint64, error) {
 		return amount, nil
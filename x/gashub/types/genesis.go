@@ -78,6 +78,7 @@ func DefaultGenesisState() *GenesisState {
 		*NewMsgGasParamsWithFixedGas("/cosmos.staking.v1beta1.MsgDelegate", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/cosmos.staking.v1beta1.MsgEditValidator", 2e6),
 		*NewMsgGasParamsWithFixedGas("/cosmos.staking.v1beta1.MsgUndelegate", 1.2e3),
+		*NewMsgGasParamsWithFixedGas("/cosmos.upgrade.v1beta1.MsgSignalReadiness", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/greenfield.bridge.MsgTransferOut", 1.2e3),
 		*NewMsgGasParamsWithFixedGas("/greenfield.sp.MsgCreateStorageProvider", 2e6),
 		*NewMsgGasParamsWithFixedGas("/greenfield.sp.MsgDeposit", 1.2e3),
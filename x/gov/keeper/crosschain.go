@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"math/big"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -22,6 +23,37 @@ type SyncParamsApp struct {
 	keeper Keeper
 }
 
+var _ sdk.CrossChainPayloadDecoder = SyncParamsApp{}
+
+// syncParamsPayload is the human-readable form of a SyncParamsPackage.
+type syncParamsPayload struct {
+	Key     string   `json:"key"`
+	Value   string   `json:"value"`
+	Targets []string `json:"targets"`
+}
+
+// DecodePayload decodes the sync params packages sent to the destination chains, and the ones of the fail ack
+// packages. The payloads of the ack packages are not decoded.
+func (app SyncParamsApp) DecodePayload(packageType sdk.CrossChainPackageType, payload []byte) (json.RawMessage, error) {
+	if packageType == sdk.AckCrossChainPackageType {
+		return nil, nil
+	}
+
+	pack, err := types.DeserializeSyncParamsPackage(payload)
+	if err != nil {
+		return nil, err
+	}
+	if len(pack.Target)%sdk.EthAddressLength != 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSyncParamPackage, "invalid targets length %d", len(pack.Target))
+	}
+
+	decoded := syncParamsPayload{Key: pack.Key, Value: "0x" + hex.EncodeToString(pack.Value), Targets: []string{}}
+	for i := 0; i < len(pack.Target); i += sdk.EthAddressLength {
+		decoded.Targets = append(decoded.Targets, sdk.AccAddress(pack.Target[i:i+sdk.EthAddressLength]).String())
+	}
+	return json.Marshal(decoded)
+}
+
 func (app SyncParamsApp) ExecuteSynPackage(ctx sdk.Context, _ *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
 	app.keeper.Logger(ctx).Error("received sync params sync package", "payload", hex.EncodeToString(payload))
 	return sdk.ExecuteResult{}
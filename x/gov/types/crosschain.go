@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 )
@@ -42,3 +44,19 @@ func (p SyncParamsPackage) Serialize() ([]byte, error) {
 	}
 	return encodedBytes, nil
 }
+
+// DeserializeSyncParamsPackage decodes a SyncParamsPackage encoded by Serialize
+func DeserializeSyncParamsPackage(bz []byte) (SyncParamsPackage, error) {
+	unpacked, err := syncParamsPackageArgs.Unpack(bz)
+	if err != nil {
+		return SyncParamsPackage{}, err
+	}
+	if len(unpacked) != 1 {
+		return SyncParamsPackage{}, fmt.Errorf("expected one sync params package, got %d values", len(unpacked))
+	}
+	pack, ok := abi.ConvertType(unpacked[0], SyncParamsPackage{}).(SyncParamsPackage)
+	if !ok {
+		return SyncParamsPackage{}, fmt.Errorf("invalid sync params package")
+	}
+	return pack, nil
+}
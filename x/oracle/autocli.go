@@ -0,0 +1,58 @@
+package oracle
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	oraclev1 "cosmossdk.io/api/cosmos/oracle/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: oraclev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current oracle parameters",
+				},
+				{
+					RpcMethod: "InturnRelayer",
+					Use:       "inturn-relayer --claim-src-chain [chain]",
+					Short:     "Query the BLS public key of the in-turn relayer of a source chain and its relay interval",
+					Example:   "inturn-relayer --claim-src-chain bsc",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"claim_src_chain": {Usage: "source chain of the claims"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: oraclev1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Claim",
+					Use:       "claim [from_key_or_address] --src-chain-id [id] --dest-chain-id [id] --sequence [sequence] --payload [hex] --vote-address-set [words] --agg-signature [hex]",
+					Short:     "Claim the cross-chain packages of a source chain, signed by the relayers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "from_address"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"src_chain_id":     {Usage: "chain ID of the source chain of the packages"},
+						"dest_chain_id":    {Usage: "chain ID of the destination chain of the packages"},
+						"sequence":         {Usage: "sequence of the claim in the oracle channel"},
+						"timestamp":        {Usage: "unix timestamp of the claim"},
+						"payload":          {Usage: "hex encoded packages of the claim"},
+						"vote_address_set": {Usage: "bit set of the validators whose relayers signed the claim, as uint64 words"},
+						"agg_signature":    {Usage: "hex encoded 96 bytes BLS aggregated signature of the relayers"},
+					},
+				},
+				{
+					// the params are updated by governance proposals
+					RpcMethod: "UpdateParams",
+					Skip:      true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package oracle_test
+
+import (
+	"bytes"
+	"testing"
+
+	oraclev1 "cosmossdk.io/api/cosmos/oracle/v1"
+	"cosmossdk.io/client/v2/autocli"
+	autoclitestutil "cosmossdk.io/client/v2/autocli/testutil"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/x/oracle"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+func TestAutoCLIOptions(t *testing.T) {
+	autoclitestutil.AssertGolden(t, &autocli.Builder{}, types.ModuleName, oracle.AppModule{}.AutoCLIOptions())
+}
+
+func TestAutoCLIClaim(t *testing.T) {
+	var msgs []protoreflect.ProtoMessage
+	builder := &autocli.Builder{
+		HandleMsg: func(cmd *cobra.Command, msg protoreflect.ProtoMessage) error {
+			msgs = append(msgs, msg)
+			return nil
+		},
+	}
+	newCmd := func() *cobra.Command {
+		cmd, err := builder.BuildModuleMsgCommand(types.ModuleName, oracle.AppModule{}.AutoCLIOptions().Tx)
+		require.NoError(t, err)
+		return cmd
+	}
+
+	aggSignature := bytes.Repeat([]byte{0xab}, 96)
+	cmd := newCmd()
+	cmd.SetArgs([]string{
+		"claim", "relayer", "--src-chain-id", "56", "--dest-chain-id", "1000", "--sequence", "7", "--timestamp", "1700000000",
+		"--payload", "0x0102", "--vote-address-set", "3,1", "--agg-signature", "0x" + string(bytes.Repeat([]byte("ab"), 96)),
+	})
+	require.NoError(t, cmd.Execute())
+	require.Len(t, msgs, 1)
+	require.True(t, proto.Equal(&oraclev1.MsgClaim{
+		FromAddress:    "relayer",
+		SrcChainId:     56,
+		DestChainId:    1000,
+		Sequence:       7,
+		Timestamp:      1700000000,
+		Payload:        []byte{1, 2},
+		VoteAddressSet: []uint64{3, 1},
+		AggSignature:   aggSignature,
+	}, msgs[0]), "%v", msgs[0])
+
+	// the BLS signature must have 96 bytes
+	cmd = newCmd()
+	cmd.SetArgs([]string{"claim", "relayer", "--agg-signature", "abab"})
+	require.ErrorContains(t, cmd.Execute(), "expected 96 bytes, got 2")
+	cmd = newCmd()
+	cmd.SetArgs([]string{"claim", "relayer", "--payload", "0xzz"})
+	require.ErrorContains(t, cmd.Execute(), "invalid argument")
+}
@@ -4,8 +4,6 @@ import (
 	"bytes"
 	"encoding/hex"
 
-	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
-
 	sdkerrors "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/libs/log"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -133,7 +131,7 @@ func (k Keeper) CheckClaim(ctx sdk.Context, claim *types.MsgClaim) (sdk.AccAddre
 	validators := historicalInfo.Valset
 
 	claimSrcChain := types.CLAIM_SRC_CHAIN_BSC
-	if ctx.IsUpgraded(upgradetypes.Pampas) && sdk.ChainID(claim.SrcChainId) == k.CrossChainKeeper.GetDestOpChainID() {
+	if ctx.IsFeatureEnabled(types.FeatureOpBNBClaim) && sdk.ChainID(claim.SrcChainId) == k.CrossChainKeeper.GetDestOpChainID() {
 		claimSrcChain = types.CLAIM_SRC_CHAIN_OP_BNB
 	}
 
@@ -204,29 +202,10 @@ func (k Keeper) getInturnRelayer(ctx sdk.Context, relayerInterval uint64, claimS
 	}
 	validators := historicalInfo.Valset
 
-	validatorsSize := len(validators)
-
-	// totalIntervals is sum of intervals from all relayers
-	totalIntervals := relayerInterval * uint64(validatorsSize)
-
-	curTimeStamp := uint64(ctx.BlockTime().Unix())
-
-	// remainder is used to locate inturn relayer.
-	remainder := curTimeStamp % totalIntervals
-	inTurnRelayerIndex := remainder / relayerInterval
-
-	start := curTimeStamp - (remainder - inTurnRelayerIndex*relayerInterval)
-	end := start + relayerInterval
-
-	if claimSrcChain == types.CLAIM_SRC_CHAIN_OP_BNB {
-		inTurnRelayerIndex = (inTurnRelayerIndex + uint64(validatorsSize/2)) % uint64(validatorsSize)
-	}
+	inTurnRelayerIndex, interval := types.InturnRelayerIndex(len(validators), relayerInterval, uint64(ctx.BlockTime().Unix()), claimSrcChain)
 	inturnRelayer := validators[inTurnRelayerIndex]
 
-	return inturnRelayer.BlsKey, &types.RelayInterval{
-		Start: start,
-		End:   end,
-	}, nil
+	return inturnRelayer.BlsKey, interval, nil
 }
 
 func (k Keeper) GetInturnRelayer(ctx sdk.Context, relayerInterval uint64, claimSrcChain types.ClaimSrcChain) (*types.QueryInturnRelayerResponse, error) {
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RetentionConstraint pins the heights whose staking historical info is kept, relayers query the validator
+// sets at these heights to build the claims checked by CheckClaim. It is meant to be registered with
+// BaseApp.AddRetentionConstraint.
+func (k Keeper) RetentionConstraint(ctx sdk.Context) []pruningtypes.PinnedHeights {
+	entries := int64(k.StakingKeeper.HistoricalEntries(ctx))
+	if entries == 0 {
+		return nil
+	}
+
+	start := ctx.BlockHeight() - entries + 1
+	if start < 1 {
+		start = 1
+	}
+	return []pruningtypes.PinnedHeights{{
+		Start:  start,
+		End:    ctx.BlockHeight(),
+		Reason: "oracle: historical validator sets checking the cross-chain claims",
+	}}
+}
@@ -0,0 +1,22 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+)
+
+func (s *TestSuite) TestRetentionConstraint() {
+	reason := "oracle: historical validator sets checking the cross-chain claims"
+
+	// nothing is pinned without historical entries
+	s.stakingKeeper.EXPECT().HistoricalEntries(gomock.Any()).Return(uint32(0))
+	s.Require().Empty(s.oracleKeeper.RetentionConstraint(s.ctx.WithBlockHeight(100)))
+
+	s.stakingKeeper.EXPECT().HistoricalEntries(gomock.Any()).Return(uint32(10)).Times(2)
+	s.Require().Equal([]pruningtypes.PinnedHeights{{Start: 91, End: 100, Reason: reason}},
+		s.oracleKeeper.RetentionConstraint(s.ctx.WithBlockHeight(100)))
+	// the pinned heights start at the first block
+	s.Require().Equal([]pruningtypes.PinnedHeights{{Start: 1, End: 5, Reason: reason}},
+		s.oracleKeeper.RetentionConstraint(s.ctx.WithBlockHeight(5)))
+}
@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 
@@ -8,6 +9,10 @@ import (
 	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/prysmaticlabs/prysm/crypto/bls/blst"
 	blscmn "github.com/prysmaticlabs/prysm/crypto/bls/common"
+	"github.com/willf/bitset"
+
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type Vote struct {
@@ -106,3 +111,69 @@ func GenerateBlsSig(privKeys []bls.SecretKey, data []byte) []byte {
 	aggregatedSignature, _ := AggregatedSignature(votes)
 	return aggregatedSignature
 }
+
+// BlsKeySet is an in-memory set of the BLS keys of validators, voting for the claims relayed to the chain.
+type BlsKeySet struct {
+	keys []bls.SecretKey
+}
+
+// NewBlsKeySet returns a set of BLS keys.
+func NewBlsKeySet(keys ...bls.SecretKey) *BlsKeySet {
+	return &BlsKeySet{keys: keys}
+}
+
+// GenerateBlsKeySet returns a set of n random BLS keys.
+func GenerateBlsKeySet(n int) (*BlsKeySet, error) {
+	keys := make([]bls.SecretKey, n)
+	for i := range keys {
+		key, err := blst.RandKey()
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return NewBlsKeySet(keys...), nil
+}
+
+// Keys returns the BLS keys of the set.
+func (s *BlsKeySet) Keys() []bls.SecretKey {
+	return s.keys
+}
+
+// PubKeys returns the BLS public keys of the set, the ones to give to the validators.
+func (s *BlsKeySet) PubKeys() [][]byte {
+	pubKeys := make([][]byte, len(s.keys))
+	for i, key := range s.keys {
+		pubKeys[i] = key.PublicKey().Marshal()
+	}
+	return pubKeys
+}
+
+// Vote signs data with the keys of the set of the validators of a validator set. It returns the vote address
+// set of the validators which voted, by their index in the validator set, and their aggregated signature.
+func (s *BlsKeySet) Vote(validators []stakingtypes.Validator, data []byte) ([]uint64, []byte, error) {
+	voteAddressSet := bitset.New(types.ValidatorBitSetLength * 64)
+	var votes []*Vote
+	for i, validator := range validators {
+		for _, key := range s.keys {
+			if !bytes.Equal(key.PublicKey().Marshal(), validator.BlsKey) {
+				continue
+			}
+			var vote Vote
+			copy(vote.PubKey[:], validator.BlsKey)
+			copy(vote.Signature[:], key.Sign(data).Marshal())
+			votes = append(votes, &vote)
+			voteAddressSet.Set(uint(i))
+			break
+		}
+	}
+	if len(votes) == 0 {
+		return nil, nil, fmt.Errorf("no validator has a BLS key of the set")
+	}
+
+	aggSignature, err := AggregatedSignature(votes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return voteAddressSet.Bytes(), aggSignature, nil
+}
@@ -0,0 +1,168 @@
+package testutil
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// LoopbackApp handles on a LoopbackChain the payload of a syn package received on a channel. It returns the
+// payload of the ack package to send back, none if empty, or an error to send back a fail ack package.
+type LoopbackApp func(payload []byte) ([]byte, error)
+
+// LoopbackChain stands in for the chain on the other side of the cross chain communication, such as BSC. It
+// receives the packages sent by the chain under test, answers its syn packages with ack packages the way the cross
+// chain apps of BSC do, and relays its own packages back in claims voted for by the validators.
+type LoopbackChain struct {
+	// ChainID is the chain ID of the stand-in chain, the source chain of its claims.
+	ChainID sdk.ChainID
+	// DestChainID is the chain ID of the chain under test.
+	DestChainID sdk.ChainID
+
+	keys *BlsKeySet
+	apps map[sdk.ChannelID]LoopbackApp
+
+	claimSequence    uint64
+	sendSequences    map[sdk.ChannelID]uint64
+	receiveSequences map[sdk.ChannelID]uint64
+	received         map[sdk.ChannelID][]ReceivedPackage
+	pending          []pendingPackage
+}
+
+// ReceivedPackage is a package received by a LoopbackChain.
+type ReceivedPackage struct {
+	Sequence uint64
+	Header   sdk.PackageHeader
+	Payload  []byte
+}
+
+// pendingPackage is a package waiting to be claimed, whose header is completed with the timestamp of its claim.
+type pendingPackage struct {
+	channelID sdk.ChannelID
+	sequence  uint64
+	header    sdk.PackageHeader
+	payload   []byte
+}
+
+// NewLoopbackChain returns a stand-in chain relaying packages to a chain with the votes of a BLS key set.
+func NewLoopbackChain(chainID, destChainID sdk.ChainID, keys *BlsKeySet) *LoopbackChain {
+	return &LoopbackChain{
+		ChainID:          chainID,
+		DestChainID:      destChainID,
+		keys:             keys,
+		apps:             make(map[sdk.ChannelID]LoopbackApp),
+		sendSequences:    make(map[sdk.ChannelID]uint64),
+		receiveSequences: make(map[sdk.ChannelID]uint64),
+		received:         make(map[sdk.ChannelID][]ReceivedPackage),
+	}
+}
+
+// RegisterApp registers the app handling the syn packages of a channel. The syn packages of the channels without
+// an app are answered with fail ack packages.
+func (c *LoopbackChain) RegisterApp(channelID sdk.ChannelID, app LoopbackApp) {
+	c.apps[channelID] = app
+}
+
+// Send queues a syn package sent on a channel, to be claimed by the next claim.
+func (c *LoopbackChain) Send(channelID sdk.ChannelID, payload []byte, relayerFee, ackRelayerFee *big.Int) {
+	c.queue(channelID, sdk.PackageHeader{
+		PackageType:   sdk.SynCrossChainPackageType,
+		RelayerFee:    relayerFee,
+		AckRelayerFee: ackRelayerFee,
+	}, payload)
+}
+
+// Receive handles a package sent by the chain under test on a channel, with its header, in the order of the
+// sequences of the channel. The syn packages are answered by the app of the channel, the ack packages being paid
+// with the ack relayer fee of the syn packages.
+func (c *LoopbackChain) Receive(channelID sdk.ChannelID, sequence uint64, pack []byte) error {
+	if expected := c.receiveSequences[channelID]; sequence != expected {
+		return fmt.Errorf("expected the package with sequence %d in channel %d, got %d", expected, channelID, sequence)
+	}
+	header, err := sdk.DecodePackageHeader(pack)
+	if err != nil {
+		return err
+	}
+	payload := pack[sdk.GetPackageHeaderLength(header.PackageType):]
+	c.received[channelID] = append(c.received[channelID], ReceivedPackage{Sequence: sequence, Header: header, Payload: payload})
+	c.receiveSequences[channelID]++
+	if header.PackageType != sdk.SynCrossChainPackageType {
+		return nil
+	}
+
+	ackHeader := sdk.PackageHeader{
+		PackageType:   sdk.AckCrossChainPackageType,
+		RelayerFee:    header.AckRelayerFee,
+		AckRelayerFee: sdk.NilAckRelayerFee,
+	}
+
+	app, ok := c.apps[channelID]
+	if !ok {
+		ackHeader.PackageType = sdk.FailAckCrossChainPackageType
+		c.queue(channelID, ackHeader, payload)
+		return nil
+	}
+	ackPayload, err := app(payload)
+	if err != nil {
+		ackHeader.PackageType = sdk.FailAckCrossChainPackageType
+		c.queue(channelID, ackHeader, payload)
+		return nil
+	}
+	if len(ackPayload) != 0 {
+		c.queue(channelID, ackHeader, ackPayload)
+	}
+	return nil
+}
+
+// ReceiveSequence returns the sequence of the next package expected from the chain under test on a channel.
+func (c *LoopbackChain) ReceiveSequence(channelID sdk.ChannelID) uint64 {
+	return c.receiveSequences[channelID]
+}
+
+// ReceivedPackages returns the packages received on a channel, in the order of their sequences.
+func (c *LoopbackChain) ReceivedPackages(channelID sdk.ChannelID) []ReceivedPackage {
+	return c.received[channelID]
+}
+
+// PendingPackages returns the number of packages waiting to be claimed.
+func (c *LoopbackChain) PendingPackages() int {
+	return len(c.pending)
+}
+
+// Claim returns the claim of the pending packages at a timestamp, relayed by a relayer and voted for by the
+// validators of a validator set having a BLS key of the chain. The packages are then no longer pending.
+func (c *LoopbackChain) Claim(relayer sdk.AccAddress, validators []stakingtypes.Validator, timestamp uint64) (*types.MsgClaim, error) {
+	claim := Claim{
+		SrcChainID:  c.ChainID,
+		DestChainID: c.DestChainID,
+		Sequence:    c.claimSequence,
+		Timestamp:   timestamp,
+		Packages:    make(types.Packages, 0, len(c.pending)),
+	}
+	for _, pending := range c.pending {
+		header := pending.header
+		header.Timestamp = timestamp
+		claim.Packages = append(claim.Packages, NewPackage(pending.channelID, pending.sequence, header, pending.payload))
+	}
+
+	msg, err := BuildClaim(relayer, claim, validators, c.keys)
+	if err != nil {
+		return nil, err
+	}
+	c.claimSequence++
+	c.pending = nil
+	return msg, nil
+}
+
+func (c *LoopbackChain) queue(channelID sdk.ChannelID, header sdk.PackageHeader, payload []byte) {
+	c.pending = append(c.pending, pendingPackage{
+		channelID: channelID,
+		sequence:  c.sendSequences[channelID],
+		header:    header,
+		payload:   payload,
+	})
+	c.sendSequences[channelID]++
+}
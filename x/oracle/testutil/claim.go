@@ -0,0 +1,63 @@
+package testutil
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// NewPackage returns a package of a channel, its payload prefixed by the package header.
+func NewPackage(channelID sdk.ChannelID, sequence uint64, header sdk.PackageHeader, payload []byte) types.Package {
+	return types.Package{
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Payload:   append(sdk.EncodePackageHeader(header), payload...),
+	}
+}
+
+// EncodePackages encodes packages into the payload of a claim.
+func EncodePackages(packages types.Packages) ([]byte, error) {
+	return rlp.EncodeToBytes(packages)
+}
+
+// Claim describes the claim of packages relayed from a source chain.
+type Claim struct {
+	SrcChainID  sdk.ChainID
+	DestChainID sdk.ChainID
+	// Sequence is the sequence of the claim, the receive sequence of the relay packages channel.
+	Sequence uint64
+	// Timestamp is the timestamp of the claim, which must be the one of the headers of the packages.
+	Timestamp uint64
+	Packages  types.Packages
+}
+
+// BuildClaim returns the MsgClaim of a claim relayed by a relayer, voted for by the validators of a validator set
+// having a BLS key of a key set. The validator set is the one of the block processing the claim.
+func BuildClaim(relayer sdk.AccAddress, claim Claim, validators []stakingtypes.Validator, keys *BlsKeySet) (*types.MsgClaim, error) {
+	payload, err := EncodePackages(claim.Packages)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgClaim(relayer.String(), uint32(claim.SrcChainID), uint32(claim.DestChainID), claim.Sequence,
+		claim.Timestamp, payload, nil, nil)
+
+	signBytes := msg.GetBlsSignBytes()
+	msg.VoteAddressSet, msg.AggSignature, err = keys.Vote(validators, signBytes[:])
+	if err != nil {
+		return nil, err
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// InturnRelayer returns the validator whose relayer is in turn at a time to relay the claims from a source chain,
+// following the schedule of the oracle module.
+func InturnRelayer(validators []stakingtypes.Validator, relayerInterval, timestamp uint64, claimSrcChain types.ClaimSrcChain) (stakingtypes.Validator, error) {
+	if len(validators) == 0 {
+		return stakingtypes.Validator{}, fmt.Errorf("empty validator set")
+	}
+	index, _ := types.InturnRelayerIndex(len(validators), relayerInterval, timestamp, claimSrcChain)
+	return validators[index], nil
+}
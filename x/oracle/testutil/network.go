@@ -0,0 +1,126 @@
+package testutil
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ClaimGasLimit is the gas limit of the claim txs relayed to a test network.
+const ClaimGasLimit = 1000000
+
+// NetworkBlsKeySet returns the set of the BLS keys of the validators of a test network.
+func NetworkBlsKeySet(n *network.Network) *BlsKeySet {
+	keys := make([]bls.SecretKey, 0, len(n.Validators))
+	for _, val := range n.Validators {
+		keys = append(keys, val.BlsKey)
+	}
+	return NewBlsKeySet(keys...)
+}
+
+// Relay relays the packages between a test network and a stand-in chain. The packages sent by the network to the
+// chain on the channels are received by the chain, then the packages pending on the chain are claimed to the
+// network by its in-turn relayer. It returns the response of the claim tx, nil if no package is pending.
+func Relay(ctx context.Context, n *network.Network, chain *LoopbackChain, channels ...sdk.ChannelID) (*sdk.TxResponse, error) {
+	clientCtx := n.Validators[0].ClientCtx
+
+	crossChainClient := crosschaintypes.NewQueryClient(clientCtx)
+	for _, channelID := range channels {
+		res, err := crossChainClient.SendSequence(ctx, &crosschaintypes.QuerySendSequenceRequest{
+			DestChainId: uint32(chain.ChainID),
+			ChannelId:   uint32(channelID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for sequence := chain.ReceiveSequence(channelID); sequence < res.Sequence; sequence++ {
+			packRes, err := crossChainClient.CrossChainPackage(ctx, &crosschaintypes.QueryCrossChainPackageRequest{
+				DestChainId: uint32(chain.ChainID),
+				ChannelId:   uint32(channelID),
+				Sequence:    sequence,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if err := chain.Receive(channelID, sequence, packRes.Package); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if chain.PendingPackages() == 0 {
+		return nil, nil
+	}
+
+	height, err := n.LatestHeight()
+	if err != nil {
+		return nil, err
+	}
+	historicalInfo, err := stakingtypes.NewQueryClient(clientCtx).HistoricalInfo(ctx, &stakingtypes.QueryHistoricalInfoRequest{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	inturnRes, err := types.NewQueryClient(clientCtx).InturnRelayer(ctx, &types.QueryInturnRelayerRequest{
+		ClaimSrcChain: types.CLAIM_SRC_CHAIN_BSC,
+	})
+	if err != nil {
+		return nil, err
+	}
+	relayer, err := networkValidatorByBlsKey(n, inturnRes.BlsPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := chain.Claim(relayer.Address, historicalInfo.Hist.Valset, uint64(time.Now().Unix()))
+	if err != nil {
+		return nil, err
+	}
+	return broadcastClaim(n, relayer, msg)
+}
+
+// networkValidatorByBlsKey returns the validator of a test network with a hex BLS public key.
+func networkValidatorByBlsKey(n *network.Network, blsPubKey string) (*network.Validator, error) {
+	for _, val := range n.Validators {
+		if hex.EncodeToString(val.BlsKey.PublicKey().Marshal()) == blsPubKey {
+			return val, nil
+		}
+	}
+	return nil, fmt.Errorf("no validator with the BLS public key %s", blsPubKey)
+}
+
+// broadcastClaim broadcasts a claim tx signed by the account of a validator of a test network.
+func broadcastClaim(n *network.Network, val *network.Validator, msg *types.MsgClaim) (*sdk.TxResponse, error) {
+	clientCtx := val.ClientCtx.WithFromAddress(val.Address).WithFromName(val.Moniker)
+	txf := tx.Factory{}.
+		WithChainID(n.Config.ChainID).
+		WithKeybase(clientCtx.Keyring).
+		WithTxConfig(n.Config.TxConfig).
+		WithAccountRetriever(n.Config.AccountRetriever).
+		WithGas(ClaimGasLimit).
+		WithGasPrices(n.Config.MinGasPrices)
+	txf, err := txf.Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	txBuilder, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(txf, val.Moniker, txBuilder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := n.Config.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	return clientCtx.BroadcastTxSync(txBytes)
+}
@@ -0,0 +1,147 @@
+package testutil_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/stretchr/testify/require"
+	"github.com/willf/bitset"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
+	_ "github.com/cosmos/cosmos-sdk/x/authz/module"
+	_ "github.com/cosmos/cosmos-sdk/x/bank"
+	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/crosschain"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	_ "github.com/cosmos/cosmos-sdk/x/genutil"
+	_ "github.com/cosmos/cosmos-sdk/x/oracle"
+	"github.com/cosmos/cosmos-sdk/x/oracle/testutil"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	_ "github.com/cosmos/cosmos-sdk/x/params"
+	_ "github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRelayRoundTrip(t *testing.T) {
+	cfg, err := network.DefaultConfigWithAppConfig(configurator.NewAppConfig(
+		configurator.AuthModule(),
+		configurator.AuthzModule(),
+		configurator.BankModule(),
+		configurator.ParamsModule(),
+		configurator.GenutilModule(),
+		configurator.StakingModule(),
+		configurator.ConsensusModule(),
+		configurator.TxModule(),
+		configurator.CrossChainModule(),
+		configurator.OracleModule(),
+	))
+	require.NoError(t, err)
+	cfg.NumValidators = 1
+
+	n, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	defer n.Cleanup()
+	require.NoError(t, n.WaitForNextBlock())
+
+	// the chain IDs of the crosschain module are not set by the app config
+	chain := testutil.NewLoopbackChain(0, 0, testutil.NetworkBlsKeySet(n))
+
+	// the multi message package without messages crashes, and is answered with a fail ack package
+	payload := []byte("loopback payload")
+	chain.Send(types.MultiMessageChannelId, payload, big.NewInt(0), big.NewInt(0))
+	res, err := testutil.Relay(context.Background(), n, chain, types.MultiMessageChannelId)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code, res.RawLog)
+	require.NoError(t, n.WaitForNextBlock())
+
+	queryClient := crosschaintypes.NewQueryClient(n.Validators[0].ClientCtx)
+	seqRes, err := queryClient.ReceiveSequence(context.Background(), &crosschaintypes.QueryReceiveSequenceRequest{
+		ChannelId: uint32(types.RelayPackagesChannelId),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), seqRes.Sequence)
+
+	res, err = testutil.Relay(context.Background(), n, chain, types.MultiMessageChannelId)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	received := chain.ReceivedPackages(types.MultiMessageChannelId)
+	require.Len(t, received, 1)
+	require.Equal(t, sdk.FailAckCrossChainPackageType, received[0].Header.PackageType)
+	require.Equal(t, payload, received[0].Payload)
+}
+
+func TestLoopbackChainAcks(t *testing.T) {
+	keys, err := testutil.GenerateBlsKeySet(3)
+	require.NoError(t, err)
+	validators := make([]stakingtypes.Validator, 3)
+	for i, pubKey := range keys.PubKeys() {
+		validators[i].BlsKey = pubKey
+	}
+
+	chain := testutil.NewLoopbackChain(56, 1, keys)
+	chain.RegisterApp(1, func(payload []byte) ([]byte, error) { return append([]byte("ack "), payload...), nil })
+	chain.RegisterApp(2, func(payload []byte) ([]byte, error) { return nil, fmt.Errorf("failed") })
+
+	synPackage := func(payload string) []byte {
+		return testutil.NewPackage(0, 0, sdk.PackageHeader{
+			PackageType:   sdk.SynCrossChainPackageType,
+			RelayerFee:    big.NewInt(1),
+			AckRelayerFee: big.NewInt(2),
+		}, []byte(payload)).Payload
+	}
+	require.NoError(t, chain.Receive(1, 0, synPackage("a")))
+	require.NoError(t, chain.Receive(2, 0, synPackage("b")))
+	require.NoError(t, chain.Receive(3, 0, synPackage("c")))
+	require.Error(t, chain.Receive(1, 0, synPackage("a")))
+	require.Equal(t, 3, chain.PendingPackages())
+
+	relayer := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.EthAddressLength))
+	msg, err := chain.Claim(relayer, validators, 1000)
+	require.NoError(t, err)
+	require.Equal(t, uint32(56), msg.SrcChainId)
+	require.Equal(t, uint64(0), msg.Sequence)
+	require.Equal(t, 0, chain.PendingPackages())
+
+	var packages types.Packages
+	require.NoError(t, rlp.DecodeBytes(msg.Payload, &packages))
+	require.Len(t, packages, 3)
+	expected := []struct {
+		packageType sdk.CrossChainPackageType
+		payload     string
+	}{
+		{sdk.AckCrossChainPackageType, "ack a"},
+		{sdk.FailAckCrossChainPackageType, "b"},
+		{sdk.FailAckCrossChainPackageType, "c"},
+	}
+	for i, pack := range packages {
+		header, err := sdk.DecodePackageHeader(pack.Payload)
+		require.NoError(t, err)
+		require.Equal(t, expected[i].packageType, header.PackageType)
+		require.Equal(t, uint64(1000), header.Timestamp)
+		require.Equal(t, big.NewInt(2), header.RelayerFee)
+		require.Equal(t, expected[i].payload, string(pack.Payload[sdk.AckPackageHeaderLength:]))
+	}
+
+	// the aggregated signature of all the validators verifies
+	pubKeys := make([]bls.PublicKey, 0, len(validators))
+	for _, validator := range validators {
+		pubKey, err := bls.PublicKeyFromBytes(validator.BlsKey)
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	sig, err := bls.SignatureFromBytes(msg.AggSignature)
+	require.NoError(t, err)
+	signBytes := msg.GetBlsSignBytes()
+	require.True(t, sig.FastAggregateVerify(pubKeys, signBytes))
+	require.Equal(t, uint(3), bitset.From(msg.VoteAddressSet).Count())
+}
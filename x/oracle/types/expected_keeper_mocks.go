@@ -79,6 +79,20 @@ func (mr *MockStakingKeeperMockRecorder) GetLastValidators(ctx interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastValidators", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastValidators), ctx)
 }
 
+// HistoricalEntries mocks base method.
+func (m *MockStakingKeeper) HistoricalEntries(ctx types.Context) uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HistoricalEntries", ctx)
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// HistoricalEntries indicates an expected call of HistoricalEntries.
+func (mr *MockStakingKeeperMockRecorder) HistoricalEntries(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HistoricalEntries", reflect.TypeOf((*MockStakingKeeper)(nil).HistoricalEntries), ctx)
+}
+
 // MockCrossChainKeeper is a mock of CrossChainKeeper interface.
 type MockCrossChainKeeper struct {
 	ctrl     *gomock.Controller
@@ -10,6 +10,7 @@ import (
 type StakingKeeper interface {
 	GetLastValidators(ctx sdk.Context) (validators []types.Validator)
 	GetHistoricalInfo(ctx sdk.Context, height int64) (types.HistoricalInfo, bool)
+	HistoricalEntries(ctx sdk.Context) uint32
 	BondDenom(ctx sdk.Context) (res string)
 }
 
@@ -0,0 +1,7 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// FeatureOpBNBClaim verifies claims from opBNB against the opBNB relayer schedule.
+var FeatureOpBNBClaim = sdk.RegisterFeature(ModuleName, "OpBNBClaim", sdk.Pampas,
+	"claims from the opBNB chain are verified against the in-turn relayer schedule of opBNB")
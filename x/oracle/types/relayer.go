@@ -0,0 +1,23 @@
+package types
+
+// InturnRelayerIndex returns the index of the in-turn relayer in a validator set of a size at a time, and its
+// relay interval. The relayers of the claims from opBNB are shifted by half of the validator set.
+func InturnRelayerIndex(validatorsSize int, relayerInterval, timestamp uint64, claimSrcChain ClaimSrcChain) (uint64, *RelayInterval) {
+	// totalIntervals is sum of intervals from all relayers
+	totalIntervals := relayerInterval * uint64(validatorsSize)
+
+	// remainder is used to locate inturn relayer.
+	remainder := timestamp % totalIntervals
+	inTurnRelayerIndex := remainder / relayerInterval
+
+	start := timestamp - (remainder - inTurnRelayerIndex*relayerInterval)
+	end := start + relayerInterval
+
+	if claimSrcChain == CLAIM_SRC_CHAIN_OP_BNB {
+		inTurnRelayerIndex = (inTurnRelayerIndex + uint64(validatorsSize/2)) % uint64(validatorsSize)
+	}
+	return inTurnRelayerIndex, &RelayInterval{
+		Start: start,
+		End:   end,
+	}
+}
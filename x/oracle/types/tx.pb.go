@@ -227,42 +227,43 @@ func init() {
 func init() { proto.RegisterFile("cosmos/oracle/v1/tx.proto", fileDescriptor_836933fb4b988e66) }
 
 var fileDescriptor_836933fb4b988e66 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0xb5, 0x4d, 0x9a, 0x5c, 0x1c, 0x08, 0xa7, 0x4a, 0x75, 0x2c, 0x70, 0x8c, 0x59, 0x4c,
-	0x51, 0x6d, 0xb5, 0x48, 0x0c, 0xed, 0x44, 0x3a, 0xa0, 0x0e, 0x91, 0x90, 0x2b, 0x16, 0x84, 0x64,
-	0x5d, 0xed, 0xe3, 0x62, 0x11, 0xfb, 0x8c, 0xef, 0x12, 0x35, 0x1b, 0x62, 0x62, 0x64, 0x66, 0xea,
-	0xd8, 0xb1, 0x12, 0xfc, 0x11, 0x95, 0x58, 0x2a, 0x26, 0x26, 0x84, 0x92, 0xa1, 0xfc, 0x19, 0xc8,
-	0x3f, 0x8e, 0xd0, 0x16, 0xd4, 0xc5, 0xbe, 0x7b, 0xef, 0x7d, 0xef, 0xfb, 0xa1, 0xfb, 0x60, 0x37,
-	0x60, 0x3c, 0x66, 0xdc, 0x65, 0x19, 0x0e, 0x46, 0xc4, 0x9d, 0x6c, 0xb9, 0xe2, 0xc8, 0x49, 0x33,
-	0x26, 0x18, 0xea, 0x94, 0x94, 0x53, 0x52, 0xce, 0x64, 0x4b, 0x5f, 0xa3, 0x8c, 0xb2, 0x82, 0x74,
-	0xf3, 0x53, 0xa9, 0xd3, 0x2b, 0x0b, 0xbf, 0x24, 0xaa, 0xa0, 0x92, 0x5a, 0xaf, 0xdc, 0x63, 0x4e,
-	0x73, 0xeb, 0x98, 0xd3, 0x8a, 0xb8, 0x77, 0x2d, 0x6d, 0x95, 0xa5, 0xa4, 0xef, 0xe0, 0x38, 0x4a,
-	0x98, 0x5b, 0x7c, 0x4b, 0xc8, 0xfa, 0xba, 0x04, 0x1b, 0x03, 0x4e, 0xf7, 0x46, 0x38, 0x8a, 0xd1,
-	0x2e, 0x54, 0x5f, 0x67, 0x2c, 0xf6, 0x71, 0x18, 0x66, 0x84, 0x73, 0x0d, 0x98, 0xc0, 0x6e, 0xf6,
-	0xb5, 0x6f, 0x5f, 0x36, 0xd7, 0xaa, 0xfc, 0x4f, 0x4b, 0xe6, 0x40, 0x64, 0x51, 0x42, 0xbd, 0x56,
-	0xae, 0xae, 0x20, 0x64, 0x42, 0x95, 0x67, 0x81, 0x1f, 0x0c, 0x71, 0x94, 0xf8, 0x51, 0xa8, 0x2d,
-	0x99, 0xc0, 0x6e, 0x7b, 0x90, 0x67, 0xc1, 0x5e, 0x0e, 0xed, 0x87, 0xc8, 0x82, 0xed, 0x90, 0x70,
-	0xb1, 0x90, 0x2c, 0x17, 0x92, 0x56, 0x0e, 0x4a, 0x8d, 0x0e, 0x1b, 0x9c, 0xbc, 0x1d, 0x93, 0x24,
-	0x20, 0xda, 0x8a, 0x09, 0xec, 0x15, 0xef, 0xcf, 0x1d, 0xdd, 0x85, 0x4d, 0x11, 0xc5, 0x84, 0x0b,
-	0x1c, 0xa7, 0x5a, 0xad, 0x20, 0x17, 0x00, 0xd2, 0xe0, 0x6a, 0x8a, 0xa7, 0x23, 0x86, 0x43, 0xad,
-	0x6e, 0x02, 0x5b, 0xf5, 0xe4, 0x15, 0xd9, 0xb0, 0x33, 0x61, 0x82, 0xc8, 0xb6, 0x7c, 0x4e, 0x84,
-	0xb6, 0x6a, 0x2e, 0xdb, 0x75, 0xef, 0x56, 0x8e, 0xcb, 0x9e, 0x88, 0x40, 0x0f, 0x60, 0x1b, 0x53,
-	0xea, 0xf3, 0x88, 0x26, 0x58, 0x8c, 0x33, 0xa2, 0x35, 0x0a, 0x27, 0x15, 0x53, 0x7a, 0x20, 0xb1,
-	0x9d, 0xee, 0x87, 0xe3, 0x9e, 0xf2, 0xeb, 0xb8, 0xa7, 0xbc, 0xbf, 0x38, 0xdd, 0xb8, 0x34, 0x30,
-	0x0b, 0xc1, 0x8e, 0x1c, 0xa6, 0x47, 0x78, 0xca, 0x12, 0x4e, 0xac, 0x4f, 0x00, 0xde, 0x1e, 0x70,
-	0xfa, 0x22, 0x0d, 0xb1, 0x20, 0xcf, 0x71, 0x86, 0x63, 0x8e, 0x9e, 0xc0, 0x26, 0x1e, 0x8b, 0x21,
-	0xcb, 0x22, 0x31, 0xbd, 0x71, 0xca, 0x0b, 0x29, 0xda, 0x85, 0xf5, 0xb4, 0x70, 0x28, 0xa6, 0xdb,
-	0xda, 0xd6, 0x9c, 0xab, 0x8f, 0xc9, 0x29, 0x33, 0xf4, 0x9b, 0x67, 0x3f, 0x7a, 0xca, 0xc9, 0xc5,
-	0xe9, 0x06, 0xf0, 0xaa, 0x90, 0x1d, 0x24, 0x6b, 0x5e, 0x18, 0x5a, 0x5d, 0xb8, 0x7e, 0xa5, 0x36,
-	0x59, 0xf7, 0xf6, 0x67, 0x00, 0x97, 0x07, 0x9c, 0xa2, 0x67, 0xb0, 0x56, 0xbe, 0x0e, 0xfd, 0x7a,
-	0x32, 0xd9, 0xac, 0x6e, 0xfd, 0x9f, 0x93, 0x86, 0xe8, 0x15, 0x54, 0x2f, 0x0d, 0xe1, 0xfe, 0x3f,
-	0x63, 0xfe, 0x96, 0xe8, 0x0f, 0x6f, 0x94, 0x48, 0x77, 0xbd, 0xf6, 0x2e, 0x6f, 0xb6, 0xbf, 0x7f,
-	0x32, 0x33, 0xc0, 0xd9, 0xcc, 0x00, 0xe7, 0x33, 0x03, 0xfc, 0x9c, 0x19, 0xe0, 0xe3, 0xdc, 0x50,
-	0xce, 0xe7, 0x86, 0xf2, 0x7d, 0x6e, 0x28, 0x2f, 0x1f, 0xd1, 0x48, 0x0c, 0xc7, 0x87, 0x4e, 0xc0,
-	0xe2, 0x6a, 0xa3, 0xaa, 0xdf, 0x26, 0x0f, 0xdf, 0xb8, 0x47, 0x72, 0x6f, 0xc4, 0x34, 0x25, 0xfc,
-	0xb0, 0x5e, 0x6c, 0xc8, 0xe3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x14, 0xf5, 0xab, 0x59, 0xcc,
-	0x03, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x4f, 0xd4, 0x40,
+	0x18, 0xed, 0xf0, 0x63, 0x61, 0x87, 0x45, 0x70, 0x20, 0xa1, 0x34, 0x5a, 0x6a, 0x4f, 0x15, 0xb3,
+	0x6d, 0xc0, 0xc4, 0x03, 0x78, 0xb1, 0x1c, 0x94, 0x44, 0x12, 0x53, 0xe2, 0xc5, 0x98, 0x34, 0x43,
+	0x3b, 0x0e, 0x8d, 0xb4, 0x53, 0x3b, 0xb3, 0x84, 0xbd, 0x19, 0x4f, 0x1e, 0x3d, 0x7b, 0xe2, 0xc8,
+	0x91, 0xc4, 0xfd, 0x23, 0x38, 0x92, 0x3d, 0x79, 0x32, 0x66, 0xf7, 0x80, 0xff, 0x82, 0x37, 0xd3,
+	0x76, 0x86, 0x15, 0xd0, 0x70, 0xd9, 0xed, 0xbc, 0xf7, 0xbe, 0xf7, 0xfd, 0x98, 0xf9, 0xe0, 0x72,
+	0xc4, 0x78, 0xca, 0xb8, 0xc7, 0x0a, 0x1c, 0x1d, 0x10, 0xef, 0x70, 0xcd, 0x13, 0x47, 0x6e, 0x5e,
+	0x30, 0xc1, 0xd0, 0x7c, 0x4d, 0xb9, 0x35, 0xe5, 0x1e, 0xae, 0x19, 0x8b, 0x94, 0x51, 0x56, 0x91,
+	0x5e, 0xf9, 0x55, 0xeb, 0x0c, 0x69, 0x11, 0xd6, 0x84, 0x0c, 0xaa, 0xa9, 0x25, 0xe9, 0x9e, 0x72,
+	0x5a, 0x5a, 0xa7, 0x9c, 0x4a, 0xe2, 0xfe, 0x8d, 0xb4, 0x32, 0x4b, 0x4d, 0xdf, 0xc5, 0x69, 0x92,
+	0x31, 0xaf, 0xfa, 0xad, 0x21, 0xfb, 0xf7, 0x18, 0x9c, 0xde, 0xe1, 0x74, 0xeb, 0x00, 0x27, 0x29,
+	0xda, 0x84, 0xad, 0x77, 0x05, 0x4b, 0x43, 0x1c, 0xc7, 0x05, 0xe1, 0x5c, 0x07, 0x16, 0x70, 0x9a,
+	0xbe, 0xde, 0xef, 0xb5, 0x17, 0x65, 0xfe, 0x67, 0x35, 0xb3, 0x2b, 0x8a, 0x24, 0xa3, 0xc1, 0x4c,
+	0xa9, 0x96, 0x10, 0xb2, 0x60, 0x8b, 0x17, 0x51, 0x18, 0xed, 0xe3, 0x24, 0x0b, 0x93, 0x58, 0x1f,
+	0xb3, 0x80, 0x33, 0x1b, 0x40, 0x5e, 0x44, 0x5b, 0x25, 0xb4, 0x1d, 0x23, 0x1b, 0xce, 0xc6, 0x84,
+	0x8b, 0x91, 0x64, 0xbc, 0x92, 0xcc, 0x94, 0xa0, 0xd2, 0x18, 0x70, 0x9a, 0x93, 0x0f, 0x1d, 0x92,
+	0x45, 0x44, 0x9f, 0xb0, 0x80, 0x33, 0x11, 0x5c, 0x9e, 0xd1, 0x3d, 0xd8, 0x14, 0x49, 0x4a, 0xb8,
+	0xc0, 0x69, 0xae, 0x4f, 0x56, 0xe4, 0x08, 0x40, 0x6d, 0x38, 0x95, 0xe3, 0xee, 0x01, 0xc3, 0xb1,
+	0xde, 0xb0, 0x80, 0xd3, 0xf2, 0x17, 0xfa, 0xbd, 0xf6, 0x9c, 0xac, 0xfb, 0x05, 0x39, 0xf2, 0xbb,
+	0x82, 0xf0, 0x40, 0x69, 0x90, 0x03, 0xe7, 0x0f, 0x99, 0x20, 0xaa, 0xd7, 0x90, 0x13, 0xa1, 0x4f,
+	0x59, 0xe3, 0x4e, 0x23, 0xb8, 0x53, 0xe2, 0xaa, 0x51, 0x22, 0xd0, 0x53, 0x38, 0x8b, 0x29, 0x0d,
+	0x79, 0x42, 0x33, 0x2c, 0x3a, 0x05, 0xd1, 0xa7, 0x2b, 0xfb, 0xa5, 0x7e, 0xaf, 0xbd, 0x20, 0xed,
+	0xfd, 0x97, 0xbb, 0xbb, 0x8a, 0x0e, 0x5a, 0x98, 0xd2, 0xcb, 0xd3, 0xc6, 0xf2, 0xe7, 0xe3, 0x15,
+	0xed, 0xd7, 0xf1, 0x8a, 0xf6, 0xe9, 0xe2, 0x74, 0xf5, 0xca, 0x78, 0x6d, 0x04, 0xe7, 0xd5, 0xe8,
+	0x03, 0xc2, 0x73, 0x96, 0x71, 0x62, 0x7f, 0x05, 0x70, 0x6e, 0x87, 0xd3, 0xd7, 0x79, 0x8c, 0x05,
+	0x79, 0x85, 0x0b, 0x9c, 0x72, 0xf4, 0x04, 0x36, 0x71, 0x47, 0xec, 0xb3, 0x22, 0x11, 0xdd, 0x5b,
+	0xef, 0x64, 0x24, 0x45, 0x9b, 0xb0, 0x91, 0x57, 0x0e, 0xd5, 0x5d, 0xcc, 0xac, 0xeb, 0xee, 0xf5,
+	0xa7, 0xe7, 0xd6, 0x19, 0xfc, 0xe6, 0xd9, 0x8f, 0x15, 0xed, 0xe4, 0xe2, 0x74, 0x15, 0x04, 0x32,
+	0x64, 0x03, 0xa9, 0x9a, 0x47, 0x86, 0xf6, 0x32, 0x5c, 0xba, 0x56, 0x9b, 0xaa, 0x7b, 0xfd, 0x1b,
+	0x80, 0xe3, 0x3b, 0x9c, 0xa2, 0xe7, 0x70, 0xb2, 0x7e, 0x4b, 0xc6, 0xcd, 0x64, 0xaa, 0x59, 0xc3,
+	0xfe, 0x3f, 0xa7, 0x0c, 0xd1, 0x5b, 0xd8, 0xba, 0x32, 0x84, 0x07, 0xff, 0x8c, 0xf9, 0x5b, 0x62,
+	0x3c, 0xbc, 0x55, 0xa2, 0xdc, 0x8d, 0xc9, 0x8f, 0x65, 0xb3, 0xfe, 0xf6, 0xc9, 0xc0, 0x04, 0x67,
+	0x03, 0x13, 0x9c, 0x0f, 0x4c, 0xf0, 0x73, 0x60, 0x82, 0x2f, 0x43, 0x53, 0x3b, 0x1f, 0x9a, 0xda,
+	0xf7, 0xa1, 0xa9, 0xbd, 0x79, 0x44, 0x13, 0xb1, 0xdf, 0xd9, 0x73, 0x23, 0x96, 0xca, 0xfd, 0x93,
+	0x7f, 0x6d, 0x1e, 0xbf, 0xf7, 0x8e, 0xd4, 0x96, 0x89, 0x6e, 0x4e, 0xf8, 0x5e, 0xa3, 0xda, 0xa7,
+	0xc7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x85, 0xf9, 0x42, 0xc2, 0xfa, 0x03, 0x00, 0x00,
 }
 
 func (this *MsgClaimResponse) Equal(that interface{}) bool {
@@ -37,6 +37,7 @@ const (
 	FlagAddressChallenger = "addr-challenger"
 	FlagBlsKey            = "bls-key"
 	FlagBlsProof          = "bls-proof"
+	FlagBlsFrom           = "bls-from"
 )
 
 // common flagsets to add to various functions
@@ -98,6 +99,14 @@ func FlagSetBlsKey() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(FlagBlsKey, "", "The bls pubkey of the validator")
 	fs.String(FlagBlsProof, "", "The bls proof of the validator")
+	fs.String(FlagBlsFrom, "", "Name of the BLS key in the keyring to sign the bls proof with, overrides --bls-key and --bls-proof")
+	return fs
+}
+
+// FlagSetBlsFrom Returns the flagset for reading the bls key and proof from the keyring.
+func FlagSetBlsFrom() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagBlsFrom, "", "Name of the BLS key in the keyring to sign the bls proof with, overrides bls_key and bls_proof of the message")
 	return fs
 }
 
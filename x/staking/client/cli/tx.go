@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -111,7 +113,8 @@ Where create_validator_proposal.json contains:
 	"deposit": "1000000000000000000BNB"
 }
 
-modify the related configrations as you need, where you can get the pubkey using "%s tendermint show-validator"
+modify the related configrations as you need, where you can get the pubkey using "%s tendermint show-validator",
+the bls_key and bls_proof can be signed with a BLS key of the keyring using --bls-from
 `, version.AppName, version.AppName)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -124,20 +127,33 @@ modify the related configrations as you need, where you can get the pubkey using
 				return err
 			}
 
-			govMsg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary)
-			if err != nil {
-				return fmt.Errorf("invalid message: %w", err)
-			}
-
 			if len(msgs) != 1 {
 				return fmt.Errorf("invalid message length: %d", len(msgs))
 			}
 
 			valMsg, ok := msgs[0].(*types.MsgCreateValidator)
-			if !ok || valMsg.ValidateBasic() != nil {
+			if !ok {
 				return fmt.Errorf("invalid create validator message")
 			}
 
+			blsPk, blsProof, err := getBlsKeyAndProof(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if blsPk != "" {
+				valMsg.BlsKey = blsPk
+				valMsg.BlsProof = blsProof
+			}
+
+			if valMsg.ValidateBasic() != nil {
+				return fmt.Errorf("invalid create validator message")
+			}
+
+			govMsg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
 			delAddr, err := sdk.AccAddressFromHexUnsafe(valMsg.DelegatorAddress)
 			if err != nil {
 				return err
@@ -165,6 +181,7 @@ modify the related configrations as you need, where you can get the pubkey using
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetBlsFrom())
 	flags.AddTxFlagsToCmd(cmd)
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
@@ -232,8 +249,14 @@ func NewEditValidatorCmd() *cobra.Command {
 				}
 			}
 
-			blsPk, _ := cmd.Flags().GetString(FlagBlsKey)
-			blsProof, _ := cmd.Flags().GetString(FlagBlsProof)
+			blsPk, blsProof, err := getBlsKeyAndProof(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if blsPk == "" {
+				blsPk, _ = cmd.Flags().GetString(FlagBlsKey)
+				blsProof, _ = cmd.Flags().GetString(FlagBlsProof)
+			}
 
 			msg := types.NewMsgEditValidator(
 				valAddr, description, newRate, newMinSelfDelegation,
@@ -601,6 +624,22 @@ func PrepareConfigForTxCreateValidator(flagSet *flag.FlagSet, moniker, nodeID, c
 	return c, nil
 }
 
+// getBlsKeyAndProof returns the hex encoded public key and proof of possession of the BLS key of the keyring named
+// by the --bls-from flag, or empty strings if the flag is not set.
+func getBlsKeyAndProof(clientCtx client.Context, fs *flag.FlagSet) (string, string, error) {
+	name, _ := fs.GetString(FlagBlsFrom)
+	if name == "" {
+		return "", "", nil
+	}
+
+	pubKey, proof, err := keyring.SignBLSProof(clientCtx.Keyring, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	return hex.EncodeToString(pubKey), hex.EncodeToString(proof), nil
+}
+
 // BuildCreateValidatorMsg makes a new MsgCreateValidator.
 func BuildCreateValidatorMsg(clientCtx client.Context, config TxCreateValidatorConfig, txBldr tx.Factory, generateOnly bool) (tx.Factory, sdk.Msg, error) {
 	amounstStr := config.Amount
@@ -2,13 +2,19 @@ package cli_test
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -22,9 +28,13 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var PKs = simtestutil.CreateTestPubKeys(500)
@@ -536,3 +546,143 @@ func (s *CLITestSuite) TestNewCancelUnbondingDelegationCmd() {
 func TestCLITestSuite(t *testing.T) {
 	suite.Run(t, new(CLITestSuite))
 }
+
+// importBLSKeystore creates a BLS key in another keyring and imports it in the keyring of the suite from its
+// EIP-2335 keystore, returning its public key.
+func (s *CLITestSuite) importBLSKeystore(uid string) []byte {
+	kr := keyring.NewInMemory(s.encCfg.Codec)
+	_, _, err := kr.NewMnemonic(uid, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.EthBLS)
+	s.Require().NoError(err)
+	keystoreJSON, err := keyring.ExportBLSKeystore(kr, uid, "password")
+	s.Require().NoError(err)
+
+	k, err := keyring.ImportBLSKeystore(s.kr, uid, keystoreJSON, "password")
+	s.Require().NoError(err)
+	pk, err := k.GetPubKey()
+	s.Require().NoError(err)
+	return pk.Bytes()
+}
+
+// requireBLSProof checks the hex encoded BLS key and proof of a msg against the public key of the BLS key.
+func (s *CLITestSuite) requireBLSProof(pubKey []byte, blsKey, blsProof string) {
+	s.Require().Equal(hex.EncodeToString(pubKey), blsKey)
+
+	proofBz, err := hex.DecodeString(blsProof)
+	s.Require().NoError(err)
+	pk, err := bls.PublicKeyFromBytes(pubKey)
+	s.Require().NoError(err)
+	sig, err := bls.SignatureFromBytes(proofBz)
+	s.Require().NoError(err)
+	s.Require().True(sig.Verify(pk, tmhash.Sum(pubKey)))
+}
+
+func (s *CLITestSuite) TestNewEditValidatorCmdBlsFrom() {
+	pubKey := s.importBLSKeystore("edit-validator-bls")
+
+	testCases := []struct {
+		name      string
+		blsFrom   string
+		expectErr error
+	}{
+		{"keystore imported BLS key", "edit-validator-bls", nil},
+		{"unknown key", "unknown-bls", sdkerrors.ErrKeyNotFound},
+		{"non BLS key", "NewValidator", keyring.ErrNotBLSKey},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cli.NewEditValidatorCmd(), []string{
+				fmt.Sprintf("--%s=%s", cli.FlagBlsFrom, tc.blsFrom),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			})
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			tx, err := s.encCfg.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err)
+			s.Require().Len(tx.GetMsgs(), 1)
+			msg, ok := tx.GetMsgs()[0].(*types.MsgEditValidator)
+			s.Require().True(ok)
+			s.requireBLSProof(pubKey, msg.BlsKey, msg.BlsProof)
+		})
+	}
+}
+
+func (s *CLITestSuite) TestNewCreateValidatorCmdBlsFrom() {
+	pubKey := s.importBLSKeystore("create-validator-bls")
+
+	// the tx wraps the msg in a grant and a proposal
+	authz.RegisterInterfaces(s.encCfg.InterfaceRegistry)
+	v1.RegisterInterfaces(s.encCfg.InterfaceRegistry)
+
+	proposal := fmt.Sprintf(`{
+	"messages": [
+		{
+			"@type": "/cosmos.staking.v1beta1.MsgCreateValidator",
+			"description": {"moniker": "validator"},
+			"commission": {"rate": "0.1", "max_rate": "0.2", "max_change_rate": "0.01"},
+			"min_self_delegation": "1",
+			"delegator_address": "%s",
+			"validator_address": "%s",
+			"pubkey": {"@type": "/cosmos.crypto.ed25519.PubKey", "key": "%s"},
+			"value": {"denom": "%s", "amount": "100"},
+			"from": "%s",
+			"relayer_address": "%s",
+			"challenger_address": "%s",
+			"bls_key": "",
+			"bls_proof": ""
+		}
+	],
+	"metadata": "",
+	"title": "Create validator",
+	"summary": "create validator",
+	"deposit": "1%s"
+}`, s.addrs[0], s.addrs[0], base64.StdEncoding.EncodeToString(PKs[0].Bytes()), sdk.DefaultBondDenom,
+		s.addrs[0], s.addrs[1], s.addrs[2], sdk.DefaultBondDenom)
+	proposalFile := filepath.Join(s.T().TempDir(), "create_validator_proposal.json")
+	s.Require().NoError(os.WriteFile(proposalFile, []byte(proposal), 0o600))
+
+	testCases := []struct {
+		name      string
+		blsFrom   string
+		expectErr error
+	}{
+		{"keystore imported BLS key", "create-validator-bls", nil},
+		{"unknown key", "unknown-bls", sdkerrors.ErrKeyNotFound},
+		{"non BLS key", "NewValidator", keyring.ErrNotBLSKey},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cli.NewCreateValidatorCmd(), []string{
+				proposalFile,
+				fmt.Sprintf("--%s=%s", cli.FlagBlsFrom, tc.blsFrom),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.addrs[0]),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			})
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			tx, err := s.encCfg.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err)
+			s.Require().Len(tx.GetMsgs(), 2)
+			govMsg, ok := tx.GetMsgs()[1].(*v1.MsgSubmitProposal)
+			s.Require().True(ok)
+			msgs, err := govMsg.GetMsgs()
+			s.Require().NoError(err)
+			s.Require().Len(msgs, 1)
+			msg, ok := msgs[0].(*types.MsgCreateValidator)
+			s.Require().True(ok)
+			s.requireBLSProof(pubKey, msg.BlsKey, msg.BlsProof)
+		})
+	}
+}
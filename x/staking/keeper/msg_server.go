@@ -19,7 +19,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
-	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 type msgServer struct {
@@ -362,7 +361,7 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		return nil, err
 	}
 
-	if !ctx.IsUpgraded(upgradetypes.EnablePublicDelegationUpgrade) {
+	if !ctx.IsFeatureEnabled(types.FeaturePublicDelegation) {
 		selfDelAddress := validator.GetSelfDelegator()
 		if delegatorAddress.String() != selfDelAddress.String() {
 			return nil, types.ErrDelegationNotAllowed
@@ -408,7 +407,7 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 // BeginRedelegate defines a method for performing a redelegation of coins from a delegator and source validator to a destination validator
 func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRedelegate) (*types.MsgBeginRedelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if !ctx.IsUpgraded(upgradetypes.EnablePublicDelegationUpgrade) {
+	if !ctx.IsFeatureEnabled(types.FeaturePublicDelegation) {
 		return nil, types.ErrRedelegationNotAllowed
 	}
 
@@ -0,0 +1,7 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// FeaturePublicDelegation allows any account to delegate to and redelegate between validators.
+var FeaturePublicDelegation = sdk.RegisterFeature(ModuleName, "PublicDelegation", sdk.EnablePublicDelegationUpgrade,
+	"any account can delegate to any validator and redelegate between validators, not only the self delegator")
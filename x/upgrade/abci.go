@@ -17,12 +17,16 @@ import (
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
+// If a readiness threshold is set, it will halt at the block before the plan height when the voting
+// power that signalled readiness for the plan is below the threshold.
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
 // skipUpgradeHeightArray is a set of block heights for which the upgrade must be skipped
 func BeginBlocker(k *keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	// the done heights are cached once the upgrades of the block are applied
+	defer k.CacheDoneHeights(ctx)
 
 	plans, found := k.GetUpgradePlan(ctx)
 
@@ -39,7 +43,13 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 			ctx.Logger().Info(fmt.Sprintf("applying upgrade \"%s\" at %s", plan.Name, plan.DueAt()))
 			ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
 			k.ApplyUpgrade(ctx, *plan)
+			k.ClearReadinessSignals(ctx, plan.Name)
 			executed = true
+		} else if plan.Height == ctx.BlockHeight()+1 {
+			if err := k.CheckReadiness(ctx, *plan); err != nil {
+				ctx.Logger().Error(err.Error())
+				panic(err)
+			}
 		}
 	}
 	if executed {
@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetReadinessCmd(),
+		GetFeaturesCmd(),
 	)
 
 	return cmd
@@ -161,3 +163,61 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetReadinessCmd returns the readiness of the validators for an upgrade plan
+func GetReadinessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "readiness [plan-name]",
+		Short: "get the readiness of the validators for an upgrade plan",
+		Long: "Gets the readiness signals of the validators for an upgrade plan, together with\n" +
+			"the ratio of voting power that signalled readiness.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Readiness(cmd.Context(), &types.QueryReadinessRequest{PlanName: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetFeaturesCmd returns the registered features with their activation state
+func GetFeaturesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "features",
+		Short: "get the features activated by upgrades",
+		Long: "Gets the features declared by the modules, together with the upgrade activating\n" +
+			"each of them, its activation height and whether it is enabled.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Features(cmd.Context(), &types.QueryFeaturesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
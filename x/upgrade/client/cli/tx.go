@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// FlagBinaryVersion is the flag to override the binary version carried by a readiness signal
+const FlagBinaryVersion = "binary-version"
+
+// GetTxCmd returns the parent command for all x/upgrade CLI transaction commands.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Upgrade transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewSignalReadinessCmd(),
+	)
+
+	return cmd
+}
+
+// NewSignalReadinessCmd returns a CLI command handler for creating a MsgSignalReadiness transaction.
+func NewSignalReadinessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-readiness [plan-name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "signal that the validator runs a binary ready for an upgrade plan",
+		Long: `Signal that the validator runs a binary which has the upgrade handler of the plan registered.
+The signal carries the version of the binary, which defaults to the version of this binary:
+
+$ <appd> tx upgrade signal-readiness Savanna --from mykey
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			binaryVersion, err := cmd.Flags().GetString(FlagBinaryVersion)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSignalReadiness(clientCtx.GetFromAddress(), args[0], binaryVersion)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBinaryVersion, version.Version, "The version of the binary the validator runs")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
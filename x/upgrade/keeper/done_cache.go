@@ -0,0 +1,62 @@
+package keeper
+
+import "sync"
+
+// doneCacheSize is the number of block heights kept in the done cache. Two heights
+// cover the check state and the deliver state, which are used alternately.
+const doneCacheSize = 2
+
+// doneCache caches the done heights of the applied upgrades per block height.
+// Upgrades are only applied in BeginBlock, so the done heights seen by all the
+// contexts of a block height are the same once the upgrades of the block are
+// applied. Only the deliver state fills the cache, so that the upgrades applied on
+// branches of the state which are discarded are never cached, and applying an upgrade
+// at a block height invalidates the cached done heights of that height.
+type doneCache struct {
+	mtx     sync.RWMutex
+	heights map[int64]map[string]int64
+}
+
+func newDoneCache() *doneCache {
+	return &doneCache{heights: make(map[int64]map[string]int64, doneCacheSize)}
+}
+
+// doneHeight returns the done height of the upgrade at the given block height, and
+// false if the done heights of the block height are not cached.
+func (c *doneCache) doneHeight(blockHeight int64, name string) (int64, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	heights, ok := c.heights[blockHeight]
+	if !ok {
+		return 0, false
+	}
+	return heights[name], true
+}
+
+// store caches the done heights of the given block height, evicting the lowest
+// cached block height if the cache is full.
+func (c *doneCache) store(blockHeight int64, heights map[string]int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, ok := c.heights[blockHeight]; !ok && len(c.heights) >= doneCacheSize {
+		lowest := blockHeight
+		for h := range c.heights {
+			if h < lowest {
+				lowest = h
+			}
+		}
+		delete(c.heights, lowest)
+	}
+	c.heights[blockHeight] = heights
+}
+
+// invalidate removes the cached done heights of the given block height, at which an
+// upgrade is applied, possibly on a branch of the state.
+func (c *doneCache) invalidate(blockHeight int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	delete(c.heights, blockHeight)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// DryRunUpgrade applies the named upgrade plan on the given context through ApplyUpgrade and returns the module
+// versions before and after the upgrade. Unlike ApplyUpgrade, failures of the upgrade initializer or handler are
+// returned as errors and panics are recovered. It is meant to be run on a branch of the state which is discarded
+// afterwards, the plan is applied at the height of the context.
+func (k Keeper) DryRunUpgrade(ctx sdk.Context, name string) (fromVM, toVM module.VersionMap, err error) {
+	if k.GetDoneHeight(ctx, name) != 0 {
+		return nil, nil, errors.Wrapf(types.ErrUpgradeCompleted, "upgrade %s", name)
+	}
+	if !k.HasHandler(name) {
+		return nil, nil, errors.Wrapf(types.ErrUpgradeFailed, "missing handler to upgrade %s", name)
+	}
+
+	plan := types.Plan{Name: name, Height: ctx.BlockHeight()}
+	if configured, ok := k.upgradeConfig.GetPlanByName(name); ok {
+		plan.Info = configured.Info
+	}
+
+	fromVM = k.GetModuleVersionMap(ctx)
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Wrapf(types.ErrUpgradeFailed, "upgrade %s panicked: %v", name, r)
+		}
+	}()
+
+	k.ApplyUpgrade(ctx, plan)
+	// ApplyUpgrade only logs failures, the plan is marked as done once the handler succeeded
+	if k.GetDoneHeight(ctx, name) == 0 {
+		return nil, nil, errors.Wrapf(types.ErrUpgradeFailed, "upgrade %s was not applied, see the logs for details", name)
+	}
+
+	return fromVM, k.GetModuleVersionMap(ctx), nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// GetFeatureStates returns all the registered features with their activation height and state.
+func (k Keeper) GetFeatureStates(ctx sdk.Context) []types.FeatureState {
+	features := sdk.RegisteredFeatures()
+	states := make([]types.FeatureState, 0, len(features))
+	for _, f := range features {
+		state := types.FeatureState{
+			Name:        f.Name,
+			Module:      f.Module,
+			Upgrade:     f.Upgrade,
+			Description: f.Description,
+			Enabled:     k.IsUpgraded(ctx, f.Upgrade),
+		}
+
+		if height := k.GetDoneHeight(ctx, f.Upgrade); height != 0 {
+			state.ActivationHeight = height
+		} else if plan, ok := k.upgradeConfig.GetPlanByName(f.Upgrade); ok {
+			state.ActivationHeight = plan.Height
+		}
+
+		states = append(states, state)
+	}
+
+	return states
+}
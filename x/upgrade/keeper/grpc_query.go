@@ -71,3 +71,32 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 		ModuleVersions: mv,
 	}, nil
 }
+
+// Readiness implements the Query/Readiness gRPC method
+func (k Keeper) Readiness(c context.Context, req *types.QueryReadinessRequest) (*types.QueryReadinessResponse, error) {
+	if req == nil || len(req.PlanName) == 0 {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "plan name cannot be empty")
+	}
+	if len(req.PlanName) > types.MaxPlanNameLength {
+		return nil, errors.Wrapf(errors.ErrInvalidRequest, "plan name cannot be longer than %d characters", types.MaxPlanNameLength)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signals, readyPower, totalPower := k.GetReadiness(ctx, req.PlanName)
+	return &types.QueryReadinessResponse{
+		Signals:    signals,
+		ReadyPower: readyPower,
+		TotalPower: totalPower,
+		ReadyRatio: readyRatio(readyPower, totalPower),
+	}, nil
+}
+
+// Features implements the Query/Features gRPC method
+func (k Keeper) Features(c context.Context, req *types.QueryFeaturesRequest) (*types.QueryFeaturesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeaturesResponse{
+		Features: k.GetFeatureStates(ctx),
+	}, nil
+}
@@ -32,6 +32,9 @@ type Keeper struct {
 	upgradeConfig      *types.UpgradeConfig                // upgrade config for upcoming upgrade plan or upgraded plan
 	versionSetter      xp.ProtocolVersionSetter            // implements setting the protocol version field on BaseApp
 	initVersionMap     module.VersionMap
+	stakingKeeper      types.StakingKeeper // used to weigh readiness signals by voting power
+	readinessThreshold sdk.Dec             // minimal ready voting power ratio before an upgrade, nil disables the check
+	doneCache          *doneCache          // done heights of applied upgrades per block height
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -49,6 +52,7 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.BinaryCodec, homePath str
 		upgradeInitializer: make(map[string]types.UpgradeInitializer),
 		upgradeConfig:      types.NewUpgradeConfig(),
 		versionSetter:      vs,
+		doneCache:          newDoneCache(),
 	}
 }
 
@@ -314,6 +318,7 @@ func (k Keeper) GetUpgradePlan(ctx sdk.Context) ([]*types.Plan, bool) {
 func (k Keeper) setDone(ctx sdk.Context, name string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(encodeDoneKey(name, ctx.BlockHeight()), []byte{1})
+	k.doneCache.invalidate(ctx.BlockHeight())
 }
 
 // HasHandler returns true iff there is a handler registered for this name
@@ -422,9 +427,15 @@ func (k Keeper) ReadUpgradeInfoFromDisk() (types.Plan, error) {
 	return upgradeInfo, nil
 }
 
-// IsUpgraded returns the bool which the given upgrade was executed
+// IsUpgraded returns the bool which the given upgrade was executed.
+// The done heights are cached once per block height by CacheDoneHeights, so that
+// repeated checks, e.g. of features, are answered from memory. The contexts of the
+// block heights which are not cached read the store.
 func (k Keeper) IsUpgraded(ctx sdk.Context, name string) bool {
-	height := k.GetDoneHeight(ctx, name)
+	height, ok := k.doneCache.doneHeight(ctx.BlockHeight(), name)
+	if !ok {
+		height = k.getDoneHeights(ctx)[name]
+	}
 	if height == 0 {
 		return false
 	}
@@ -432,6 +443,26 @@ func (k Keeper) IsUpgraded(ctx sdk.Context, name string) bool {
 	return height <= ctx.BlockHeight()
 }
 
+// CacheDoneHeights caches the done heights of the applied upgrades at the block height of the context, for
+// IsUpgraded. It must only be called with the deliver state once the upgrades of the block are applied, which the
+// BeginBlocker of the module does, since the state of the context is the one committed at that height.
+func (k Keeper) CacheDoneHeights(ctx sdk.Context) {
+	k.doneCache.store(ctx.BlockHeight(), k.getDoneHeights(ctx))
+}
+
+// getDoneHeights returns the done heights of all applied upgrades by name
+func (k Keeper) getDoneHeights(ctx sdk.Context) map[string]int64 {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStoreWithZeroRead(k.storeKey), []byte{types.DoneByte})
+	defer iter.Close()
+
+	heights := make(map[string]int64)
+	for ; iter.Valid(); iter.Next() {
+		upgradeName, height := parseDoneKey(iter.Key())
+		heights[upgradeName] = height
+	}
+	return heights
+}
+
 // InitUpgraded execute the upgrade initializer that the upgrade is already applied.
 func (k Keeper) InitUpgraded(ctx sdk.Context) error {
 	iter := storetypes.KVStorePrefixIterator(ctx.KVStoreWithZeroRead(k.storeKey), []byte{types.DoneByte})
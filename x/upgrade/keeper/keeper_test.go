@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -278,3 +279,104 @@ func (s *KeeperTestSuite) TestLastCompletedUpgradeOrdering() {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func (s *KeeperTestSuite) TestIsUpgradedCached() {
+	keeper := s.upgradeKeeper
+	require := s.Require()
+
+	require.False(keeper.IsUpgraded(s.ctx, "test"))
+
+	keeper.SetUpgradeHandler("test", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+
+	// an upgrade applied on a branch which is discarded is never cached
+	branchCtx, _ := s.ctx.CacheContext()
+	keeper.ApplyUpgrade(branchCtx, types.Plan{Name: "test", Height: s.ctx.BlockHeight()})
+	require.True(keeper.IsUpgraded(branchCtx, "test"))
+	require.False(keeper.IsUpgraded(s.ctx, "test"))
+	keeper.CacheDoneHeights(s.ctx)
+	require.False(keeper.IsUpgraded(s.ctx, "test"))
+
+	keeper.ApplyUpgrade(s.ctx, types.Plan{Name: "test", Height: s.ctx.BlockHeight()})
+
+	// the upgrade applied in this block is visible in the same block, once cached too
+	require.True(keeper.IsUpgraded(s.ctx, "test"))
+	keeper.CacheDoneHeights(s.ctx)
+	require.True(keeper.IsUpgraded(s.ctx, "test"))
+	require.True(keeper.IsUpgraded(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), "test"))
+	require.False(keeper.IsUpgraded(s.ctx.WithBlockHeight(s.ctx.BlockHeight()-1), "test"))
+}
+
+func (s *KeeperTestSuite) TestGetFeatureStates() {
+	keeper := s.upgradeKeeper
+	require := s.Require()
+
+	f := sdk.RegisterFeature("test", s.T().Name(), "test-feature-upgrade", "a test feature")
+	s.T().Cleanup(func() { sdk.UnregisterFeature(f.Name) })
+	require.NoError(keeper.RegisterUpgradePlan("test-chain", nil))
+	require.NoError(keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: f.Upgrade, Height: s.ctx.BlockHeight() + 5}))
+
+	findState := func() types.FeatureState {
+		res, err := keeper.Features(s.ctx, &types.QueryFeaturesRequest{})
+		require.NoError(err)
+		for _, state := range res.Features {
+			if state.Name == f.Name {
+				return state
+			}
+		}
+		require.FailNow("feature not found")
+		return types.FeatureState{}
+	}
+
+	state := findState()
+	require.False(state.Enabled)
+	require.Equal(s.ctx.BlockHeight()+5, state.ActivationHeight)
+
+	keeper.SetUpgradeHandler(f.Upgrade, func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 5)
+	keeper.ApplyUpgrade(s.ctx, types.Plan{Name: f.Upgrade, Height: s.ctx.BlockHeight()})
+
+	state = findState()
+	require.True(state.Enabled)
+	require.Equal(s.ctx.BlockHeight(), state.ActivationHeight)
+}
+
+func (s *KeeperTestSuite) TestDryRunUpgrade() {
+	keeper := s.upgradeKeeper
+	require := s.Require()
+
+	keeper.SetModuleVersionMap(s.ctx, module.VersionMap{"bank": 1})
+	keeper.SetUpgradeHandler("dry-run", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		vm["bank"]++
+		return vm, nil
+	})
+	keeper.SetUpgradeHandler("dry-run-error", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+	keeper.SetUpgradeHandler("dry-run-panic", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		panic("migration panicked")
+	})
+
+	ctx, _ := s.ctx.CacheContext()
+	fromVM, toVM, err := keeper.DryRunUpgrade(ctx, "dry-run")
+	require.NoError(err)
+	require.Equal(uint64(1), fromVM["bank"])
+	require.Equal(uint64(2), toVM["bank"])
+	require.True(keeper.IsUpgraded(ctx, "dry-run"))
+
+	_, _, err = keeper.DryRunUpgrade(ctx, "dry-run")
+	require.ErrorIs(err, types.ErrUpgradeCompleted)
+
+	_, _, err = keeper.DryRunUpgrade(s.ctx, "dry-run-missing")
+	require.ErrorIs(err, types.ErrUpgradeFailed)
+
+	_, _, err = keeper.DryRunUpgrade(s.ctx, "dry-run-error")
+	require.ErrorIs(err, types.ErrUpgradeFailed)
+
+	_, _, err = keeper.DryRunUpgrade(s.ctx, "dry-run-panic")
+	require.ErrorIs(err, types.ErrUpgradeFailed)
+	require.Contains(err.Error(), "migration panicked")
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the upgrade MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// SoftwareUpgrade implements the Msg/SoftwareUpgrade method. The upgrade plans are registered from the upgrade
+// config of the app at start, which the msgs executed on chain can't change, so the msg is always rejected.
+func (k msgServer) SoftwareUpgrade(_ context.Context, _ *types.MsgSoftwareUpgrade) (*types.MsgSoftwareUpgradeResponse, error) {
+	return nil, sdkerrors.ErrInvalidRequest.Wrap("upgrades are scheduled by the upgrade config of the app, not by governance")
+}
+
+// CancelUpgrade implements the Msg/CancelUpgrade method, which is always rejected as SoftwareUpgrade is.
+func (k msgServer) CancelUpgrade(_ context.Context, _ *types.MsgCancelUpgrade) (*types.MsgCancelUpgradeResponse, error) {
+	return nil, sdkerrors.ErrInvalidRequest.Wrap("upgrades are cancelled by the upgrade config of the app, not by governance")
+}
+
+// SignalReadiness implements the Msg/SignalReadiness method.
+func (k msgServer) SignalReadiness(goCtx context.Context, msg *types.MsgSignalReadiness) (*types.MsgSignalReadinessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.stakingKeeper == nil {
+		return nil, types.ErrNotValidator.Wrap("readiness signalling is not enabled")
+	}
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return nil, types.ErrNotValidator.Wrapf("%s", msg.ValidatorAddress)
+	}
+
+	if k.GetDoneHeight(ctx, msg.PlanName) != 0 {
+		return nil, types.ErrUpgradeCompleted.Wrapf("%s", msg.PlanName)
+	}
+	if _, found := k.upgradeConfig.GetPlanByName(msg.PlanName); !found {
+		return nil, types.ErrUnknownPlan.Wrapf("%s", msg.PlanName)
+	}
+
+	k.SetReadinessSignal(ctx, types.ReadinessSignal{
+		ValidatorAddress: msg.ValidatorAddress,
+		PlanName:         msg.PlanName,
+		BinaryVersion:    msg.BinaryVersion,
+		Height:           ctx.BlockHeight(),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSignalReadiness{
+		ValidatorAddress: msg.ValidatorAddress,
+		PlanName:         msg.PlanName,
+		BinaryVersion:    msg.BinaryVersion,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignalReadinessResponse{}, nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestGovernanceUpgradeMsgs() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	softwareUpgrade := &types.MsgSoftwareUpgrade{Authority: authority, Plan: types.Plan{Name: "Savanna", Height: 100}}
+	cancelUpgrade := &types.MsgCancelUpgrade{Authority: authority}
+
+	// the msgs offered by the proposal prompt resolve to routable msgs
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	for _, msg := range []sdk.Msg{softwareUpgrade, cancelUpgrade} {
+		s.Require().NoError(msg.ValidateBasic())
+		resolved, err := registry.Resolve(sdk.MsgTypeURL(msg))
+		s.Require().NoError(err)
+		s.Require().IsType(msg, resolved)
+	}
+
+	// but the plans are only scheduled by the upgrade config
+	msgServer := keeper.NewMsgServerImpl(s.upgradeKeeper)
+	_, err := msgServer.SoftwareUpgrade(s.ctx, softwareUpgrade)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = msgServer.CancelUpgrade(s.ctx, cancelUpgrade)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, found := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().False(found)
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// SetStakingKeeper sets the staking keeper used to weigh readiness signals by voting power.
+func (k *Keeper) SetStakingKeeper(sk types.StakingKeeper) {
+	k.stakingKeeper = sk
+}
+
+// SetReadinessThreshold sets the minimal ratio of voting power that must have signalled
+// readiness for a plan before it is applied. When the ratio is below the threshold at the
+// block before the plan height, BeginBlocker halts the node. A nil threshold disables the check.
+func (k *Keeper) SetReadinessThreshold(threshold sdk.Dec) error {
+	if !threshold.IsNil() && (threshold.IsNegative() || threshold.GT(sdk.OneDec())) {
+		return fmt.Errorf("readiness threshold must be between 0 and 1, got %s", threshold)
+	}
+	k.readinessThreshold = threshold
+	return nil
+}
+
+// GetReadinessThreshold returns the readiness threshold, which is nil if the check is disabled.
+func (k Keeper) GetReadinessThreshold() sdk.Dec {
+	return k.readinessThreshold
+}
+
+// SetReadinessSignal stores the readiness signal of a validator for a plan, replacing any previous one.
+func (k Keeper) SetReadinessSignal(ctx sdk.Context, signal types.ReadinessSignal) {
+	valAddr := sdk.MustAccAddressFromHex(signal.ValidatorAddress)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReadinessKey(signal.PlanName, valAddr), k.cdc.MustMarshal(&signal))
+}
+
+// GetReadinessSignal returns the readiness signal of a validator for a plan, if any.
+func (k Keeper) GetReadinessSignal(ctx sdk.Context, planName string, valAddr sdk.AccAddress) (types.ReadinessSignal, bool) {
+	var signal types.ReadinessSignal
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReadinessKey(planName, valAddr))
+	if bz == nil {
+		return signal, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &signal)
+	return signal, true
+}
+
+// IterateReadinessSignals iterates over the readiness signals of a plan.
+func (k Keeper) IterateReadinessSignals(ctx sdk.Context, planName string, cb func(signal types.ReadinessSignal) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadinessPrefix(planName))
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var signal types.ReadinessSignal
+		k.cdc.MustUnmarshal(it.Value(), &signal)
+		if cb(signal) {
+			break
+		}
+	}
+}
+
+// ClearReadinessSignals deletes all readiness signals of a plan.
+func (k Keeper) ClearReadinessSignals(ctx sdk.Context, planName string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadinessPrefix(planName))
+	it := store.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetReadiness aggregates the readiness signals of a plan by the voting power of the
+// last bonded validator set. Signals of validators without voting power are reported
+// with zero power and do not count towards the ready power.
+func (k Keeper) GetReadiness(ctx sdk.Context, planName string) (signals []types.ValidatorReadiness, readyPower, totalPower int64) {
+	if k.stakingKeeper == nil {
+		return nil, 0, 0
+	}
+
+	totalPower = k.stakingKeeper.GetLastTotalPower(ctx).Int64()
+	k.IterateReadinessSignals(ctx, planName, func(signal types.ReadinessSignal) bool {
+		power := k.stakingKeeper.GetLastValidatorPower(ctx, sdk.MustAccAddressFromHex(signal.ValidatorAddress))
+		readyPower += power
+		signals = append(signals, types.ValidatorReadiness{Signal: signal, Power: power})
+		return false
+	})
+
+	return signals, readyPower, totalPower
+}
+
+// GetReadyRatio returns the ratio of voting power that signalled readiness for a plan.
+func (k Keeper) GetReadyRatio(ctx sdk.Context, planName string) sdk.Dec {
+	_, readyPower, totalPower := k.GetReadiness(ctx, planName)
+	return readyRatio(readyPower, totalPower)
+}
+
+func readyRatio(readyPower, totalPower int64) sdk.Dec {
+	if totalPower == 0 {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDec(readyPower).QuoInt64(totalPower)
+}
+
+// CheckReadiness returns an error if the readiness threshold is set and the ratio of voting
+// power that signalled readiness for the plan is below it.
+func (k Keeper) CheckReadiness(ctx sdk.Context, plan types.Plan) error {
+	if k.readinessThreshold.IsNil() || k.stakingKeeper == nil {
+		return nil
+	}
+
+	ratio := k.GetReadyRatio(ctx, plan.Name)
+	if ratio.LT(k.readinessThreshold) {
+		return types.ErrInsufficientReadiness.Wrapf(
+			"upgrade %q at %s: %s of the voting power signalled readiness, below the required threshold %s",
+			plan.Name, plan.DueAt(), ratio, k.readinessThreshold,
+		)
+	}
+
+	return nil
+}
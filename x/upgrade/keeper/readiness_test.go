@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+type mockStakingKeeper struct {
+	powers map[string]int64
+}
+
+func (m mockStakingKeeper) GetValidator(_ sdk.Context, addr sdk.AccAddress) (stakingtypes.Validator, bool) {
+	_, found := m.powers[addr.String()]
+	return stakingtypes.Validator{OperatorAddress: addr.String()}, found
+}
+
+func (m mockStakingKeeper) GetLastValidatorPower(_ sdk.Context, operator sdk.AccAddress) int64 {
+	return m.powers[operator.String()]
+}
+
+func (m mockStakingKeeper) GetLastTotalPower(_ sdk.Context) math.Int {
+	total := int64(0)
+	for _, power := range m.powers {
+		total += power
+	}
+	return math.NewInt(total)
+}
+
+func (s *KeeperTestSuite) TestSignalReadiness() {
+	vals := []sdk.AccAddress{sdk.AccAddress("val1________________"), sdk.AccAddress("val2________________")}
+	s.upgradeKeeper.SetStakingKeeper(mockStakingKeeper{powers: map[string]int64{
+		vals[0].String(): 30,
+		vals[1].String(): 70,
+	}})
+	msgServer := keeper.NewMsgServerImpl(s.upgradeKeeper)
+	plan := types.Plan{Name: "Savanna", Height: s.ctx.BlockHeight() + 1}
+
+	// a plan which is not scheduled cannot be signalled
+	_, err := msgServer.SignalReadiness(s.ctx, types.NewMsgSignalReadiness(vals[0], plan.Name, "v1.0.0"))
+	s.Require().ErrorIs(err, types.ErrUnknownPlan)
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+
+	// a non validator cannot signal
+	_, err = msgServer.SignalReadiness(s.ctx, types.NewMsgSignalReadiness(s.addrs[0], plan.Name, "v1.0.0"))
+	s.Require().ErrorIs(err, types.ErrNotValidator)
+
+	_, err = msgServer.SignalReadiness(s.ctx, types.NewMsgSignalReadiness(vals[0], plan.Name, "v1.0.0"))
+	s.Require().NoError(err)
+
+	signals, readyPower, totalPower := s.upgradeKeeper.GetReadiness(s.ctx, plan.Name)
+	s.Require().Len(signals, 1)
+	s.Require().Equal("v1.0.0", signals[0].Signal.BinaryVersion)
+	s.Require().Equal(int64(30), readyPower)
+	s.Require().Equal(int64(100), totalPower)
+
+	// the readiness check is disabled without a threshold
+	s.Require().NoError(s.upgradeKeeper.CheckReadiness(s.ctx, plan))
+
+	s.Require().Error(s.upgradeKeeper.SetReadinessThreshold(sdk.NewDecWithPrec(11, 1)))
+	s.Require().NoError(s.upgradeKeeper.SetReadinessThreshold(sdk.NewDecWithPrec(67, 2)))
+	s.Require().ErrorIs(s.upgradeKeeper.CheckReadiness(s.ctx, plan), types.ErrInsufficientReadiness)
+
+	// signalling again replaces the previous signal
+	_, err = msgServer.SignalReadiness(s.ctx, types.NewMsgSignalReadiness(vals[1], plan.Name, "v1.0.0"))
+	s.Require().NoError(err)
+	_, err = msgServer.SignalReadiness(s.ctx, types.NewMsgSignalReadiness(vals[1], plan.Name, "v1.0.1"))
+	s.Require().NoError(err)
+
+	res, err := s.upgradeKeeper.Readiness(s.ctx, &types.QueryReadinessRequest{PlanName: plan.Name})
+	s.Require().NoError(err)
+	s.Require().Len(res.Signals, 2)
+	s.Require().Equal(int64(100), res.ReadyPower)
+	s.Require().Equal(sdk.OneDec(), res.ReadyRatio)
+	s.Require().NoError(s.upgradeKeeper.CheckReadiness(s.ctx, plan))
+
+	// the plan names too long to be length prefixed in the readiness keys are rejected
+	_, err = s.upgradeKeeper.Readiness(s.ctx, &types.QueryReadinessRequest{PlanName: strings.Repeat("a", types.MaxPlanNameLength+1)})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	res, err = s.upgradeKeeper.Readiness(s.ctx, &types.QueryReadinessRequest{PlanName: strings.Repeat("a", types.MaxPlanNameLength)})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Signals)
+
+	s.upgradeKeeper.ClearReadinessSignals(s.ctx, plan.Name)
+	signals, _, _ = s.upgradeKeeper.GetReadiness(s.ctx, plan.Name)
+	s.Require().Empty(signals)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"fmt"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RetentionConstraint pins the last height before the last completed upgrade, which holds the state before its
+// migrations, until another upgrade is completed. It is meant to be registered with
+// BaseApp.AddRetentionConstraint.
+func (k Keeper) RetentionConstraint(ctx sdk.Context) []pruningtypes.PinnedHeights {
+	name, height := k.GetLastCompletedUpgrade(ctx)
+	if height <= 1 {
+		return nil
+	}
+
+	return []pruningtypes.PinnedHeights{{
+		Start:  height - 1,
+		End:    height - 1,
+		Reason: fmt.Sprintf("upgrade: state before the %s upgrade", name),
+	}}
+}
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (s *KeeperTestSuite) TestRetentionConstraint() {
+	keeper := s.upgradeKeeper
+	require := s.Require()
+
+	// nothing is pinned before an upgrade is completed
+	require.Empty(keeper.RetentionConstraint(s.ctx))
+
+	for _, name := range []string{"test0", "test1"} {
+		keeper.SetUpgradeHandler(name, func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			return vm, nil
+		})
+	}
+
+	keeper.ApplyUpgrade(s.ctx.WithBlockHeight(10), types.Plan{Name: "test0", Height: 10})
+	require.Equal([]pruningtypes.PinnedHeights{{Start: 9, End: 9, Reason: "upgrade: state before the test0 upgrade"}},
+		keeper.RetentionConstraint(s.ctx.WithBlockHeight(12)))
+
+	// the state before the last upgrade only is pinned
+	keeper.ApplyUpgrade(s.ctx.WithBlockHeight(15), types.Plan{Name: "test1", Height: 15})
+	require.Equal([]pruningtypes.PinnedHeights{{Start: 14, End: 14, Reason: "upgrade: state before the test1 upgrade"}},
+		keeper.RetentionConstraint(s.ctx.WithBlockHeight(20)))
+}
@@ -69,7 +69,7 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 
 // GetTxCmd returns the CLI transaction commands for this module
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // RegisterInterfaces registers interfaces and implementations of the upgrade module.
@@ -104,6 +104,7 @@ func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
 	m := keeper.NewMigrator(am.keeper)
@@ -174,7 +175,8 @@ type UpgradeInputs struct {
 	Key    *store.KVStoreKey
 	Cdc    codec.Codec
 
-	AppOpts servertypes.AppOptions `optional:"true"`
+	AppOpts       servertypes.AppOptions `optional:"true"`
+	StakingKeeper types.StakingKeeper    `optional:"true"`
 }
 
 type UpgradeOutputs struct {
@@ -194,6 +196,9 @@ func ProvideModule(in UpgradeInputs) UpgradeOutputs {
 
 	// set the governance module account as the authority for conducting upgrades
 	k := keeper.NewKeeper(in.Key, in.Cdc, homePath, nil)
+	if in.StakingKeeper != nil {
+		k.SetStakingKeeper(in.StakingKeeper)
+	}
 
 	baseappOpt := func(app *baseapp.BaseApp) {
 		k.SetVersionSetter(app)
@@ -10,4 +10,12 @@ var (
 	ErrUpgradeScheduled = errors.Register(ModuleName, 2, "upgrade cannot be scheduled in the past")
 	// ErrUpgradeCompleted error if the upgrade has already been completed
 	ErrUpgradeCompleted = errors.Register(ModuleName, 3, "upgrade has already been completed")
+	// ErrNotValidator error if the signer of a readiness signal is not a validator
+	ErrNotValidator = errors.Register(ModuleName, 4, "signer is not a validator")
+	// ErrInsufficientReadiness error if not enough voting power signalled readiness for an upgrade
+	ErrInsufficientReadiness = errors.Register(ModuleName, 5, "insufficient upgrade readiness")
+	// ErrUpgradeFailed error if an upgrade handler failed or panicked
+	ErrUpgradeFailed = errors.Register(ModuleName, 6, "upgrade failed")
+	// ErrUnknownPlan error if a readiness signal is for a plan which is not scheduled
+	ErrUnknownPlan = errors.Register(ModuleName, 7, "upgrade plan is not scheduled")
 )
@@ -0,0 +1,15 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the expected staking keeper used to weigh readiness signals by voting power
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.AccAddress) (validator stakingtypes.Validator, found bool)
+	GetLastValidatorPower(ctx sdk.Context, operator sdk.AccAddress) (power int64)
+	GetLastTotalPower(ctx sdk.Context) math.Int
+}
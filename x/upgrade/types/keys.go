@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -17,6 +22,9 @@ const (
 	// VersionMapByte is a prefix to look up module names (key) and versions (value)
 	VersionMapByte = 0x2
 
+	// ReadinessByte is a prefix to look up validator readiness signals by plan name and validator
+	ReadinessByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -34,3 +42,13 @@ const (
 func UpgradedConsStateKey(height int64) []byte {
 	return []byte(fmt.Sprintf("%s/%d/%s", KeyUpgradedIBCState, height, KeyUpgradedConsState))
 }
+
+// ReadinessPrefix returns the prefix under which the readiness signals of a plan are stored.
+func ReadinessPrefix(planName string) []byte {
+	return append([]byte{ReadinessByte}, address.MustLengthPrefix([]byte(planName))...)
+}
+
+// ReadinessKey returns the key under which the readiness signal of a validator for a plan is stored.
+func ReadinessKey(planName string, valAddr sdk.AccAddress) []byte {
+	return append(ReadinessPrefix(planName), address.MustLengthPrefix(valAddr)...)
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxBinaryVersionLength is the maximum length of the binary version carried by a readiness signal
+const MaxBinaryVersionLength = 128
+
+// MaxPlanNameLength is the maximum length of the plan name of a readiness signal, which is length prefixed in
+// the readiness keys
+const MaxPlanNameLength = 255
+
+var _ sdk.Msg = &MsgSignalReadiness{}
+
+var _, _ sdk.Msg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSoftwareUpgrade) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSoftwareUpgrade) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSoftwareUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSoftwareUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := m.Plan.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "plan")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSoftwareUpgrade.
+func (m MsgSoftwareUpgrade) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHexUnsafe(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCancelUpgrade) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCancelUpgrade) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgCancelUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCancelUpgrade.
+func (m MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHexUnsafe(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSignalReadiness creates a new MsgSignalReadiness instance
+func NewMsgSignalReadiness(valAddr sdk.AccAddress, planName, binaryVersion string) *MsgSignalReadiness {
+	return &MsgSignalReadiness{
+		ValidatorAddress: valAddr.String(),
+		PlanName:         planName,
+		BinaryVersion:    binaryVersion,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgSignalReadiness) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgSignalReadiness) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSignalReadiness) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSignalReadiness.
+func (msg MsgSignalReadiness) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress)
+	return []sdk.AccAddress{valAddr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg MsgSignalReadiness) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if len(msg.PlanName) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("plan name cannot be empty")
+	}
+
+	if len(msg.PlanName) > MaxPlanNameLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("plan name cannot be longer than %d characters", MaxPlanNameLength)
+	}
+
+	if len(msg.BinaryVersion) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("binary version cannot be empty")
+	}
+
+	if len(msg.BinaryVersion) > MaxBinaryVersionLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("binary version cannot be longer than %d characters", MaxBinaryVersionLength)
+	}
+
+	return nil
+}
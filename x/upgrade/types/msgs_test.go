@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestMsgSignalReadinessValidateBasic(t *testing.T) {
+	val := sdk.AccAddress("val1________________")
+	testCases := map[string]struct {
+		msg   *types.MsgSignalReadiness
+		valid bool
+	}{
+		"valid":                   {msg: types.NewMsgSignalReadiness(val, "Savanna", "v1.0.0"), valid: true},
+		"invalid address":         {msg: &types.MsgSignalReadiness{ValidatorAddress: "val", PlanName: "Savanna", BinaryVersion: "v1.0.0"}},
+		"empty plan name":         {msg: types.NewMsgSignalReadiness(val, "", "v1.0.0")},
+		"longest plan name":       {msg: types.NewMsgSignalReadiness(val, strings.Repeat("a", types.MaxPlanNameLength), "v1.0.0"), valid: true},
+		"plan name too long":      {msg: types.NewMsgSignalReadiness(val, strings.Repeat("a", types.MaxPlanNameLength+1), "v1.0.0")},
+		"empty binary version":    {msg: types.NewMsgSignalReadiness(val, "Savanna", "")},
+		"binary version too long": {msg: types.NewMsgSignalReadiness(val, "Savanna", strings.Repeat("v", types.MaxBinaryVersionLength+1))},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -214,43 +214,153 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgSignalReadiness is the Msg/SignalReadiness request type.
+type MsgSignalReadiness struct {
+	// validator_address is the operator address of the signalling validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// plan_name is the name of the upgrade plan the validator is ready for.
+	PlanName string `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// binary_version is the version of the binary the validator runs.
+	BinaryVersion string `protobuf:"bytes,3,opt,name=binary_version,json=binaryVersion,proto3" json:"binary_version,omitempty"`
+}
+
+func (m *MsgSignalReadiness) Reset()         { *m = MsgSignalReadiness{} }
+func (m *MsgSignalReadiness) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalReadiness) ProtoMessage()    {}
+func (*MsgSignalReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgSignalReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalReadiness.Merge(m, src)
+}
+func (m *MsgSignalReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalReadiness proto.InternalMessageInfo
+
+func (m *MsgSignalReadiness) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSignalReadiness) GetPlanName() string {
+	if m != nil {
+		return m.PlanName
+	}
+	return ""
+}
+
+func (m *MsgSignalReadiness) GetBinaryVersion() string {
+	if m != nil {
+		return m.BinaryVersion
+	}
+	return ""
+}
+
+// MsgSignalReadinessResponse is the Msg/SignalReadiness response type.
+type MsgSignalReadinessResponse struct {
+}
+
+func (m *MsgSignalReadinessResponse) Reset()         { *m = MsgSignalReadinessResponse{} }
+func (m *MsgSignalReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalReadinessResponse) ProtoMessage()    {}
+func (*MsgSignalReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgSignalReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalReadinessResponse.Merge(m, src)
+}
+func (m *MsgSignalReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalReadinessResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgSignalReadiness)(nil), "cosmos.upgrade.v1beta1.MsgSignalReadiness")
+	proto.RegisterType((*MsgSignalReadinessResponse)(nil), "cosmos.upgrade.v1beta1.MsgSignalReadinessResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50,
-	0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0xda, 0xcd, 0xc8, 0x25,
-	0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x9f, 0x56, 0x52, 0x9e, 0x58, 0x94, 0x1a, 0x0a, 0x31, 0x48, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x6b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b,
-	0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0x58, 0x0a, 0x72,
-	0x12, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x7b, 0x5c, 0x2f, 0x20,
-	0x27, 0x31, 0xcf, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x81,
-	0x35, 0x59, 0x19, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xac, 0xeb, 0xf9, 0x06, 0x2d, 0x59, 0x88,
-	0x01, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x98, 0xce, 0x54, 0x92, 0xe1, 0x92, 0xc2, 0x14, 0x0d, 0x4a,
-	0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x55, 0xaa, 0xe2, 0x12, 0xf0, 0x2d, 0x4e, 0x77, 0x4e, 0xcc,
-	0x4b, 0x4e, 0xcd, 0xa1, 0xd0, 0x63, 0x56, 0x7a, 0x98, 0x6e, 0x93, 0x46, 0x75, 0x1b, 0x8a, 0x3d,
-	0x4a, 0x52, 0x5c, 0x12, 0xe8, 0x62, 0x30, 0x77, 0x19, 0xbd, 0x61, 0xe4, 0x62, 0xf6, 0x2d, 0x4e,
-	0x17, 0x2a, 0xe4, 0xe2, 0x47, 0x0f, 0x77, 0x2d, 0x5c, 0x21, 0x86, 0xe9, 0x4d, 0x29, 0x23, 0xe2,
-	0xd5, 0xc2, 0xac, 0x16, 0xca, 0xe6, 0xe2, 0x45, 0x0d, 0x0f, 0x0d, 0x3c, 0x86, 0xa0, 0xa8, 0x94,
-	0x32, 0x20, 0x56, 0x25, 0xcc, 0x32, 0x29, 0xd6, 0x06, 0x50, 0xe4, 0x3a, 0xb9, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0x2e, 0x34, 0x9d, 0xeb, 0x23, 0x85, 0x69, 0x05, 0x3c, 0x99, 0x97, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0x53, 0xac, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x07, 0x64, 0xf8,
-	0x6f, 0x03, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x86, 0x9b, 0x0d, 0x10, 0x31, 0x1a, 0x6c, 0xd1, 0x04, 0x21, 0x1b, 0xd9, 0x54, 0x81, 0x34,
+	0x55, 0x2c, 0x59, 0x8b, 0x84, 0x50, 0x39, 0x51, 0x04, 0xb7, 0x22, 0x94, 0x09, 0x0e, 0x5c, 0x2a,
+	0xb7, 0x31, 0x9e, 0xb5, 0xc4, 0x0e, 0xb6, 0x5b, 0x56, 0x4e, 0x88, 0x23, 0x27, 0x7e, 0x06, 0xc7,
+	0x1e, 0xb8, 0xf1, 0x07, 0x76, 0x9c, 0x38, 0xc1, 0x05, 0x50, 0x7b, 0xe8, 0xdf, 0x40, 0x89, 0xdd,
+	0x8e, 0x34, 0x5d, 0x99, 0xc4, 0xa5, 0xad, 0x5e, 0x3f, 0xfe, 0xbe, 0xf7, 0xf5, 0xe7, 0x1a, 0x6c,
+	0x75, 0x98, 0x88, 0x99, 0xf0, 0xbb, 0x09, 0xe6, 0x30, 0x44, 0x7e, 0xaf, 0xda, 0x46, 0x12, 0x56,
+	0x7d, 0x79, 0xe4, 0x25, 0x9c, 0x49, 0x66, 0x5d, 0x57, 0x80, 0xa7, 0x01, 0x4f, 0x03, 0xce, 0x3a,
+	0x66, 0x98, 0x65, 0x88, 0x9f, 0xfe, 0x52, 0xb4, 0x73, 0x53, 0xd1, 0x2d, 0xb5, 0xa0, 0xb7, 0xaa,
+	0xa5, 0xdb, 0x67, 0x74, 0x9a, 0x14, 0x56, 0xd4, 0x0d, 0x4d, 0xc5, 0x02, 0xfb, 0xbd, 0x6a, 0xfa,
+	0xa5, 0x17, 0xd6, 0x60, 0x4c, 0x28, 0xf3, 0xb3, 0x4f, 0x25, 0x95, 0xbf, 0x1a, 0xc0, 0x6a, 0x0a,
+	0xbc, 0xcf, 0x5e, 0xcb, 0xb7, 0x90, 0xa3, 0x17, 0xaa, 0x90, 0x75, 0x1f, 0x98, 0xb0, 0x2b, 0x0f,
+	0x18, 0x27, 0xb2, 0x6f, 0x1b, 0xdb, 0xc6, 0x8e, 0xd9, 0xb0, 0xbf, 0x7d, 0xd9, 0x5d, 0xd7, 0x6e,
+	0x1e, 0x85, 0x21, 0x47, 0x42, 0xec, 0x4b, 0x4e, 0x28, 0x0e, 0x4e, 0x51, 0xeb, 0x21, 0xb8, 0x90,
+	0x44, 0x90, 0xda, 0x4b, 0xdb, 0xc6, 0xce, 0x95, 0xda, 0xa6, 0x37, 0x3f, 0xb8, 0xf7, 0x3c, 0x82,
+	0xb4, 0x61, 0x1e, 0xff, 0xdc, 0x2a, 0x7d, 0x1e, 0x0f, 0x2a, 0x46, 0x90, 0x6d, 0xaa, 0xef, 0x7d,
+	0x18, 0x0f, 0x2a, 0xa7, 0xc5, 0x3e, 0x8e, 0x07, 0x95, 0x5b, 0xaa, 0xc0, 0xae, 0x08, 0x0f, 0xfd,
+	0xa2, 0xcd, 0xf2, 0x26, 0x70, 0x8a, 0x6a, 0x80, 0x44, 0xc2, 0xa8, 0x40, 0xe5, 0x77, 0x60, 0xb5,
+	0x29, 0xf0, 0x63, 0x48, 0x3b, 0x28, 0xfa, 0xcf, 0x60, 0x75, 0xaf, 0xe8, 0x6d, 0x23, 0xef, 0x2d,
+	0xd7, 0xa7, 0xec, 0x00, 0x7b, 0x56, 0x9b, 0xfa, 0xfa, 0xa1, 0xcf, 0x9c, 0x60, 0x0a, 0xa3, 0x00,
+	0xc1, 0x90, 0x50, 0x24, 0x84, 0xf5, 0x04, 0xac, 0xf5, 0x60, 0x44, 0x42, 0x28, 0x19, 0x6f, 0x41,
+	0x65, 0xe4, 0x9f, 0x16, 0x57, 0xa7, 0x5b, 0xb4, 0x6e, 0x6d, 0x00, 0x33, 0x3d, 0xcd, 0x16, 0x85,
+	0x31, 0xca, 0xe6, 0x60, 0x06, 0x97, 0x53, 0xe1, 0x19, 0x8c, 0x91, 0x75, 0x07, 0x5c, 0x6d, 0x13,
+	0x0a, 0x79, 0xbf, 0xd5, 0x43, 0x5c, 0x10, 0x46, 0xed, 0xe5, 0x8c, 0x58, 0x51, 0xea, 0x4b, 0x25,
+	0xd6, 0x1f, 0xa4, 0x69, 0x8b, 0x6e, 0xe6, 0x4d, 0x24, 0x1f, 0x62, 0x32, 0x91, 0xbc, 0x3a, 0x49,
+	0x5e, 0xfb, 0xb5, 0x04, 0x96, 0x9b, 0x02, 0x5b, 0x6f, 0xc0, 0xb5, 0xd9, 0x1b, 0x57, 0x39, 0xeb,
+	0xae, 0x14, 0x07, 0xec, 0xd4, 0xce, 0xcf, 0x4e, 0x5a, 0x5b, 0x87, 0x60, 0x25, 0x7f, 0x13, 0x76,
+	0x16, 0x14, 0xc9, 0x91, 0xce, 0xde, 0x79, 0xc9, 0x69, 0xb3, 0x34, 0xdf, 0xcc, 0x74, 0x17, 0xe6,
+	0xcb, 0xb3, 0x8b, 0xf3, 0xcd, 0x3f, 0x5a, 0xe7, 0xe2, 0xfb, 0xf4, 0x9f, 0xd4, 0x78, 0x7a, 0x3c,
+	0x74, 0x8d, 0x93, 0xa1, 0x6b, 0xfc, 0x1e, 0xba, 0xc6, 0xa7, 0x91, 0x5b, 0x3a, 0x19, 0xb9, 0xa5,
+	0xef, 0x23, 0xb7, 0xf4, 0xea, 0x2e, 0x26, 0xf2, 0xa0, 0xdb, 0xf6, 0x3a, 0x2c, 0xd6, 0x8f, 0x8a,
+	0xff, 0xd7, 0x28, 0x8f, 0xa6, 0x6f, 0x8a, 0xec, 0x27, 0x48, 0xb4, 0x2f, 0x65, 0xcf, 0xc3, 0xbd,
+	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xe2, 0x0b, 0xa4, 0xdc, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -274,6 +384,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// SignalReadiness defines a method for a validator to signal that its binary
+	// has the upgrade handler of a plan registered.
+	SignalReadiness(ctx context.Context, in *MsgSignalReadiness, opts ...grpc.CallOption) (*MsgSignalReadinessResponse, error)
 }
 
 type msgClient struct {
@@ -302,6 +415,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) SignalReadiness(ctx context.Context, in *MsgSignalReadiness, opts ...grpc.CallOption) (*MsgSignalReadinessResponse, error) {
+	out := new(MsgSignalReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/SignalReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -313,6 +435,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// SignalReadiness defines a method for a validator to signal that its binary
+	// has the upgrade handler of a plan registered.
+	SignalReadiness(context.Context, *MsgSignalReadiness) (*MsgSignalReadinessResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -325,6 +450,9 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) SignalReadiness(ctx context.Context, req *MsgSignalReadiness) (*MsgSignalReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalReadiness not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -366,6 +494,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignalReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalReadiness)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/SignalReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalReadiness(ctx, req.(*MsgSignalReadiness))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -378,6 +524,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "SignalReadiness",
+			Handler:    _Msg_SignalReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -499,6 +649,73 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSignalReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BinaryVersion) > 0 {
+		i -= len(m.BinaryVersion)
+		copy(dAtA[i:], m.BinaryVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BinaryVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -556,6 +773,36 @@ func (m *MsgCancelUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSignalReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BinaryVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignalReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -859,6 +1106,202 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSignalReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -117,9 +118,109 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// ReadinessSignal records that a validator runs a binary able to apply a
+// named upgrade plan.
+type ReadinessSignal struct {
+	// validator_address is the operator address of the signalling validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// plan_name is the name of the upgrade plan the validator is ready for.
+	PlanName string `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// binary_version is the version of the binary the validator runs.
+	BinaryVersion string `protobuf:"bytes,3,opt,name=binary_version,json=binaryVersion,proto3" json:"binary_version,omitempty"`
+	// height is the block height at which the signal was last updated.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ReadinessSignal) Reset()         { *m = ReadinessSignal{} }
+func (m *ReadinessSignal) String() string { return proto.CompactTextString(m) }
+func (*ReadinessSignal) ProtoMessage()    {}
+func (*ReadinessSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{2}
+}
+func (m *ReadinessSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadinessSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadinessSignal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadinessSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadinessSignal.Merge(m, src)
+}
+func (m *ReadinessSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadinessSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadinessSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadinessSignal proto.InternalMessageInfo
+
+// FeatureState describes a feature activated by an upgrade and its activation
+// state.
+type FeatureState struct {
+	// name is the unique name of the feature.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// module is the name of the module which declares the feature.
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// upgrade is the name of the upgrade plan which activates the feature.
+	Upgrade string `protobuf:"bytes,3,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// description describes the behavior change.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// activation_height is the height at which the upgrade was applied, or the
+	// scheduled height of the upgrade if it is not applied yet. It is 0 if the
+	// upgrade is not scheduled.
+	ActivationHeight int64 `protobuf:"varint,5,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// enabled is true if the upgrade has been applied.
+	Enabled bool `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *FeatureState) Reset()         { *m = FeatureState{} }
+func (m *FeatureState) String() string { return proto.CompactTextString(m) }
+func (*FeatureState) ProtoMessage()    {}
+func (*FeatureState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
+}
+func (m *FeatureState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeatureState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureState.Merge(m, src)
+}
+func (m *FeatureState) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureState) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureState proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*ReadinessSignal)(nil), "cosmos.upgrade.v1beta1.ReadinessSignal")
+	proto.RegisterType((*FeatureState)(nil), "cosmos.upgrade.v1beta1.FeatureState")
 }
 
 func init() {
@@ -127,24 +228,37 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0x84, 0xf1, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0x20, 0xaa, 0xf4, 0x60,
-	0xa2, 0x50, 0x55, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x25, 0xfa, 0x20, 0x16, 0x44, 0xb5,
-	0x94, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x08, 0x29, 0xa5, 0x70, 0xb1, 0x04,
-	0xe4, 0x24, 0xe6, 0x09, 0x09, 0x71, 0xb1, 0xe4, 0x25, 0xe6, 0xa6, 0x4a, 0x30, 0x2a, 0x30, 0x6a,
-	0x70, 0x06, 0x81, 0xd9, 0x42, 0x62, 0x5c, 0x6c, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0x4c,
-	0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x50, 0x1e, 0x48, 0x6d, 0x66, 0x5e, 0x5a, 0xbe, 0x04, 0x33, 0x44,
-	0x2d, 0x88, 0x6d, 0x25, 0x33, 0x63, 0x81, 0x3c, 0xc3, 0x8b, 0x05, 0xf2, 0x8c, 0x5d, 0xcf, 0x37,
-	0x68, 0xf1, 0x43, 0x5c, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x0f, 0x32, 0x5d, 0xc9, 0x9d, 0x8b, 0xd7,
-	0x37, 0x3f, 0xa5, 0x34, 0x27, 0x35, 0x2c, 0xb5, 0xa8, 0x38, 0x33, 0x1f, 0xbb, 0x75, 0x12, 0x5c,
-	0xec, 0x65, 0x10, 0x69, 0xb0, 0x7d, 0x2c, 0x41, 0x30, 0xae, 0x15, 0xc7, 0x8c, 0x05, 0xf2, 0x8c,
-	0x20, 0xc3, 0x9d, 0xbc, 0x4e, 0x3c, 0x94, 0x63, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0x9d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x68,
-	0xf0, 0x21, 0xb9, 0xa4, 0x02, 0x1e, 0x96, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x10,
-	0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x47, 0x1a, 0x85, 0x6a, 0x01, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xf5, 0xb6, 0x26, 0x4d, 0x17, 0x4a, 0x1b, 0xab, 0xaa, 0x4c, 0x41, 0x4e, 0x14, 0x81, 0x14,
+	0x01, 0x8d, 0x55, 0x71, 0xeb, 0x8d, 0x4a, 0x7c, 0x08, 0x09, 0x84, 0x1c, 0x89, 0x03, 0x17, 0x6b,
+	0x9c, 0x5d, 0x9c, 0x15, 0xf6, 0xae, 0xe5, 0xdd, 0x44, 0xf4, 0x2f, 0x70, 0xe2, 0xd8, 0x63, 0x7e,
+	0x02, 0x07, 0xce, 0x5c, 0xe9, 0xb1, 0xe2, 0xc4, 0x11, 0x92, 0x03, 0xfc, 0x0c, 0xb4, 0x1f, 0x6e,
+	0x72, 0x80, 0x8b, 0x35, 0xef, 0xcd, 0xf3, 0xcc, 0x9b, 0xd9, 0xc1, 0x77, 0xc7, 0x42, 0x96, 0x42,
+	0xc6, 0xd3, 0x2a, 0xaf, 0x81, 0xd0, 0x78, 0x76, 0x9c, 0x51, 0x05, 0xc7, 0x0d, 0x1e, 0x56, 0xb5,
+	0x50, 0x22, 0x38, 0xb0, 0xaa, 0x61, 0xc3, 0x3a, 0xd5, 0xe1, 0x7e, 0x2e, 0x72, 0x61, 0x24, 0xb1,
+	0x8e, 0xac, 0xfa, 0xb0, 0x03, 0x25, 0xe3, 0x22, 0x36, 0x5f, 0x47, 0xdd, 0xb2, 0x05, 0x52, 0xab,
+	0x75, 0xd5, 0x0c, 0xe8, 0x13, 0xec, 0xbf, 0x2e, 0x80, 0x07, 0x01, 0xf6, 0x39, 0x94, 0x34, 0x44,
+	0x3d, 0x34, 0xd8, 0x4e, 0x4c, 0x1c, 0x1c, 0xe0, 0xd6, 0x84, 0xb2, 0x7c, 0xa2, 0xc2, 0x8d, 0x1e,
+	0x1a, 0x6c, 0x26, 0x0e, 0x69, 0x2d, 0xe3, 0xef, 0x44, 0xb8, 0x69, 0xb5, 0x3a, 0x3e, 0xb9, 0x73,
+	0x3e, 0xef, 0x7a, 0x7f, 0xe6, 0x5d, 0xf4, 0xf1, 0xf7, 0xe7, 0xfb, 0xbb, 0xb6, 0xc5, 0x91, 0x24,
+	0xef, 0x63, 0x5d, 0xbd, 0xff, 0x0c, 0xef, 0xbc, 0x14, 0x64, 0x5a, 0xd0, 0x37, 0xb4, 0x96, 0x4c,
+	0xfc, 0xbb, 0x5d, 0x88, 0xb7, 0x66, 0x36, 0x6d, 0xfa, 0xf9, 0x49, 0x03, 0x4f, 0xda, 0xe7, 0xf3,
+	0x2e, 0xd2, 0xc5, 0xfb, 0x5f, 0x11, 0xde, 0x4d, 0x28, 0x10, 0xc6, 0xa9, 0x94, 0x23, 0x96, 0x73,
+	0x28, 0x82, 0x27, 0xb8, 0x33, 0x83, 0x82, 0x11, 0x50, 0xa2, 0x4e, 0x81, 0x90, 0x9a, 0x4a, 0x69,
+	0x0b, 0x9f, 0x86, 0xdf, 0xbf, 0x1c, 0xed, 0xbb, 0x79, 0x1f, 0xdb, 0xcc, 0x48, 0xd5, 0x8c, 0xe7,
+	0xc9, 0xde, 0xd5, 0x2f, 0x8e, 0x0f, 0x6e, 0xe3, 0xed, 0xaa, 0x00, 0x9e, 0x1a, 0x5f, 0x1b, 0xc6,
+	0x57, 0x5b, 0x13, 0xaf, 0xb4, 0xb7, 0x7b, 0xf8, 0x66, 0xc6, 0x38, 0xd4, 0x67, 0x69, 0x63, 0xd1,
+	0x0e, 0xbf, 0x63, 0xd9, 0x66, 0xac, 0xd5, 0xc6, 0xfc, 0xf5, 0x8d, 0xad, 0x0d, 0xf0, 0x0d, 0xe1,
+	0x1b, 0x4f, 0x29, 0xa8, 0x69, 0x4d, 0x47, 0x0a, 0x14, 0xfd, 0xdf, 0xe2, 0x4b, 0xb3, 0x2e, 0xe7,
+	0xc3, 0x21, 0xbd, 0x21, 0x77, 0x03, 0xae, 0x7d, 0x03, 0x83, 0x1e, 0xbe, 0x4e, 0xa8, 0x1c, 0xd7,
+	0xac, 0x52, 0xda, 0x9c, 0x6f, 0xb2, 0xeb, 0x54, 0xf0, 0x00, 0x77, 0x60, 0xac, 0xd8, 0x0c, 0x34,
+	0x4a, 0x9d, 0xcb, 0x6b, 0xc6, 0xe5, 0xde, 0x2a, 0xf1, 0xdc, 0xbe, 0x70, 0x88, 0xb7, 0x28, 0x87,
+	0xac, 0xa0, 0x24, 0x6c, 0xf5, 0xd0, 0xa0, 0x9d, 0x34, 0x70, 0x35, 0xc9, 0xe9, 0x8b, 0x8b, 0x5f,
+	0x91, 0x77, 0xb1, 0x88, 0xd0, 0xe5, 0x22, 0x42, 0x3f, 0x17, 0x11, 0xfa, 0xb4, 0x8c, 0xbc, 0xcb,
+	0x65, 0xe4, 0xfd, 0x58, 0x46, 0xde, 0xdb, 0x87, 0x39, 0x53, 0x93, 0x69, 0x36, 0x1c, 0x8b, 0xd2,
+	0x1d, 0x5c, 0xbc, 0x76, 0x14, 0x1f, 0xae, 0x2e, 0x5e, 0x9d, 0x55, 0x54, 0x66, 0x2d, 0x73, 0x8c,
+	0x8f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x74, 0x49, 0x7c, 0x62, 0x10, 0x03, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -204,6 +318,78 @@ func (this *ModuleVersion) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ReadinessSignal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReadinessSignal)
+	if !ok {
+		that2, ok := that.(ReadinessSignal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.PlanName != that1.PlanName {
+		return false
+	}
+	if this.BinaryVersion != that1.BinaryVersion {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *FeatureState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeatureState)
+	if !ok {
+		that2, ok := that.(FeatureState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Module != that1.Module {
+		return false
+	}
+	if this.Upgrade != that1.Upgrade {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.ActivationHeight != that1.ActivationHeight {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -281,6 +467,121 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReadinessSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadinessSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadinessSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BinaryVersion) > 0 {
+		i -= len(m.BinaryVersion)
+		copy(dAtA[i:], m.BinaryVersion)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.BinaryVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeatureState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Upgrade) > 0 {
+		i -= len(m.Upgrade)
+		copy(dAtA[i:], m.Upgrade)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Upgrade)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -328,6 +629,61 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *ReadinessSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.BinaryVersion)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *FeatureState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Upgrade)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovUpgrade(uint64(m.ActivationHeight))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -568,6 +924,388 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReadinessSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadinessSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadinessSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeatureState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upgrade = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -205,6 +205,15 @@ func (c *UpgradeConfig) Clear(height int64) {
 	c.elements[height] = nil
 }
 
+// GetPlanByName returns the upgrade plan with the given name
+func (c *UpgradeConfig) GetPlanByName(name string) (*Plan, bool) {
+	key, ok := c.keys[name]
+	if !ok {
+		return nil, false
+	}
+	return c.elements[key.height][key.index], true
+}
+
 // GetPlan returns the upgrade plan at a given height
 func (c *UpgradeConfig) GetPlan(height int64) []*Plan {
 	plans, exist := c.elements[height]